@@ -44,6 +44,17 @@ func (o *OriginData) SaveState(dir string) (string, error) {
 	return statePath, file.WriteFile(statePath, o.StateBytes())
 }
 
+// BlockRoot returns the hash_tree_root of the downloaded SignedBeaconBlock, which is also the root
+// of the block header integrated by the downloaded BeaconState.
+func (o *OriginData) BlockRoot() [32]byte {
+	return o.br
+}
+
+// State returns the downloaded BeaconState value.
+func (o *OriginData) State() state.BeaconState {
+	return o.st
+}
+
 // StateBytes returns the ssz-encoded bytes of the downloaded BeaconState value.
 func (o *OriginData) StateBytes() []byte {
 	return o.sb
@@ -101,6 +112,9 @@ func DownloadFinalizedData(ctx context.Context, client *Client) (*OriginData, er
 	if err != nil {
 		return nil, errors.Wrap(err, "error computing hash_tree_root of retrieved block")
 	}
+	if realBlockRoot != br {
+		return nil, fmt.Errorf("retrieved block root %#x does not match the state latest_block_header root %#x", realBlockRoot, br)
+	}
 
 	log.Printf("BeaconState slot=%d, Block slot=%d", s.Slot(), b.Block().Slot())
 	log.Printf("BeaconState htr=%#xd, Block state_root=%#x", sr, b.Block().StateRoot())
@@ -35,6 +35,10 @@ const (
 	getForkSchedulePath     = "/eth/v1/config/fork_schedule"
 	getStatePath            = "/eth/v2/debug/beacon/states"
 	getNodeVersionPath      = "/eth/v1/node/version"
+	getDepositSnapshotPath  = "/eth/v1/beacon/deposit_snapshot"
+
+	postVoluntaryExitPath         = "/eth/v1/beacon/pool/voluntary_exits"
+	postBLSToExecutionChangesPath = "/eth/v1/beacon/pool/bls_to_execution_changes"
 )
 
 // StateOrBlockId represents the block_id / state_id parameters that several of the Eth Beacon API methods accept.
@@ -171,6 +175,28 @@ func (c *Client) get(ctx context.Context, path string, opts ...reqOption) ([]byt
 	return b, nil
 }
 
+// post is a generic POST function for submitting a JSON request body, which expects an empty 200 response.
+func (c *Client) post(ctx context.Context, path string, body []byte) error {
+	u := c.baseURL.ResolveReference(&url.URL{Path: path})
+	log.Printf("posting to %s", u.String())
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u.String(), bytes.NewBuffer(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	r, err := c.hc.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = r.Body.Close()
+	}()
+	if r.StatusCode != http.StatusOK {
+		return non200Err(r)
+	}
+	return nil
+}
+
 func renderGetBlockPath(id StateOrBlockId) string {
 	return path.Join(getSignedBlockPath, string(id))
 }
@@ -309,6 +335,32 @@ func (c *Client) GetState(ctx context.Context, stateId StateOrBlockId) ([]byte,
 	return b, nil
 }
 
+// SubmitVoluntaryExit submits a signed voluntary exit to the voluntary exit pool of the beacon node,
+// which broadcasts it to the network.
+func (c *Client) SubmitVoluntaryExit(ctx context.Context, exit *apimiddleware.SignedVoluntaryExitJson) error {
+	body, err := json.Marshal(exit)
+	if err != nil {
+		return errors.Wrap(err, "could not marshal voluntary exit")
+	}
+	if err := c.post(ctx, postVoluntaryExitPath, body); err != nil {
+		return errors.Wrapf(err, "error submitting voluntary exit of validator %s", exit.Exit.ValidatorIndex)
+	}
+	return nil
+}
+
+// SubmitBLSToExecutionChanges submits signed BLS to execution changes to the pool of the beacon node,
+// which broadcasts them to the network.
+func (c *Client) SubmitBLSToExecutionChanges(ctx context.Context, changes []*apimiddleware.SignedBLSToExecutionChangeJson) error {
+	body, err := json.Marshal(changes)
+	if err != nil {
+		return errors.Wrap(err, "could not marshal BLS to execution changes")
+	}
+	if err := c.post(ctx, postBLSToExecutionChangesPath, body); err != nil {
+		return errors.Wrap(err, "error submitting BLS to execution changes")
+	}
+	return nil
+}
+
 // GetWeakSubjectivity calls a proposed API endpoint that is unique to prysm
 // This api method does the following:
 // - computes weak subjectivity epoch
@@ -343,6 +395,51 @@ func (c *Client) GetWeakSubjectivity(ctx context.Context) (*WeakSubjectivityData
 	}, nil
 }
 
+// GetDepositSnapshot retrieves the EIP-4881 finalized deposit tree snapshot of the beacon node.
+func (c *Client) GetDepositSnapshot(ctx context.Context) (*ethpb.DepositSnapshot, error) {
+	body, err := c.get(ctx, getDepositSnapshotPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "error requesting deposit snapshot")
+	}
+	v := &apimiddleware.DepositSnapshotResponseJson{}
+	if err := json.Unmarshal(body, v); err != nil {
+		return nil, errors.Wrap(err, "error decoding json response in GetDepositSnapshot")
+	}
+	if v.Data == nil {
+		return nil, errors.New("empty deposit snapshot response")
+	}
+	finalized := make([][]byte, len(v.Data.Finalized))
+	for i, f := range v.Data.Finalized {
+		finalized[i], err = hexutil.Decode(f)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error decoding hex-encoded finalized root %s", f)
+		}
+	}
+	depositRoot, err := hexutil.Decode(v.Data.DepositRoot)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error decoding hex-encoded deposit root %s", v.Data.DepositRoot)
+	}
+	depositCount, err := strconv.ParseUint(v.Data.DepositCount, 10, 64)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error parsing deposit count %s", v.Data.DepositCount)
+	}
+	blockHash, err := hexutil.Decode(v.Data.ExecutionBlockHash)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error decoding hex-encoded execution block hash %s", v.Data.ExecutionBlockHash)
+	}
+	blockHeight, err := strconv.ParseUint(v.Data.ExecutionBlockHeight, 10, 64)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error parsing execution block height %s", v.Data.ExecutionBlockHeight)
+	}
+	return &ethpb.DepositSnapshot{
+		Finalized:            finalized,
+		DepositRoot:          depositRoot,
+		DepositCount:         depositCount,
+		ExecutionBlockHash:   blockHash,
+		ExecutionBlockHeight: blockHeight,
+	}, nil
+}
+
 func non200Err(response *http.Response) error {
 	bodyBytes, err := io.ReadAll(response.Body)
 	var body string
@@ -1,9 +1,15 @@
 package beacon
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
 	"net/url"
 	"testing"
 
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/rpc/apimiddleware"
 	"github.com/prysmaticlabs/prysm/v3/testing/require"
 )
 
@@ -136,3 +142,85 @@ func TestValidHostname(t *testing.T) {
 		})
 	}
 }
+
+func TestSubmitVoluntaryExit(t *testing.T) {
+	exit := &apimiddleware.SignedVoluntaryExitJson{
+		Exit:      &apimiddleware.VoluntaryExitJson{Epoch: "10", ValidatorIndex: "2"},
+		Signature: "0x01",
+	}
+	var status int
+	c := &Client{
+		hc:      &http.Client{},
+		baseURL: &url.URL{Host: "localhost:3500", Scheme: "http"},
+	}
+	c.hc.Transport = &testRT{rt: func(req *http.Request) (*http.Response, error) {
+		require.Equal(t, http.MethodPost, req.Method)
+		require.Equal(t, postVoluntaryExitPath, req.URL.Path)
+		got := &apimiddleware.SignedVoluntaryExitJson{}
+		require.NoError(t, json.NewDecoder(req.Body).Decode(got))
+		require.DeepEqual(t, exit, got)
+		return &http.Response{Request: req, StatusCode: status, Body: io.NopCloser(bytes.NewBuffer(nil))}, nil
+	}}
+
+	status = http.StatusOK
+	require.NoError(t, c.SubmitVoluntaryExit(context.Background(), exit))
+	status = http.StatusBadRequest
+	err := c.SubmitVoluntaryExit(context.Background(), exit)
+	require.ErrorIs(t, err, ErrNotOK)
+	require.ErrorContains(t, "error submitting voluntary exit of validator 2", err)
+}
+
+func TestSubmitBLSToExecutionChanges(t *testing.T) {
+	changes := []*apimiddleware.SignedBLSToExecutionChangeJson{
+		{
+			Message: &apimiddleware.BLSToExecutionChangeJson{
+				ValidatorIndex:     "2",
+				FromBLSPubkey:      "0x02",
+				ToExecutionAddress: "0x03",
+			},
+			Signature: "0x01",
+		},
+	}
+	c := &Client{
+		hc:      &http.Client{},
+		baseURL: &url.URL{Host: "localhost:3500", Scheme: "http"},
+	}
+	c.hc.Transport = &testRT{rt: func(req *http.Request) (*http.Response, error) {
+		require.Equal(t, http.MethodPost, req.Method)
+		require.Equal(t, postBLSToExecutionChangesPath, req.URL.Path)
+		var got []*apimiddleware.SignedBLSToExecutionChangeJson
+		require.NoError(t, json.NewDecoder(req.Body).Decode(&got))
+		require.DeepEqual(t, changes, got)
+		return &http.Response{Request: req, StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewBuffer(nil))}, nil
+	}}
+	require.NoError(t, c.SubmitBLSToExecutionChanges(context.Background(), changes))
+}
+
+func TestGetDepositSnapshot(t *testing.T) {
+	c := &Client{
+		hc:      &http.Client{},
+		baseURL: &url.URL{Host: "localhost:3500", Scheme: "http"},
+	}
+	c.hc.Transport = &testRT{rt: func(req *http.Request) (*http.Response, error) {
+		require.Equal(t, http.MethodGet, req.Method)
+		require.Equal(t, getDepositSnapshotPath, req.URL.Path)
+		b, err := json.Marshal(&apimiddleware.DepositSnapshotResponseJson{
+			Data: &apimiddleware.DepositSnapshotJson{
+				Finalized:            []string{"0x01", "0x02"},
+				DepositRoot:          "0x03",
+				DepositCount:         "3",
+				ExecutionBlockHash:   "0x04",
+				ExecutionBlockHeight: "100",
+			},
+		})
+		require.NoError(t, err)
+		return &http.Response{Request: req, StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewBuffer(b))}, nil
+	}}
+	snapshot, err := c.GetDepositSnapshot(context.Background())
+	require.NoError(t, err)
+	require.DeepEqual(t, [][]byte{{0x01}, {0x02}}, snapshot.Finalized)
+	require.DeepEqual(t, []byte{0x03}, snapshot.DepositRoot)
+	require.Equal(t, uint64(3), snapshot.DepositCount)
+	require.DeepEqual(t, []byte{0x04}, snapshot.ExecutionBlockHash)
+	require.Equal(t, uint64(100), snapshot.ExecutionBlockHeight)
+}
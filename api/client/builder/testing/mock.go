@@ -12,6 +12,10 @@ import (
 // MockClient is a mock implementation of BuilderClient.
 type MockClient struct {
 	RegisteredVals map[[48]byte]bool
+	URL            string
+	Bid            *ethpb.SignedBuilderBid
+	Payload        *v1.ExecutionPayload
+	Err            error
 }
 
 // NewClient creates a new, correctly initialized mock.
@@ -20,17 +24,20 @@ func NewClient() MockClient {
 }
 
 // NodeURL --
-func (MockClient) NodeURL() string {
-	return ""
+func (m MockClient) NodeURL() string {
+	return m.URL
 }
 
 // GetHeader --
-func (MockClient) GetHeader(_ context.Context, _ types.Slot, _ [32]byte, _ [48]byte) (*ethpb.SignedBuilderBid, error) {
-	return nil, nil
+func (m MockClient) GetHeader(_ context.Context, _ types.Slot, _ [32]byte, _ [48]byte) (*ethpb.SignedBuilderBid, error) {
+	return m.Bid, m.Err
 }
 
 // RegisterValidator --
 func (m MockClient) RegisterValidator(_ context.Context, svr []*ethpb.SignedValidatorRegistrationV1) error {
+	if m.Err != nil {
+		return m.Err
+	}
 	for _, r := range svr {
 		b := bytesutil.ToBytes48(r.Message.Pubkey)
 		m.RegisteredVals[b] = true
@@ -39,8 +46,8 @@ func (m MockClient) RegisterValidator(_ context.Context, svr []*ethpb.SignedVali
 }
 
 // SubmitBlindedBlock --
-func (MockClient) SubmitBlindedBlock(_ context.Context, _ *ethpb.SignedBlindedBeaconBlockBellatrix) (*v1.ExecutionPayload, error) {
-	return nil, nil
+func (m MockClient) SubmitBlindedBlock(_ context.Context, _ *ethpb.SignedBlindedBeaconBlockBellatrix) (*v1.ExecutionPayload, error) {
+	return m.Payload, m.Err
 }
 
 // Status --
@@ -93,6 +93,11 @@ type OptimisticModeFetcher interface {
 	IsOptimisticForRoot(ctx context.Context, root [32]byte) (bool, error)
 }
 
+// PayloadInvalidationFetcher retrieves the recent execution payload invalidations observed by the node.
+type PayloadInvalidationFetcher interface {
+	RecentPayloadInvalidations() []*ethpb.PayloadInvalidation
+}
+
 // FinalizedCheckpt returns the latest finalized checkpoint from chain store.
 func (s *Service) FinalizedCheckpt() *ethpb.Checkpoint {
 	cp := s.ForkChoicer().FinalizedCheckpoint()
@@ -72,6 +72,18 @@ func (s *Service) notifyForkchoiceUpdate(ctx context.Context, arg *notifyForkcho
 		log.WithError(err).Error("Could not get head payload attribute")
 		return nil, nil
 	}
+	// The next proposer is expected to reorg a late and weakly attested head, so the execution engine is
+	// not moved to the head, and the payload is prepared on top of the parent of the head instead. The head
+	// is updated as usual if there is no payload to prepare on the parent.
+	if hasAttr && s.ForkChoicer().ShouldOverrideFCU() {
+		payloadID, err := s.notifyForkchoiceUpdateOnParent(ctx, headBlk, fcs, nextSlot)
+		if err != nil {
+			log.WithError(err).Error("Could not prepare payload on parent of late head block")
+		}
+		if payloadID != nil {
+			return payloadID, nil
+		}
+	}
 
 	payloadID, lastValidHash, err := s.cfg.ExecutionEngineCaller.ForkchoiceUpdated(ctx, fcs, attr)
 	if err != nil {
@@ -99,6 +111,7 @@ func (s *Service) notifyForkchoiceUpdate(ctx context.Context, arg *notifyForkcho
 				log.WithError(err).Error("Could not remove invalid block and state")
 				return nil, nil
 			}
+			s.notifyPayloadInvalidated(headBlk.Slot(), headRoot, lastValidHash, invalidRoots)
 
 			r, err := s.cfg.ForkChoiceStore.Head(ctx, s.justifiedBalances.balances)
 			if err != nil {
@@ -146,7 +159,7 @@ func (s *Service) notifyForkchoiceUpdate(ctx context.Context, arg *notifyForkcho
 		}
 	}
 	forkchoiceUpdatedValidNodeCount.Inc()
-	if err := s.cfg.ForkChoiceStore.SetOptimisticToValid(ctx, arg.headRoot); err != nil {
+	if err := s.setOptimisticToValid(ctx, headBlk, arg.headRoot, arg.headRoot); err != nil {
 		log.WithError(err).Error("Could not set head root to valid")
 		return nil, nil
 	}
@@ -163,6 +176,57 @@ func (s *Service) notifyForkchoiceUpdate(ctx context.Context, arg *notifyForkcho
 	return payloadID, nil
 }
 
+// notifyForkchoiceUpdateOnParent signals the execution engine to build the payload of the next slot on top
+// of the parent of the head block, with the payload ID cached under the parent root. This is used when the
+// proposer of the next slot reorgs a late and weakly attested head block. It returns a nil payload ID if
+// there is no payload to prepare on the parent.
+func (s *Service) notifyForkchoiceUpdateOnParent(ctx context.Context, headBlk interfaces.BeaconBlock, fcs *enginev1.ForkchoiceState, nextSlot types.Slot) (*enginev1.PayloadIDBytes, error) {
+	ctx, span := trace.StartSpan(ctx, "blockChain.notifyForkchoiceUpdateOnParent")
+	defer span.End()
+
+	parentRoot := headBlk.ParentRoot()
+	parentHash, err := s.getPayloadHash(ctx, parentRoot[:])
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get parent payload hash")
+	}
+	if parentHash == params.BeaconConfig().ZeroHash { // The parent is not an execution block, there is nothing to build on.
+		return nil, nil
+	}
+	parentState, err := s.cfg.StateGen.StateByRoot(ctx, parentRoot)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get parent state")
+	}
+	hasAttr, attr, proposerId, err := s.getPayloadAttribute(ctx, parentState, nextSlot)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get parent payload attribute")
+	}
+	if !hasAttr {
+		return nil, nil
+	}
+	parentFcs := &enginev1.ForkchoiceState{
+		HeadBlockHash:      parentHash[:],
+		SafeBlockHash:      fcs.SafeBlockHash,
+		FinalizedBlockHash: fcs.FinalizedBlockHash,
+	}
+	payloadID, _, err := s.cfg.ExecutionEngineCaller.ForkchoiceUpdated(ctx, parentFcs, attr)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not notify forkchoice update")
+	}
+	if payloadID == nil {
+		return nil, fmt.Errorf("nil payload ID with parent block hash: %#x", parentHash)
+	}
+	var pId [8]byte
+	copy(pId[:], payloadID[:])
+	s.cfg.ProposerSlotIndexCache.SetProposerAndPayloadIDs(nextSlot, proposerId, pId, parentRoot)
+	forkchoiceUpdatedParentOverrideCount.Inc()
+	log.WithFields(logrus.Fields{
+		"headSlot":   headBlk.Slot(),
+		"parentRoot": fmt.Sprintf("%#x", bytesutil.Trunc(parentRoot[:])),
+		"nextSlot":   nextSlot,
+	}).Info("Prepared payload on parent of late head block")
+	return payloadID, nil
+}
+
 // getPayloadHash returns the payload hash given the block root.
 // if the block is before bellatrix fork epoch, it returns the zero hash.
 func (s *Service) getPayloadHash(ctx context.Context, root []byte) ([32]byte, error) {
@@ -232,6 +296,7 @@ func (s *Service) notifyNewPayload(ctx context.Context, postStateVersion int,
 		if err := s.removeInvalidBlockAndState(ctx, invalidRoots); err != nil {
 			return false, err
 		}
+		s.notifyPayloadInvalidated(blk.Block().Slot(), root, lastValidHash, invalidRoots)
 		log.WithFields(logrus.Fields{
 			"slot":                 blk.Block().Slot(),
 			"blockRoot":            fmt.Sprintf("%#x", root),
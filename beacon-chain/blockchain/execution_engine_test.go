@@ -7,11 +7,13 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	mock "github.com/prysmaticlabs/prysm/v3/beacon-chain/blockchain/testing"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/blocks"
 	testDB "github.com/prysmaticlabs/prysm/v3/beacon-chain/db/testing"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/execution"
 	mockExecution "github.com/prysmaticlabs/prysm/v3/beacon-chain/execution/testing"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/forkchoice"
 	doublylinkedtree "github.com/prysmaticlabs/prysm/v3/beacon-chain/forkchoice/doubly-linked-tree"
 	forkchoicetypes "github.com/prysmaticlabs/prysm/v3/beacon-chain/forkchoice/types"
 	bstate "github.com/prysmaticlabs/prysm/v3/beacon-chain/state"
@@ -45,6 +47,7 @@ func Test_NotifyForkchoiceUpdate(t *testing.T) {
 		WithStateGen(stategen.New(beaconDB, fcs)),
 		WithForkChoiceStore(fcs),
 		WithProposerIdsCache(cache.NewProposerPayloadIDsCache()),
+		WithStateNotifier(&mock.MockStateNotifier{}),
 	}
 	service, err := NewService(ctx, opts...)
 	st, _ := util.DeterministicGenesisState(t, 10)
@@ -433,6 +436,7 @@ func Test_NotifyNewPayload(t *testing.T) {
 		WithDatabase(beaconDB),
 		WithStateGen(stategen.New(beaconDB, fcs)),
 		WithForkChoiceStore(fcs),
+		WithStateNotifier(&mock.MockStateNotifier{}),
 	}
 	phase0State, _ := util.DeterministicGenesisState(t, 1)
 	altairState, _ := util.DeterministicGenesisStateAltair(t, 1)
@@ -965,3 +969,129 @@ func TestService_getPayloadHash(t *testing.T) {
 	require.NoError(t, err)
 	require.DeepEqual(t, [32]byte{'a'}, h)
 }
+
+func Test_NotifyForkchoiceUpdateOnParent(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	fc := doublylinkedtree.New()
+	pid := &v1.PayloadIDBytes{1}
+	opts := []Option{
+		WithDatabase(beaconDB),
+		WithStateGen(stategen.New(beaconDB, fc)),
+		WithForkChoiceStore(fc),
+		WithProposerIdsCache(cache.NewProposerPayloadIDsCache()),
+		WithExecutionEngineCaller(&mockExecution.EngineClient{PayloadIDBytes: pid}),
+	}
+	service, err := NewService(ctx, opts...)
+	require.NoError(t, err)
+	fcs := &v1.ForkchoiceState{
+		SafeBlockHash:      make([]byte, fieldparams.RootLength),
+		FinalizedBlockHash: make([]byte, fieldparams.RootLength),
+	}
+	nextSlot := types.Slot(2)
+	suggestedVid := types.ValidatorIndex(1)
+	service.cfg.ProposerSlotIndexCache.SetProposerAndPayloadIDs(nextSlot, suggestedVid, [8]byte{}, [32]byte{})
+
+	// Pre-merge parent, nothing to build on.
+	parent := util.NewBeaconBlock()
+	parentRoot, err := parent.Block.HashTreeRoot()
+	require.NoError(t, err)
+	util.SaveBlock(t, ctx, beaconDB, parent)
+	head := util.NewBeaconBlockBellatrix()
+	head.Block.Slot = 1
+	head.Block.ParentRoot = parentRoot[:]
+	wsb, err := consensusblocks.NewSignedBeaconBlock(head)
+	require.NoError(t, err)
+	gotID, err := service.notifyForkchoiceUpdateOnParent(ctx, wsb.Block(), fcs, nextSlot)
+	require.NoError(t, err)
+	require.Equal(t, (*v1.PayloadIDBytes)(nil), gotID)
+	_, _, ok := service.cfg.ProposerSlotIndexCache.GetProposerPayloadIDs(nextSlot, parentRoot)
+	require.Equal(t, false, ok)
+
+	// Post-merge parent, the payload ID is cached under the parent root.
+	bellatrixParent := util.NewBeaconBlockBellatrix()
+	bellatrixParent.Block.Body.ExecutionPayload.BlockHash = bytesutil.PadTo([]byte{'a'}, fieldparams.RootLength)
+	parentRoot, err = bellatrixParent.Block.HashTreeRoot()
+	require.NoError(t, err)
+	util.SaveBlock(t, ctx, beaconDB, bellatrixParent)
+	st, _ := util.DeterministicGenesisStateBellatrix(t, 1)
+	require.NoError(t, beaconDB.SaveState(ctx, st, parentRoot))
+	head.Block.ParentRoot = parentRoot[:]
+	wsb, err = consensusblocks.NewSignedBeaconBlock(head)
+	require.NoError(t, err)
+	gotID, err = service.notifyForkchoiceUpdateOnParent(ctx, wsb.Block(), fcs, nextSlot)
+	require.NoError(t, err)
+	require.DeepEqual(t, pid, gotID)
+	vId, payloadID, ok := service.cfg.ProposerSlotIndexCache.GetProposerPayloadIDs(nextSlot, parentRoot)
+	require.Equal(t, true, ok)
+	require.Equal(t, suggestedVid, vId)
+	require.DeepEqual(t, [8]byte(*pid), payloadID)
+}
+
+type overrideFCUForkChoicer struct {
+	forkchoice.ForkChoicer
+}
+
+func (*overrideFCUForkChoicer) ShouldOverrideFCU() bool {
+	return true
+}
+
+type headRecordingEngineClient struct {
+	mockExecution.EngineClient
+	heads [][]byte
+}
+
+func (e *headRecordingEngineClient) ForkchoiceUpdated(
+	ctx context.Context, fcs *v1.ForkchoiceState, attr *v1.PayloadAttributes,
+) (*v1.PayloadIDBytes, []byte, error) {
+	e.heads = append(e.heads, fcs.HeadBlockHash)
+	return e.EngineClient.ForkchoiceUpdated(ctx, fcs, attr)
+}
+
+func Test_NotifyForkchoiceUpdate_OverrideSkipsHead(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	fc := &overrideFCUForkChoicer{ForkChoicer: doublylinkedtree.New()}
+	pid := &v1.PayloadIDBytes{1}
+	engine := &headRecordingEngineClient{EngineClient: mockExecution.EngineClient{PayloadIDBytes: pid}}
+	opts := []Option{
+		WithDatabase(beaconDB),
+		WithStateGen(stategen.New(beaconDB, fc)),
+		WithForkChoiceStore(fc),
+		WithProposerIdsCache(cache.NewProposerPayloadIDsCache()),
+		WithExecutionEngineCaller(engine),
+	}
+	service, err := NewService(ctx, opts...)
+	require.NoError(t, err)
+	service.genesisTime = time.Now()
+	nextSlot := service.CurrentSlot() + 1
+	service.cfg.ProposerSlotIndexCache.SetProposerAndPayloadIDs(nextSlot, 1, [8]byte{}, [32]byte{})
+
+	parent := util.NewBeaconBlockBellatrix()
+	parentHash := bytesutil.PadTo([]byte{'a'}, fieldparams.RootLength)
+	parent.Block.Body.ExecutionPayload.BlockHash = parentHash
+	parentRoot, err := parent.Block.HashTreeRoot()
+	require.NoError(t, err)
+	util.SaveBlock(t, ctx, beaconDB, parent)
+	st, _ := util.DeterministicGenesisStateBellatrix(t, 1)
+	require.NoError(t, beaconDB.SaveState(ctx, st, parentRoot))
+
+	head := util.NewBeaconBlockBellatrix()
+	head.Block.Slot = 1
+	head.Block.ParentRoot = parentRoot[:]
+	head.Block.Body.ExecutionPayload.BlockHash = bytesutil.PadTo([]byte{'b'}, fieldparams.RootLength)
+	wsb, err := consensusblocks.NewSignedBeaconBlock(head)
+	require.NoError(t, err)
+	headRoot, err := head.Block.HashTreeRoot()
+	require.NoError(t, err)
+
+	gotID, err := service.notifyForkchoiceUpdate(ctx, &notifyForkchoiceUpdateArg{headState: st, headRoot: headRoot, headBlock: wsb.Block()})
+	require.NoError(t, err)
+	require.DeepEqual(t, pid, gotID)
+	// Only the parent of the head is sent to the execution engine.
+	require.Equal(t, 1, len(engine.heads))
+	require.DeepEqual(t, parentHash, engine.heads[0])
+	_, payloadID, ok := service.cfg.ProposerSlotIndexCache.GetProposerPayloadIDs(nextSlot, parentRoot)
+	require.Equal(t, true, ok)
+	require.DeepEqual(t, [8]byte(*pid), payloadID)
+}
@@ -162,6 +162,10 @@ var (
 		Name: "forkchoice_updated_invalid_node_count",
 		Help: "Count the number of invalid nodes after forkchoiceUpdated EE call",
 	})
+	forkchoiceUpdatedParentOverrideCount = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "forkchoice_updated_parent_override_count",
+		Help: "Count the number of payloads prepared on the parent of a late head block, for the next proposer to reorg the head",
+	})
 	txsPerSlotCount = promauto.NewGauge(prometheus.GaugeOpts{
 		Name: "txs_per_slot_count",
 		Help: "Count the number of txs per slot",
@@ -59,6 +59,14 @@ func WithExecutionEngineCaller(c execution.EngineCaller) Option {
 	}
 }
 
+// WithExecutionBlockFetcher to look up execution blocks, such as the block of a deposit snapshot.
+func WithExecutionBlockFetcher(f execution.POWBlockFetcher) Option {
+	return func(s *Service) error {
+		s.cfg.BlockFetcher = f
+		return nil
+	}
+}
+
 // WithDepositCache for deposit lifecycle after chain inclusion.
 func WithDepositCache(c *depositcache.DepositCache) Option {
 	return func(s *Service) error {
@@ -0,0 +1,107 @@
+package blockchain
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/v3/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/v3/consensus-types/interfaces"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
+	ethpbv1 "github.com/prysmaticlabs/prysm/v3/proto/eth/v1"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+)
+
+// maxRecordedPayloadInvalidations is the number of recent payload invalidations kept in memory
+// for incident analysis.
+const maxRecordedPayloadInvalidations = 64
+
+// payloadInvalidationLog is a bounded record of the most recent execution payload invalidations,
+// ordered from oldest to newest.
+type payloadInvalidationLog struct {
+	sync.RWMutex
+	entries []*ethpb.PayloadInvalidation
+}
+
+func (l *payloadInvalidationLog) add(inv *ethpb.PayloadInvalidation) {
+	l.Lock()
+	defer l.Unlock()
+	if len(l.entries) >= maxRecordedPayloadInvalidations {
+		l.entries = l.entries[len(l.entries)-maxRecordedPayloadInvalidations+1:]
+	}
+	l.entries = append(l.entries, inv)
+}
+
+func (l *payloadInvalidationLog) list() []*ethpb.PayloadInvalidation {
+	l.RLock()
+	defer l.RUnlock()
+	entries := make([]*ethpb.PayloadInvalidation, len(l.entries))
+	copy(entries, l.entries)
+	return entries
+}
+
+// RecentPayloadInvalidations returns the most recent execution payload invalidations observed
+// by the node, ordered from oldest to newest.
+func (s *Service) RecentPayloadInvalidations() []*ethpb.PayloadInvalidation {
+	return s.payloadInvalidations.list()
+}
+
+// notifyPayloadInvalidated records an execution payload invalidation along with the blocks it
+// removed from fork choice, and notifies state feed subscribers of it.
+func (s *Service) notifyPayloadInvalidated(slot types.Slot, root [32]byte, lastValidHash []byte, invalidRoots [][32]byte) {
+	invalidated := make([][]byte, len(invalidRoots))
+	for i := range invalidRoots {
+		invalidated[i] = bytesutil.SafeCopyBytes(invalidRoots[i][:])
+	}
+	lvh := bytesutil.PadTo(bytesutil.SafeCopyBytes(lastValidHash), 32)
+	s.payloadInvalidations.add(&ethpb.PayloadInvalidation{
+		Slot:             slot,
+		BlockRoot:        bytesutil.SafeCopyBytes(root[:]),
+		LatestValidHash:  lvh,
+		InvalidatedRoots: invalidated,
+		Timestamp:        uint64(time.Now().Unix()),
+	})
+	s.cfg.StateNotifier.StateFeed().Send(&feed.Event{
+		Type: statefeed.PayloadInvalidated,
+		Data: &ethpbv1.EventPayloadInvalidated{
+			Slot:              slot,
+			Block:             bytesutil.SafeCopyBytes(root[:]),
+			LatestValidHash:   lvh,
+			InvalidatedBlocks: invalidated,
+		},
+	})
+}
+
+// notifyPayloadValidated notifies state feed subscribers that the block with the given root, and
+// any of its optimistic ancestors, are no longer optimistic.
+func (s *Service) notifyPayloadValidated(slot types.Slot, root [32]byte, blockHash []byte) {
+	s.cfg.StateNotifier.StateFeed().Send(&feed.Event{
+		Type: statefeed.PayloadValidated,
+		Data: &ethpbv1.EventPayloadValidated{
+			Slot:               slot,
+			Block:              bytesutil.SafeCopyBytes(root[:]),
+			ExecutionBlockHash: bytesutil.SafeCopyBytes(blockHash),
+		},
+	})
+}
+
+// setOptimisticToValid sets the block with the given root, and its ancestors, as validated in fork choice.
+// Subscribers are notified of the validation if the block at optimisticRoot was optimistic beforehand.
+func (s *Service) setOptimisticToValid(ctx context.Context, blk interfaces.BeaconBlock, root, optimisticRoot [32]byte) error {
+	wasOptimistic, err := s.cfg.ForkChoiceStore.IsOptimistic(optimisticRoot)
+	wasOptimistic = err == nil && wasOptimistic
+	if err := s.cfg.ForkChoiceStore.SetOptimisticToValid(ctx, root); err != nil {
+		return err
+	}
+	if !wasOptimistic {
+		return nil
+	}
+	var blockHash []byte
+	if payload, err := blk.Body().Execution(); err == nil {
+		blockHash = payload.BlockHash()
+	}
+	s.notifyPayloadValidated(blk.Slot(), root, blockHash)
+	return nil
+}
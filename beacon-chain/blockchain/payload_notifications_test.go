@@ -0,0 +1,97 @@
+package blockchain
+
+import (
+	"context"
+	"testing"
+
+	mock "github.com/prysmaticlabs/prysm/v3/beacon-chain/blockchain/testing"
+	statefeed "github.com/prysmaticlabs/prysm/v3/beacon-chain/core/feed/state"
+	doublylinkedtree "github.com/prysmaticlabs/prysm/v3/beacon-chain/forkchoice/doubly-linked-tree"
+	"github.com/prysmaticlabs/prysm/v3/config/params"
+	consensusblocks "github.com/prysmaticlabs/prysm/v3/consensus-types/blocks"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	ethpbv1 "github.com/prysmaticlabs/prysm/v3/proto/eth/v1"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+	"github.com/prysmaticlabs/prysm/v3/testing/util"
+)
+
+func TestPayloadInvalidationLog_Bounded(t *testing.T) {
+	l := &payloadInvalidationLog{}
+	for i := 0; i < maxRecordedPayloadInvalidations+5; i++ {
+		l.add(&ethpb.PayloadInvalidation{Slot: types.Slot(i)})
+	}
+	entries := l.list()
+	require.Equal(t, maxRecordedPayloadInvalidations, len(entries))
+	assert.Equal(t, types.Slot(5), entries[0].Slot)
+	assert.Equal(t, types.Slot(maxRecordedPayloadInvalidations+4), entries[len(entries)-1].Slot)
+}
+
+func TestService_notifyPayloadInvalidated(t *testing.T) {
+	notifier := &mock.MockStateNotifier{RecordEvents: true}
+	s := &Service{cfg: &config{StateNotifier: notifier}}
+	invalidRoots := [][32]byte{{'b'}, {'c'}}
+	s.notifyPayloadInvalidated(10, [32]byte{'b'}, []byte{'a'}, invalidRoots)
+
+	recorded := s.RecentPayloadInvalidations()
+	require.Equal(t, 1, len(recorded))
+	wantedRoot := [32]byte{'b'}
+	wantedHash := [32]byte{'a'}
+	assert.Equal(t, types.Slot(10), recorded[0].Slot)
+	assert.DeepEqual(t, wantedRoot[:], recorded[0].BlockRoot)
+	assert.DeepEqual(t, wantedHash[:], recorded[0].LatestValidHash)
+	require.Equal(t, 2, len(recorded[0].InvalidatedRoots))
+	assert.DeepEqual(t, invalidRoots[1][:], recorded[0].InvalidatedRoots[1])
+
+	events := notifier.ReceivedEvents()
+	require.Equal(t, 1, len(events))
+	assert.Equal(t, statefeed.PayloadInvalidated, int(events[0].Type))
+	data, ok := events[0].Data.(*ethpbv1.EventPayloadInvalidated)
+	require.Equal(t, true, ok)
+	assert.DeepEqual(t, recorded[0].InvalidatedRoots, data.InvalidatedBlocks)
+	assert.DeepEqual(t, wantedHash[:], data.LatestValidHash)
+}
+
+func TestService_setOptimisticToValid(t *testing.T) {
+	ctx := context.Background()
+	fcs := doublylinkedtree.New()
+	notifier := &mock.MockStateNotifier{RecordEvents: true}
+	s := &Service{cfg: &config{StateNotifier: notifier, ForkChoiceStore: fcs}}
+
+	ojc := &ethpb.Checkpoint{Root: params.BeaconConfig().ZeroHash[:]}
+	ofc := &ethpb.Checkpoint{Root: params.BeaconConfig().ZeroHash[:]}
+	st, root, err := prepareForkchoiceState(ctx, 0, [32]byte{'a'}, [32]byte{}, [32]byte{'A'}, ojc, ofc)
+	require.NoError(t, err)
+	require.NoError(t, fcs.InsertNode(ctx, st, root))
+	st, root, err = prepareForkchoiceState(ctx, 1, [32]byte{'b'}, [32]byte{'a'}, [32]byte{'B'}, ojc, ofc)
+	require.NoError(t, err)
+	require.NoError(t, fcs.InsertNode(ctx, st, root))
+	st, root, err = prepareForkchoiceState(ctx, 2, [32]byte{'c'}, [32]byte{'b'}, [32]byte{'C'}, ojc, ofc)
+	require.NoError(t, err)
+	require.NoError(t, fcs.InsertNode(ctx, st, root))
+
+	b := util.NewBeaconBlockBellatrix()
+	b.Block.Slot = 1
+	blk, err := consensusblocks.NewBeaconBlock(b.Block)
+	require.NoError(t, err)
+	require.NoError(t, s.setOptimisticToValid(ctx, blk, [32]byte{'b'}, [32]byte{'b'}))
+	optimistic, err := fcs.IsOptimistic([32]byte{'b'})
+	require.NoError(t, err)
+	require.Equal(t, false, optimistic)
+	events := notifier.ReceivedEvents()
+	require.Equal(t, 1, len(events))
+	assert.Equal(t, statefeed.PayloadValidated, int(events[0].Type))
+	data, ok := events[0].Data.(*ethpbv1.EventPayloadValidated)
+	require.Equal(t, true, ok)
+	wantedRoot := [32]byte{'b'}
+	assert.Equal(t, types.Slot(1), data.Slot)
+	assert.DeepEqual(t, wantedRoot[:], data.Block)
+
+	// The parent of the block is already validated, so no notification is sent.
+	b.Block.Slot = 2
+	blk, err = consensusblocks.NewBeaconBlock(b.Block)
+	require.NoError(t, err)
+	require.NoError(t, s.setOptimisticToValid(ctx, blk, [32]byte{'c'}, [32]byte{'b'}))
+	assert.Equal(t, 1, len(notifier.ReceivedEvents()))
+}
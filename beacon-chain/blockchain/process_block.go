@@ -147,7 +147,7 @@ func (s *Service) onBlock(ctx context.Context, signed interfaces.SignedBeaconBlo
 	}
 	s.InsertSlashingsToForkChoiceStore(ctx, signed.Block().Body().AttesterSlashings())
 	if isValidPayload {
-		if err := s.cfg.ForkChoiceStore.SetOptimisticToValid(ctx, blockRoot); err != nil {
+		if err := s.setOptimisticToValid(ctx, signed.Block(), blockRoot, signed.Block().ParentRoot()); err != nil {
 			return errors.Wrap(err, "could not set optimistic block to valid")
 		}
 	}
@@ -439,7 +439,7 @@ func (s *Service) onBlockBatch(ctx context.Context, blks []interfaces.SignedBeac
 	}
 	// Set their optimistic status
 	if isValidPayload {
-		if err := s.cfg.ForkChoiceStore.SetOptimisticToValid(ctx, lastBR); err != nil {
+		if err := s.setOptimisticToValid(ctx, blks[len(blks)-1].Block(), lastBR, blks[0].Block().ParentRoot()); err != nil {
 			return errors.Wrap(err, "could not set optimistic block to valid")
 		}
 	}
@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/helpers"
 	doublylinkedtree "github.com/prysmaticlabs/prysm/v3/beacon-chain/forkchoice/doubly-linked-tree"
@@ -313,9 +314,93 @@ func (s *Service) insertFinalizedDeposits(ctx context.Context, fRoot [32]byte) e
 	if err = s.cfg.DepositCache.PruneProofs(ctx, int64(eth1DepositIndex)); err != nil {
 		return errors.Wrap(err, "could not prune deposit proofs")
 	}
+	snapshot, err := s.finalizedDepositSnapshot(ctx, finalizedState)
+	if err != nil {
+		log.WithError(err).Warn("Could not take deposit snapshot")
+	}
+	if snapshot != nil {
+		go s.saveDepositSnapshot(snapshot)
+	}
 	return nil
 }
 
+// finalizedDepositSnapshot returns the EIP-4881 snapshot of the finalized deposits trie, which can be served
+// to nodes starting from a checkpoint, or nil if there is no new snapshot to save. A snapshot is only taken
+// when the finalized deposits are exactly the deposits of the eth1 data of the finalized state, so that the
+// execution block of the snapshot is the eth1 data block and no deposit log of that block is left out. The
+// height of the execution block is left to be filled in by saveDepositSnapshot.
+func (s *Service) finalizedDepositSnapshot(ctx context.Context, finalizedState state.BeaconState) (*ethpb.DepositSnapshot, error) {
+	ctx, span := trace.StartSpan(ctx, "blockChain.finalizedDepositSnapshot")
+	defer span.End()
+
+	eth1Data := finalizedState.Eth1Data()
+	if eth1Data == nil || eth1Data.DepositCount == 0 || eth1Data.DepositCount != finalizedState.Eth1DepositIndex() {
+		return nil, nil
+	}
+	if s.cfg.BlockFetcher == nil {
+		return nil, nil
+	}
+	saved, err := s.cfg.BeaconDB.DepositSnapshot(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get saved deposit snapshot")
+	}
+	// A new snapshot is also taken when the eth1 data block moves without new deposits, as the snapshot
+	// served to checkpoint syncing nodes must match the execution block of the finalized eth1 data.
+	if saved != nil && (saved.DepositCount > eth1Data.DepositCount ||
+		saved.DepositCount == eth1Data.DepositCount && bytes.Equal(saved.ExecutionBlockHash, eth1Data.BlockHash)) {
+		return nil, nil
+	}
+	snapshot, err := s.cfg.DepositCache.DepositSnapshot(ctx, eth1Data.BlockHash, 0)
+	if err != nil {
+		return nil, err
+	}
+	// The deposit cache may not have caught up with the deposits of the finalized state yet.
+	if snapshot.DepositCount != eth1Data.DepositCount {
+		return nil, nil
+	}
+	if !bytes.Equal(snapshot.DepositRoot, eth1Data.DepositRoot) {
+		return nil, errors.Errorf("finalized deposits root %#x does not match the eth1 data deposit root %#x", snapshot.DepositRoot, eth1Data.DepositRoot)
+	}
+	return snapshot, nil
+}
+
+// saveDepositSnapshot looks up the height of the execution block of the deposit snapshot and saves it,
+// unless a snapshot of more deposits or of as many deposits at a later execution block was saved in the
+// meantime. It queries the execution client, so it runs in the background rather than as part of
+// inserting the finalized deposits.
+func (s *Service) saveDepositSnapshot(snapshot *ethpb.DepositSnapshot) {
+	ctx, cancel := context.WithTimeout(s.ctx, depositDeadline)
+	defer cancel()
+	ctx, span := trace.StartSpan(ctx, "blockChain.saveDepositSnapshot")
+	defer span.End()
+
+	_, height, err := s.cfg.BlockFetcher.BlockExists(ctx, common.BytesToHash(snapshot.ExecutionBlockHash))
+	if err != nil {
+		log.WithError(err).Warnf("Could not get execution block %#x of deposit snapshot", snapshot.ExecutionBlockHash)
+		return
+	}
+	if height == nil {
+		log.Warnf("Execution block %#x of deposit snapshot not found", snapshot.ExecutionBlockHash)
+		return
+	}
+	snapshot.ExecutionBlockHeight = height.Uint64()
+
+	s.depositSnapshotLock.Lock()
+	defer s.depositSnapshotLock.Unlock()
+	saved, err := s.cfg.BeaconDB.DepositSnapshot(ctx)
+	if err != nil {
+		log.WithError(err).Warn("Could not get saved deposit snapshot")
+		return
+	}
+	if saved != nil && (saved.DepositCount > snapshot.DepositCount ||
+		saved.DepositCount == snapshot.DepositCount && saved.ExecutionBlockHeight >= snapshot.ExecutionBlockHeight) {
+		return
+	}
+	if err := s.cfg.BeaconDB.SaveDepositSnapshot(ctx, snapshot); err != nil {
+		log.WithError(err).Warn("Could not save deposit snapshot")
+	}
+}
+
 // The deletes input attestations from the attestation pool, so proposers don't include them in a block for the future.
 func (s *Service) deletePoolAtts(atts []*ethpb.Attestation) error {
 	for _, att := range atts {
@@ -1,6 +1,7 @@
 package blockchain
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"math/big"
@@ -33,6 +34,7 @@ import (
 	consensusblocks "github.com/prysmaticlabs/prysm/v3/consensus-types/blocks"
 	"github.com/prysmaticlabs/prysm/v3/consensus-types/interfaces"
 	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v3/container/trie"
 	"github.com/prysmaticlabs/prysm/v3/crypto/bls"
 	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
 	enginev1 "github.com/prysmaticlabs/prysm/v3/proto/engine/v1"
@@ -1019,6 +1021,129 @@ func TestInsertFinalizedDeposits(t *testing.T) {
 	}
 }
 
+func TestInsertFinalizedDeposits_SavesDepositSnapshot(t *testing.T) {
+	ctx := context.Background()
+	opts := testServiceOptsWithDB(t)
+	depositCache, err := depositcache.New()
+	require.NoError(t, err)
+	executionHash := bytesutil.PadTo([]byte{'h'}, 32)
+	opts = append(opts, WithDepositCache(depositCache), WithExecutionBlockFetcher(&mockExecution.Chain{
+		HashesByHeight: map[int][]byte{120: executionHash},
+	}))
+	service, err := NewService(ctx, opts...)
+	require.NoError(t, err)
+	finalizedTrie := insertSnapshotTestDeposits(t, depositCache, 10, 8)
+	depositRoot, err := finalizedTrie.HashTreeRoot()
+	require.NoError(t, err)
+
+	gs, _ := util.DeterministicGenesisState(t, 32)
+	// Deposits of the eth1 data are still pending inclusion, no snapshot is taken.
+	require.NoError(t, gs.SetEth1Data(&ethpb.Eth1Data{DepositCount: 8, DepositRoot: depositRoot[:], BlockHash: executionHash}))
+	require.NoError(t, gs.SetEth1DepositIndex(6))
+	require.NoError(t, service.cfg.StateGen.SaveState(ctx, [32]byte{'a'}, gs))
+	require.NoError(t, service.insertFinalizedDeposits(ctx, [32]byte{'a'}))
+	snapshot, err := service.cfg.BeaconDB.DepositSnapshot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, (*ethpb.DepositSnapshot)(nil), snapshot)
+
+	gs = gs.Copy()
+	require.NoError(t, gs.SetEth1DepositIndex(8))
+	require.NoError(t, service.cfg.StateGen.SaveState(ctx, [32]byte{'b'}, gs))
+	require.NoError(t, service.insertFinalizedDeposits(ctx, [32]byte{'b'}))
+	// The snapshot is saved in the background once the height of its execution block is known.
+	for i := 0; i < 100 && snapshot == nil; i++ {
+		time.Sleep(10 * time.Millisecond)
+		snapshot, err = service.cfg.BeaconDB.DepositSnapshot(ctx)
+		require.NoError(t, err)
+	}
+	require.NotNil(t, snapshot)
+	assert.Equal(t, uint64(8), snapshot.DepositCount)
+	assert.DeepEqual(t, depositRoot[:], snapshot.DepositRoot)
+	assert.DeepEqual(t, finalizedTrie.SubtreeRoots(), snapshot.Finalized)
+	assert.DeepEqual(t, executionHash, snapshot.ExecutionBlockHash)
+	assert.Equal(t, uint64(120), snapshot.ExecutionBlockHeight)
+}
+
+func TestInsertFinalizedDeposits_DepositSnapshotFollowsEth1DataBlock(t *testing.T) {
+	ctx := context.Background()
+	opts := testServiceOptsWithDB(t)
+	depositCache, err := depositcache.New()
+	require.NoError(t, err)
+	firstHash := bytesutil.PadTo([]byte{'f'}, 32)
+	secondHash := bytesutil.PadTo([]byte{'s'}, 32)
+	opts = append(opts, WithDepositCache(depositCache), WithExecutionBlockFetcher(&mockExecution.Chain{
+		HashesByHeight: map[int][]byte{120: firstHash, 130: secondHash},
+	}))
+	service, err := NewService(ctx, opts...)
+	require.NoError(t, err)
+	finalizedTrie := insertSnapshotTestDeposits(t, depositCache, 8, 8)
+	depositRoot, err := finalizedTrie.HashTreeRoot()
+	require.NoError(t, err)
+
+	waitForSnapshot := func(blockHash []byte) *ethpb.DepositSnapshot {
+		for i := 0; i < 100; i++ {
+			snapshot, err := service.cfg.BeaconDB.DepositSnapshot(ctx)
+			require.NoError(t, err)
+			if snapshot != nil && bytes.Equal(snapshot.ExecutionBlockHash, blockHash) {
+				return snapshot
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+		t.Fatalf("Deposit snapshot at execution block %#x not saved", blockHash)
+		return nil
+	}
+	gs, _ := util.DeterministicGenesisState(t, 32)
+	require.NoError(t, gs.SetEth1Data(&ethpb.Eth1Data{DepositCount: 8, DepositRoot: depositRoot[:], BlockHash: firstHash}))
+	require.NoError(t, gs.SetEth1DepositIndex(8))
+	require.NoError(t, service.cfg.StateGen.SaveState(ctx, [32]byte{'a'}, gs))
+	require.NoError(t, service.insertFinalizedDeposits(ctx, [32]byte{'a'}))
+	snapshot := waitForSnapshot(firstHash)
+	assert.Equal(t, uint64(120), snapshot.ExecutionBlockHeight)
+
+	// The eth1 data block moves without new deposits, the snapshot must match the new block.
+	gs = gs.Copy()
+	eth1Data := &ethpb.Eth1Data{DepositCount: 8, DepositRoot: depositRoot[:], BlockHash: secondHash}
+	require.NoError(t, gs.SetEth1Data(eth1Data))
+	require.NoError(t, service.cfg.StateGen.SaveState(ctx, [32]byte{'b'}, gs))
+	require.NoError(t, service.insertFinalizedDeposits(ctx, [32]byte{'b'}))
+	snapshot = waitForSnapshot(secondHash)
+	assert.Equal(t, uint64(130), snapshot.ExecutionBlockHeight)
+	assert.Equal(t, eth1Data.DepositCount, snapshot.DepositCount)
+	assert.DeepEqual(t, eth1Data.DepositRoot, snapshot.DepositRoot)
+
+	// A snapshot at an earlier execution block does not replace the saved one.
+	older := &ethpb.DepositSnapshot{DepositCount: 8, ExecutionBlockHash: firstHash, DepositRoot: depositRoot[:]}
+	service.saveDepositSnapshot(older)
+	snapshot, err = service.cfg.BeaconDB.DepositSnapshot(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, secondHash, snapshot.ExecutionBlockHash)
+}
+
+// Inserts the given number of deposits into the deposit cache, and returns the trie of the first
+// finalized deposits.
+func insertSnapshotTestDeposits(t *testing.T, depositCache *depositcache.DepositCache, count, finalized int) *trie.SparseMerkleTrie {
+	ctx := context.Background()
+	depositTrie, err := trie.NewTrie(params.BeaconConfig().DepositContractTreeDepth)
+	require.NoError(t, err)
+	zeroSig := [96]byte{}
+	for i := 0; i < count; i++ {
+		d := &ethpb.Deposit{Data: &ethpb.Deposit_Data{
+			PublicKey:             bytesutil.PadTo([]byte{byte(i)}, fieldparams.BLSPubkeyLength),
+			WithdrawalCredentials: params.BeaconConfig().ZeroHash[:],
+			Signature:             zeroSig[:],
+		}}
+		h, err := d.Data.HashTreeRoot()
+		require.NoError(t, err)
+		require.NoError(t, depositTrie.Insert(h[:], i))
+		root, err := depositTrie.HashTreeRoot()
+		require.NoError(t, err)
+		require.NoError(t, depositCache.InsertDeposit(ctx, d, uint64(100+i), int64(i), root))
+	}
+	finalizedTrie, err := trie.GenerateTrieFromItems(depositTrie.Items()[:finalized], params.BeaconConfig().DepositContractTreeDepth)
+	require.NoError(t, err)
+	return finalizedTrie
+}
+
 func TestInsertFinalizedDeposits_MultipleFinalizedRoutines(t *testing.T) {
 	ctx := context.Background()
 	opts := testServiceOptsWithDB(t)
@@ -58,6 +58,8 @@ type Service struct {
 	justifiedBalances       *stateBalanceCache
 	wsVerifier              *WeakSubjectivityVerifier
 	processAttestationsLock sync.Mutex
+	payloadInvalidations    payloadInvalidationLog
+	depositSnapshotLock     sync.Mutex
 }
 
 // config options for the service.
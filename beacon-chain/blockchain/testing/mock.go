@@ -64,6 +64,7 @@ type ChainService struct {
 	ReceiveBlockMockErr         error
 	OptimisticCheckRootReceived [32]byte
 	FinalizedRoots              map[[32]byte]bool
+	PayloadInvalidations        []*ethpb.PayloadInvalidation
 }
 
 // ForkChoicer mocks the same method in the chain service
@@ -460,6 +461,11 @@ func (s *ChainService) IsOptimisticForRoot(_ context.Context, root [32]byte) (bo
 	return s.Optimistic, nil
 }
 
+// RecentPayloadInvalidations mocks the same method in the chain service.
+func (s *ChainService) RecentPayloadInvalidations() []*ethpb.PayloadInvalidation {
+	return s.PayloadInvalidations
+}
+
 // UpdateHead mocks the same method in the chain service.
 func (s *ChainService) UpdateHead(_ context.Context) error { return nil }
 
@@ -0,0 +1,253 @@
+package builder
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/v3/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/v3/config/params"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	ethpbv1 "github.com/prysmaticlabs/prysm/v3/proto/eth/v1"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/time/slots"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// relayCallWindow is the number of relay calls after which the recent relay call and failure counts are
+	// halved, so that older calls weigh less in the failure rate.
+	relayCallWindow = 16
+	// minRelayCalls is the number of recent relay calls below which the failure rate is not considered.
+	minRelayCalls = 4
+)
+
+// CircuitBreakerOpen returns true if the builder is not allowed to be used for the block of the given slot,
+// because the chain or the relays are unhealthy. The state of the circuit breaker is updated, and saved to
+// the DB whenever it changes.
+func (s *Service) CircuitBreakerOpen(ctx context.Context, slot types.Slot) (bool, error) {
+	status, err := s.CircuitBreakerStatus(ctx, slot)
+	if err != nil {
+		return true, err
+	}
+
+	s.breakerLock.Lock()
+	changed := s.breaker.Open != status.Open || s.breaker.Reason != status.Reason ||
+		s.breaker.LastUnhealthySlot != status.LastUnhealthySlot
+	s.breaker.Open = status.Open
+	s.breaker.Reason = status.Reason
+	s.breaker.LastUnhealthySlot = status.LastUnhealthySlot
+	s.breaker.EpochsSinceFinality = status.EpochsSinceFinality
+	s.breakerLock.Unlock()
+
+	if changed {
+		if status.Open {
+			circuitBreakerOpen.Set(1)
+		} else {
+			circuitBreakerOpen.Set(0)
+			log.WithField("slot", slot).Info("Builder circuit breaker closed")
+		}
+		s.saveCircuitBreakerState(ctx)
+	}
+	return status.Open, nil
+}
+
+// CircuitBreakerStatus evaluates the circuit breaker conditions at the given slot and returns the state the
+// circuit breaker would be in, without updating it. Once open, the circuit breaker stays open until the chain
+// has been healthy for an epoch after the last unhealthy slot.
+func (s *Service) CircuitBreakerStatus(_ context.Context, slot types.Slot) (*ethpb.BuilderCircuitBreakerStatus, error) {
+	reason, epochsSinceFinality, err := s.unhealthyReason(slot)
+	if err != nil {
+		return nil, err
+	}
+
+	s.breakerLock.Lock()
+	status := proto.Clone(s.breaker).(*ethpb.BuilderCircuitBreakerStatus)
+	s.breakerLock.Unlock()
+
+	status.EpochsSinceFinality = epochsSinceFinality
+	switch {
+	case reason != "":
+		status.Open = true
+		status.Reason = reason
+		status.LastUnhealthySlot = slot
+	case status.Open && slot < status.LastUnhealthySlot+params.BeaconConfig().SlotsPerEpoch:
+		// The chain has not been healthy for an epoch yet, the circuit breaker stays open for the last reason.
+	default:
+		status.Open = false
+		status.Reason = ""
+	}
+	return status, nil
+}
+
+// Returns the reason the builder must not be used at the given slot, or an empty string if it can be used,
+// along with the number of epochs since finality.
+func (s *Service) unhealthyReason(slot types.Slot) (string, types.Epoch, error) {
+	if s.cfg.forkFetcher == nil || s.cfg.forkFetcher.ForkChoicer() == nil {
+		return "", 0, errors.New("no fork choicer configured")
+	}
+	cfg := params.BeaconConfig()
+
+	var epochsSinceFinality types.Epoch
+	if s.cfg.finalizationFetcher != nil {
+		if cp := s.cfg.finalizationFetcher.FinalizedCheckpt(); cp != nil && slots.ToEpoch(slot) > cp.Epoch {
+			epochsSinceFinality = slots.ToEpoch(slot) - cp.Epoch
+		}
+	}
+
+	// Circuit breaker is active if the missing consecutive slots greater than `MaxBuilderConsecutiveMissedSlots`.
+	highestReceivedSlot := s.cfg.forkFetcher.ForkChoicer().HighestReceivedBlockSlot()
+	maxConsecutiveSkipSlotsAllowed := cfg.MaxBuilderConsecutiveMissedSlots
+	diff, err := slot.SafeSubSlot(highestReceivedSlot)
+	if err != nil {
+		return "", 0, err
+	}
+	if diff > maxConsecutiveSkipSlotsAllowed {
+		log.WithFields(log.Fields{
+			"currentSlot":                    slot,
+			"highestReceivedSlot":            highestReceivedSlot,
+			"maxConsecutiveSkipSlotsAllowed": maxConsecutiveSkipSlotsAllowed,
+		}).Warn("Builder circuit breaker activated due to missing consecutive slot")
+		return "missing consecutive slots", epochsSinceFinality, nil
+	}
+
+	// Not much reason to check missed slots epoch rolling window if input slot is less than epoch.
+	if slot >= cfg.SlotsPerEpoch {
+		// Circuit breaker is active if the missing slots per epoch (rolling window) greater than `MaxBuilderEpochMissedSlots`.
+		receivedCount, err := s.cfg.forkFetcher.ForkChoicer().ReceivedBlocksLastEpoch()
+		if err != nil {
+			return "", 0, err
+		}
+		maxEpochSkipSlotsAllowed := cfg.MaxBuilderEpochMissedSlots
+		diff, err = cfg.SlotsPerEpoch.SafeSub(receivedCount)
+		if err != nil {
+			return "", 0, err
+		}
+		if diff > maxEpochSkipSlotsAllowed {
+			log.WithFields(log.Fields{
+				"totalMissed":              diff,
+				"maxEpochSkipSlotsAllowed": maxEpochSkipSlotsAllowed,
+			}).Warn("Builder circuit breaker activated due to missing enough slots last epoch")
+			return "missing slots last epoch", epochsSinceFinality, nil
+		}
+	}
+
+	// Circuit breaker is active if the epochs since finality are greater than `MaxBuilderEpochsSinceFinality`.
+	if epochsSinceFinality > cfg.MaxBuilderEpochsSinceFinality {
+		log.WithFields(log.Fields{
+			"epochsSinceFinality":    epochsSinceFinality,
+			"maxEpochsSinceFinality": cfg.MaxBuilderEpochsSinceFinality,
+		}).Warn("Builder circuit breaker activated due to finality delay")
+		return "finality delay", epochsSinceFinality, nil
+	}
+
+	s.breakerLock.Lock()
+	reorgSlot, reorgDepth := s.breaker.LastReorgSlot, s.breaker.LastReorgDepth
+	calls, failures := s.breaker.RecentRelayCalls, s.breaker.RecentRelayFailures
+	s.breakerLock.Unlock()
+
+	// Circuit breaker is active if a chain reorg deeper than `MaxBuilderReorgDepth` happened during the last epoch.
+	if reorgDepth > cfg.MaxBuilderReorgDepth && slot < reorgSlot+cfg.SlotsPerEpoch {
+		log.WithFields(log.Fields{
+			"reorgSlot":     reorgSlot,
+			"reorgDepth":    reorgDepth,
+			"maxReorgDepth": cfg.MaxBuilderReorgDepth,
+		}).Warn("Builder circuit breaker activated due to deep chain reorg")
+		return "deep chain reorg", epochsSinceFinality, nil
+	}
+
+	// Circuit breaker is active if the percentage of recent failed relay calls is greater than `MaxBuilderRelayFailureRate`.
+	if calls >= minRelayCalls && failures*100 > calls*cfg.MaxBuilderRelayFailureRate {
+		log.WithFields(log.Fields{
+			"recentRelayCalls":    calls,
+			"recentRelayFailures": failures,
+			"maxRelayFailureRate": cfg.MaxBuilderRelayFailureRate,
+		}).Warn("Builder circuit breaker activated due to relay failures")
+		return "relay failures", epochsSinceFinality, nil
+	}
+
+	return "", epochsSinceFinality, nil
+}
+
+// Records the outcome of a call to a relay in the recent relay failure rate.
+func (s *Service) recordRelayCall(failed bool) {
+	s.breakerLock.Lock()
+	defer s.breakerLock.Unlock()
+	s.breaker.RecentRelayCalls++
+	if failed {
+		s.breaker.RecentRelayFailures++
+	}
+	if s.breaker.RecentRelayCalls >= relayCallWindow {
+		s.breaker.RecentRelayCalls /= 2
+		s.breaker.RecentRelayFailures /= 2
+	}
+}
+
+// Records a chain reorg received from the state feed.
+func (s *Service) recordReorg(ctx context.Context, reorg *ethpbv1.EventChainReorg) {
+	s.breakerLock.Lock()
+	s.breaker.LastReorgSlot = reorg.Slot
+	s.breaker.LastReorgDepth = reorg.Depth
+	s.breakerLock.Unlock()
+	s.saveCircuitBreakerState(ctx)
+}
+
+// Listens to chain reorgs on the state feed until the service stops.
+func (s *Service) subscribeReorgs() {
+	stateChannel := make(chan *feed.Event, 1)
+	stateSub := s.cfg.stateNotifier.StateFeed().Subscribe(stateChannel)
+	defer stateSub.Unsubscribe()
+	for {
+		select {
+		case ev := <-stateChannel:
+			if ev.Type != statefeed.Reorg {
+				continue
+			}
+			data, ok := ev.Data.(*ethpbv1.EventChainReorg)
+			if !ok {
+				log.Errorf("Received incorrect data type over reorg feed: %v", ev.Data)
+				continue
+			}
+			s.recordReorg(s.ctx, data)
+		case err := <-stateSub.Err():
+			log.WithError(err).Error("Could not subscribe to state feed")
+			return
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+// Loads the circuit breaker state saved in the DB, if any.
+func (s *Service) loadCircuitBreakerState(ctx context.Context) error {
+	if s.cfg.beaconDB == nil {
+		return nil
+	}
+	status, err := s.cfg.beaconDB.BuilderCircuitBreakerState(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not load builder circuit breaker state")
+	}
+	if status != nil {
+		s.breakerLock.Lock()
+		s.breaker = status
+		s.breakerLock.Unlock()
+		if status.Open {
+			circuitBreakerOpen.Set(1)
+		}
+	}
+	return nil
+}
+
+// Saves the circuit breaker state to the DB, logging on failure since the state can be rebuilt.
+func (s *Service) saveCircuitBreakerState(ctx context.Context) {
+	if s.cfg.beaconDB == nil {
+		return
+	}
+	s.breakerLock.Lock()
+	status := proto.Clone(s.breaker).(*ethpb.BuilderCircuitBreakerStatus)
+	s.breakerLock.Unlock()
+	if err := s.cfg.beaconDB.SaveBuilderCircuitBreakerState(ctx, status); err != nil {
+		log.WithError(err).Error("Could not save builder circuit breaker state")
+	}
+}
@@ -0,0 +1,227 @@
+package builder
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	blockchainTesting "github.com/prysmaticlabs/prysm/v3/beacon-chain/blockchain/testing"
+	dbtesting "github.com/prysmaticlabs/prysm/v3/beacon-chain/db/testing"
+	doublylinkedtree "github.com/prysmaticlabs/prysm/v3/beacon-chain/forkchoice/doubly-linked-tree"
+	"github.com/prysmaticlabs/prysm/v3/config/params"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	ethpbv1 "github.com/prysmaticlabs/prysm/v3/proto/eth/v1"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+	"github.com/prysmaticlabs/prysm/v3/testing/util"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+func TestService_CircuitBreakerOpen_MissedSlots(t *testing.T) {
+	hook := logTest.NewGlobal()
+	ctx := context.Background()
+	s, err := NewService(ctx)
+	require.NoError(t, err)
+	_, err = s.CircuitBreakerOpen(ctx, 0)
+	require.ErrorContains(t, "no fork choicer configured", err)
+
+	forkFetcher := &blockchainTesting.ChainService{ForkChoiceStore: doublylinkedtree.New()}
+	forkFetcher.ForkChoicer().SetGenesisTime(uint64(time.Now().Unix()))
+	s, err = NewService(ctx, WithForkFetcher(forkFetcher))
+	require.NoError(t, err)
+	b, err := s.CircuitBreakerOpen(ctx, params.BeaconConfig().MaxBuilderConsecutiveMissedSlots+1)
+	require.NoError(t, err)
+	require.Equal(t, true, b)
+	require.LogsContain(t, hook, "Builder circuit breaker activated due to missing consecutive slot")
+
+	ojc := &ethpb.Checkpoint{Root: params.BeaconConfig().ZeroHash[:]}
+	ofc := &ethpb.Checkpoint{Root: params.BeaconConfig().ZeroHash[:]}
+	st, blkRoot, err := util.NewForkchoiceStateBellatrix(1, [32]byte{'a'}, [32]byte{}, params.BeaconConfig().ZeroHash, ojc, ofc)
+	require.NoError(t, err)
+	require.NoError(t, forkFetcher.ForkChoicer().InsertNode(ctx, st, blkRoot))
+	// A new service, as an open circuit breaker stays open for an epoch.
+	s, err = NewService(ctx, WithForkFetcher(forkFetcher))
+	require.NoError(t, err)
+	b, err = s.CircuitBreakerOpen(ctx, params.BeaconConfig().MaxBuilderConsecutiveMissedSlots+1)
+	require.NoError(t, err)
+	require.Equal(t, false, b)
+
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.MaxBuilderEpochMissedSlots = 4
+	params.OverrideBeaconConfig(cfg)
+	st, blkRoot, err = util.NewForkchoiceStateBellatrix(params.BeaconConfig().SlotsPerEpoch, [32]byte{'b'}, [32]byte{'a'}, params.BeaconConfig().ZeroHash, ojc, ofc)
+	require.NoError(t, err)
+	require.NoError(t, forkFetcher.ForkChoicer().InsertNode(ctx, st, blkRoot))
+	b, err = s.CircuitBreakerOpen(ctx, params.BeaconConfig().SlotsPerEpoch+1)
+	require.NoError(t, err)
+	require.Equal(t, true, b)
+	require.LogsContain(t, hook, "Builder circuit breaker activated due to missing enough slots last epoch")
+
+	want := params.BeaconConfig().SlotsPerEpoch - params.BeaconConfig().MaxBuilderEpochMissedSlots
+	for i := types.Slot(2); i <= want+2; i++ {
+		st, blkRoot, err = util.NewForkchoiceStateBellatrix(i, [32]byte{byte(i)}, [32]byte{'a'}, params.BeaconConfig().ZeroHash, ojc, ofc)
+		require.NoError(t, err)
+		require.NoError(t, forkFetcher.ForkChoicer().InsertNode(ctx, st, blkRoot))
+	}
+	s, err = NewService(ctx, WithForkFetcher(forkFetcher))
+	require.NoError(t, err)
+	b, err = s.CircuitBreakerOpen(ctx, params.BeaconConfig().SlotsPerEpoch+1)
+	require.NoError(t, err)
+	require.Equal(t, false, b)
+}
+
+func TestService_CircuitBreakerOpen_FinalityDelay(t *testing.T) {
+	ignoreMissedSlots(t)
+	hook := logTest.NewGlobal()
+	ctx := context.Background()
+	chain := &blockchainTesting.ChainService{ForkChoiceStore: doublylinkedtree.New(), FinalizedCheckPoint: &ethpb.Checkpoint{Epoch: 1}}
+	s, err := NewService(ctx, WithForkFetcher(chain), WithFinalizationFetcher(chain))
+	require.NoError(t, err)
+
+	slot := params.BeaconConfig().SlotsPerEpoch.Mul(uint64(1 + params.BeaconConfig().MaxBuilderEpochsSinceFinality))
+	status, err := s.CircuitBreakerStatus(ctx, slot)
+	require.NoError(t, err)
+	assert.Equal(t, false, status.Open)
+	assert.Equal(t, params.BeaconConfig().MaxBuilderEpochsSinceFinality, status.EpochsSinceFinality)
+
+	slot += params.BeaconConfig().SlotsPerEpoch
+	status, err = s.CircuitBreakerStatus(ctx, slot)
+	require.NoError(t, err)
+	assert.Equal(t, true, status.Open)
+	assert.Equal(t, "finality delay", status.Reason)
+	assert.Equal(t, slot, status.LastUnhealthySlot)
+	require.LogsContain(t, hook, "Builder circuit breaker activated due to finality delay")
+}
+
+func TestService_CircuitBreakerOpen_Reorg(t *testing.T) {
+	hook := logTest.NewGlobal()
+	ctx := context.Background()
+	chain := &blockchainTesting.ChainService{ForkChoiceStore: doublylinkedtree.New()}
+	s, err := NewService(ctx, WithForkFetcher(chain))
+	require.NoError(t, err)
+
+	s.recordReorg(ctx, &ethpbv1.EventChainReorg{Slot: 1, Depth: params.BeaconConfig().MaxBuilderReorgDepth})
+	b, err := s.CircuitBreakerOpen(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, false, b)
+
+	s.recordReorg(ctx, &ethpbv1.EventChainReorg{Slot: 1, Depth: params.BeaconConfig().MaxBuilderReorgDepth + 1})
+	b, err = s.CircuitBreakerOpen(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, true, b)
+	require.LogsContain(t, hook, "Builder circuit breaker activated due to deep chain reorg")
+
+	// The reorg is not considered anymore an epoch after it happened.
+	chain.ForkChoicer().SetGenesisTime(uint64(time.Now().Unix()))
+	ojc := &ethpb.Checkpoint{Root: params.BeaconConfig().ZeroHash[:]}
+	ofc := &ethpb.Checkpoint{Root: params.BeaconConfig().ZeroHash[:]}
+	slot := params.BeaconConfig().SlotsPerEpoch + 1
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.MaxBuilderEpochMissedSlots = params.BeaconConfig().SlotsPerEpoch
+	params.OverrideBeaconConfig(cfg)
+	st, blkRoot, err := util.NewForkchoiceStateBellatrix(slot, [32]byte{'a'}, [32]byte{}, params.BeaconConfig().ZeroHash, ojc, ofc)
+	require.NoError(t, err)
+	require.NoError(t, chain.ForkChoicer().InsertNode(ctx, st, blkRoot))
+	b, err = s.CircuitBreakerOpen(ctx, slot)
+	require.NoError(t, err)
+	assert.Equal(t, false, b)
+}
+
+func TestService_CircuitBreakerOpen_RelayFailures(t *testing.T) {
+	ignoreMissedSlots(t)
+	hook := logTest.NewGlobal()
+	ctx := context.Background()
+	chain := &blockchainTesting.ChainService{ForkChoiceStore: doublylinkedtree.New()}
+	s, err := NewService(ctx, WithForkFetcher(chain))
+	require.NoError(t, err)
+
+	// Too few calls to consider the failure rate.
+	for i := 0; i < minRelayCalls-1; i++ {
+		s.recordRelayCall(true)
+	}
+	b, err := s.CircuitBreakerOpen(ctx, 0)
+	require.NoError(t, err)
+	assert.Equal(t, false, b)
+
+	s.recordRelayCall(true)
+	b, err = s.CircuitBreakerOpen(ctx, 0)
+	require.NoError(t, err)
+	assert.Equal(t, true, b)
+	require.LogsContain(t, hook, "Builder circuit breaker activated due to relay failures")
+
+	// Successful calls bring the failure rate back under the limit, with older calls weighing less.
+	for i := 0; i < relayCallWindow; i++ {
+		s.recordRelayCall(false)
+	}
+	status, err := s.CircuitBreakerStatus(ctx, 0)
+	require.NoError(t, err)
+	assert.Equal(t, true, status.RecentRelayCalls < relayCallWindow)
+	// The circuit breaker stays open until the relays have been healthy for an epoch.
+	assert.Equal(t, true, status.Open)
+	assert.Equal(t, "relay failures", status.Reason)
+	status, err = s.CircuitBreakerStatus(ctx, params.BeaconConfig().SlotsPerEpoch)
+	require.NoError(t, err)
+	assert.Equal(t, false, status.Open)
+}
+
+func TestService_CircuitBreakerState_Persisted(t *testing.T) {
+	ignoreMissedSlots(t)
+	ctx := context.Background()
+	db := dbtesting.SetupDB(t)
+	chain := &blockchainTesting.ChainService{ForkChoiceStore: doublylinkedtree.New()}
+	s, err := NewService(ctx, WithDatabase(db), WithForkFetcher(chain))
+	require.NoError(t, err)
+
+	s.recordReorg(ctx, &ethpbv1.EventChainReorg{Slot: 10, Depth: params.BeaconConfig().MaxBuilderReorgDepth + 1})
+	b, err := s.CircuitBreakerOpen(ctx, 10)
+	require.NoError(t, err)
+	require.Equal(t, true, b)
+	// The reorg is still considered at the last slot of the epoch after it.
+	lastUnhealthySlot := 10 + params.BeaconConfig().SlotsPerEpoch - 1
+	b, err = s.CircuitBreakerOpen(ctx, lastUnhealthySlot)
+	require.NoError(t, err)
+	require.Equal(t, true, b)
+	require.NoError(t, s.Stop())
+
+	// A restarted service keeps the circuit breaker open until the chain has been healthy for an epoch.
+	s, err = NewService(ctx, WithDatabase(db), WithForkFetcher(chain))
+	require.NoError(t, err)
+	status, err := s.CircuitBreakerStatus(ctx, lastUnhealthySlot+1)
+	require.NoError(t, err)
+	assert.Equal(t, true, status.Open)
+	assert.Equal(t, "deep chain reorg", status.Reason)
+	assert.Equal(t, lastUnhealthySlot, status.LastUnhealthySlot)
+	assert.Equal(t, types.Slot(10), status.LastReorgSlot)
+
+	closedSlot := lastUnhealthySlot + params.BeaconConfig().SlotsPerEpoch
+	status, err = s.CircuitBreakerStatus(ctx, closedSlot-1)
+	require.NoError(t, err)
+	assert.Equal(t, true, status.Open)
+	status, err = s.CircuitBreakerStatus(ctx, closedSlot)
+	require.NoError(t, err)
+	assert.Equal(t, false, status.Open)
+
+	// Getting the status does not change the saved state.
+	saved, err := db.BuilderCircuitBreakerState(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, true, saved.Open)
+
+	b, err = s.CircuitBreakerOpen(ctx, closedSlot)
+	require.NoError(t, err)
+	assert.Equal(t, false, b)
+	saved, err = db.BuilderCircuitBreakerState(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, false, saved.Open)
+}
+
+// Raises the missed slot limits of the circuit breaker, so that tests without blocks can check other conditions.
+func ignoreMissedSlots(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.MaxBuilderConsecutiveMissedSlots = params.BeaconConfig().FarFutureSlot
+	cfg.MaxBuilderEpochMissedSlots = params.BeaconConfig().SlotsPerEpoch
+	params.OverrideBeaconConfig(cfg)
+}
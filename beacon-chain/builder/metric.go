@@ -28,3 +28,34 @@ var (
 		},
 	)
 )
+
+var (
+	relayGetHeaderLatency = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "relay_get_header_latency_milliseconds",
+			Help:    "Captures RPC latency for get header of each relay in milliseconds",
+			Buckets: []float64{1, 2, 5, 10, 20, 50, 100, 200, 500, 1000},
+		},
+		[]string{"relay"},
+	)
+	relayBidValue = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "relay_bid_value_gwei",
+			Help: "The value in gwei of the last valid bid received from each relay",
+		},
+		[]string{"relay"},
+	)
+	relayFailures = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "relay_failures_total",
+			Help: "The number of failed calls to each relay, by method",
+		},
+		[]string{"relay", "method"},
+	)
+	circuitBreakerOpen = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "builder_circuit_breaker_open",
+			Help: "Whether the builder circuit breaker is open (1) or closed (0)",
+		},
+	)
+)
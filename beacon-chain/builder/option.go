@@ -3,6 +3,7 @@ package builder
 import (
 	"github.com/prysmaticlabs/prysm/v3/api/client/builder"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/blockchain"
+	statefeed "github.com/prysmaticlabs/prysm/v3/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/v3/cmd/beacon-chain/flags"
 	"github.com/urfave/cli/v2"
@@ -12,25 +13,32 @@ type Option func(s *Service) error
 
 // FlagOptions for builder service flag configurations.
 func FlagOptions(c *cli.Context) ([]Option, error) {
-	endpoint := c.String(flags.MevRelayEndpoint.Name)
-	var client *builder.Client
-	if endpoint != "" {
-		var err error
-		client, err = builder.NewClient(endpoint)
+	var clients []builder.BuilderClient
+	for _, endpoint := range c.StringSlice(flags.MevRelayEndpoint.Name) {
+		if endpoint == "" {
+			continue
+		}
+		client, err := builder.NewClient(endpoint)
 		if err != nil {
 			return nil, err
 		}
+		clients = append(clients, client)
 	}
 	opts := []Option{
-		WithBuilderClient(client),
+		WithBuilderClients(clients...),
 	}
 	return opts, nil
 }
 
-// WithBuilderClient sets the builder client for the beacon chain builder service.
+// WithBuilderClient adds a builder client for the beacon chain builder service.
 func WithBuilderClient(client builder.BuilderClient) Option {
+	return WithBuilderClients(client)
+}
+
+// WithBuilderClients adds builder clients of several relays for the beacon chain builder service.
+func WithBuilderClients(clients ...builder.BuilderClient) Option {
 	return func(s *Service) error {
-		s.cfg.builderClient = client
+		s.cfg.builderClients = append(s.cfg.builderClients, clients...)
 		return nil
 	}
 }
@@ -43,6 +51,30 @@ func WithHeadFetcher(svc blockchain.HeadFetcher) Option {
 	}
 }
 
+// WithForkFetcher gets the fork choice store to check for missed slots.
+func WithForkFetcher(svc blockchain.ForkFetcher) Option {
+	return func(s *Service) error {
+		s.cfg.forkFetcher = svc
+		return nil
+	}
+}
+
+// WithFinalizationFetcher gets the finalized checkpoint to check for finality delay.
+func WithFinalizationFetcher(svc blockchain.FinalizationFetcher) Option {
+	return func(s *Service) error {
+		s.cfg.finalizationFetcher = svc
+		return nil
+	}
+}
+
+// WithStateNotifier subscribes to chain reorgs.
+func WithStateNotifier(notifier statefeed.Notifier) Option {
+	return func(s *Service) error {
+		s.cfg.stateNotifier = notifier
+		return nil
+	}
+}
+
 // WithDatabase for head access.
 func WithDatabase(beaconDB db.HeadAccessDatabase) Option {
 	return func(s *Service) error {
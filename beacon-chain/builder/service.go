@@ -1,18 +1,30 @@
 package builder
 
 import (
+	"bytes"
 	"context"
+	"fmt"
+	"math/big"
+	"net/url"
 	"reflect"
+	"strings"
+	"sync"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v3/api/client/builder"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/blockchain"
+	statefeed "github.com/prysmaticlabs/prysm/v3/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/signing"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/db"
+	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v3/config/params"
 	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
 	v1 "github.com/prysmaticlabs/prysm/v3/proto/engine/v1"
 	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/time/slots"
 	log "github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 )
@@ -26,62 +38,105 @@ type BlockBuilder interface {
 	GetHeader(ctx context.Context, slot types.Slot, parentHash [32]byte, pubKey [48]byte) (*ethpb.SignedBuilderBid, error)
 	RegisterValidator(ctx context.Context, reg []*ethpb.SignedValidatorRegistrationV1) error
 	Configured() bool
+	CircuitBreakerOpen(ctx context.Context, slot types.Slot) (bool, error)
+	CircuitBreakerStatus(ctx context.Context, slot types.Slot) (*ethpb.BuilderCircuitBreakerStatus, error)
 }
 
 // config defines a config struct for dependencies into the service.
 type config struct {
-	builderClient builder.BuilderClient
-	beaconDB      db.HeadAccessDatabase
-	headFetcher   blockchain.HeadFetcher
+	builderClients      []builder.BuilderClient
+	beaconDB            db.HeadAccessDatabase
+	headFetcher         blockchain.HeadFetcher
+	forkFetcher         blockchain.ForkFetcher
+	finalizationFetcher blockchain.FinalizationFetcher
+	stateNotifier       statefeed.Notifier
+}
+
+// relay is a builder client of one MEV relay, named after its host in logs and metrics. The bids of
+// the relay must be signed with the public key in its endpoint, when there is one.
+type relay struct {
+	client builder.BuilderClient
+	name   string
+	pubKey []byte
+}
+
+// winningBid is the relay whose bid won the header auction of a slot.
+type winningBid struct {
+	relay *relay
+	slot  types.Slot
 }
 
 // Service defines a service that provides a client for interacting with the beacon chain and MEV relay network.
 type Service struct {
-	cfg    *config
-	c      builder.BuilderClient
-	ctx    context.Context
-	cancel context.CancelFunc
+	cfg         *config
+	relays      []*relay
+	winners     map[[32]byte]*winningBid
+	winnersLock sync.Mutex
+	breaker     *ethpb.BuilderCircuitBreakerStatus
+	breakerLock sync.Mutex
+	ctx         context.Context
+	cancel      context.CancelFunc
 }
 
 // NewService instantiates a new service.
 func NewService(ctx context.Context, opts ...Option) (*Service, error) {
 	ctx, cancel := context.WithCancel(ctx)
 	s := &Service{
-		ctx:    ctx,
-		cancel: cancel,
-		cfg:    &config{},
+		ctx:     ctx,
+		cancel:  cancel,
+		cfg:     &config{},
+		winners: make(map[[32]byte]*winningBid),
+		breaker: &ethpb.BuilderCircuitBreakerStatus{},
 	}
 	for _, opt := range opts {
 		if err := opt(s); err != nil {
 			return nil, err
 		}
 	}
-	if s.cfg.builderClient != nil && !reflect.ValueOf(s.cfg.builderClient).IsNil() {
-		s.c = s.cfg.builderClient
+	if err := s.loadCircuitBreakerState(ctx); err != nil {
+		return nil, err
+	}
+	for _, c := range s.cfg.builderClients {
+		if c == nil || reflect.ValueOf(c).IsNil() {
+			continue
+		}
+		pubKey, err := relayPubKey(c.NodeURL())
+		if err != nil {
+			return nil, err
+		}
+		r := &relay{client: c, name: relayName(c.NodeURL()), pubKey: pubKey}
+		s.relays = append(s.relays, r)
 
 		// Is the builder up?
-		if err := s.c.Status(ctx); err != nil {
-			log.WithError(err).Error("Failed to check builder status")
+		if err := c.Status(ctx); err != nil {
+			log.WithError(err).WithField("relay", r.name).Error("Failed to check builder status")
 		} else {
-			log.WithField("endpoint", s.c.NodeURL()).Info("Builder has been configured")
-			log.Warn("Outsourcing block construction to external builders adds non-trivial delay to block propagation time.  " +
-				"Builder-constructed blocks or fallback blocks may get orphaned. Use at your own risk!")
+			log.WithField("endpoint", r.name).Info("Builder has been configured")
 		}
 	}
+	if len(s.relays) > 0 {
+		log.Warn("Outsourcing block construction to external builders adds non-trivial delay to block propagation time.  " +
+			"Builder-constructed blocks or fallback blocks may get orphaned. Use at your own risk!")
+	}
 	return s, nil
 }
 
 // Start initializes the service.
 func (s *Service) Start() {
 	go s.pollRelayerStatus(s.ctx)
+	if s.cfg.stateNotifier != nil {
+		go s.subscribeReorgs()
+	}
 }
 
-// Stop halts the service.
-func (*Service) Stop() error {
+// Stop halts the service and saves the circuit breaker state.
+func (s *Service) Stop() error {
+	s.cancel()
+	s.saveCircuitBreakerState(context.Background())
 	return nil
 }
 
-// SubmitBlindedBlock submits a blinded block to the builder relay network.
+// SubmitBlindedBlock submits a blinded block to the relay whose bid won the header of the block.
 func (s *Service) SubmitBlindedBlock(ctx context.Context, b *ethpb.SignedBlindedBeaconBlockBellatrix) (*v1.ExecutionPayload, error) {
 	ctx, span := trace.StartSpan(ctx, "builder.SubmitBlindedBlock")
 	defer span.End()
@@ -90,10 +145,22 @@ func (s *Service) SubmitBlindedBlock(ctx context.Context, b *ethpb.SignedBlinded
 		submitBlindedBlockLatency.Observe(float64(time.Since(start).Milliseconds()))
 	}()
 
-	return s.c.SubmitBlindedBlock(ctx, b)
+	r, err := s.winningRelay(b)
+	if err != nil {
+		return nil, err
+	}
+	payload, err := r.client.SubmitBlindedBlock(ctx, b)
+	s.recordRelayCall(err != nil)
+	if err != nil {
+		relayFailures.WithLabelValues(r.name, "submit_blinded_block").Inc()
+		return nil, errors.Wrapf(err, "could not submit blinded block to relay %s", r.name)
+	}
+	return payload, nil
 }
 
-// GetHeader retrieves the header for a given slot and parent hash from the builder relay network.
+// GetHeader requests the header for a given slot and parent hash from all relays in parallel, within the
+// deadline of the context, and returns the valid bid of the highest value. Bids with an invalid signature,
+// a wrong parent hash or for a fork the builder API does not support are discarded.
 func (s *Service) GetHeader(ctx context.Context, slot types.Slot, parentHash [32]byte, pubKey [48]byte) (*ethpb.SignedBuilderBid, error) {
 	ctx, span := trace.StartSpan(ctx, "builder.GetHeader")
 	defer span.End()
@@ -102,21 +169,74 @@ func (s *Service) GetHeader(ctx context.Context, slot types.Slot, parentHash [32
 		getHeaderLatency.Observe(float64(time.Since(start).Milliseconds()))
 	}()
 
-	return s.c.GetHeader(ctx, slot, parentHash, pubKey)
+	if slots.ToEpoch(slot) < params.BeaconConfig().BellatrixForkEpoch {
+		return nil, errors.Errorf("slot %d is before the bellatrix fork", slot)
+	}
+	if len(s.relays) == 0 {
+		return nil, ErrNoBuilder
+	}
+
+	bids := make([]*ethpb.SignedBuilderBid, len(s.relays))
+	errs := make([]error, len(s.relays))
+	var wg sync.WaitGroup
+	for i, r := range s.relays {
+		wg.Add(1)
+		go func(i int, r *relay) {
+			defer wg.Done()
+			bids[i], errs[i] = s.relayHeader(ctx, r, slot, parentHash, pubKey)
+		}(i, r)
+	}
+	wg.Wait()
+
+	var best int
+	var bestValue *big.Int
+	for i, bid := range bids {
+		s.recordRelayCall(errs[i] != nil)
+		if errs[i] != nil {
+			relayFailures.WithLabelValues(s.relays[i].name, "get_header").Inc()
+			log.WithError(errs[i]).WithField("relay", s.relays[i].name).Warn("Could not get a valid header from relay")
+			continue
+		}
+		if v := bidValue(bid); bestValue == nil || v.Cmp(bestValue) > 0 {
+			best, bestValue = i, v
+		}
+	}
+	if bestValue == nil {
+		if len(s.relays) == 1 {
+			return nil, errs[0]
+		}
+		return nil, errors.Errorf("no valid header received from any of %d relays", len(s.relays))
+	}
+
+	s.winnersLock.Lock()
+	for hash, w := range s.winners {
+		if w.slot < slot {
+			delete(s.winners, hash)
+		}
+	}
+	s.winners[bytesutil.ToBytes32(bids[best].Message.Header.BlockHash)] = &winningBid{relay: s.relays[best], slot: slot}
+	s.winnersLock.Unlock()
+
+	log.WithFields(log.Fields{
+		"slot":  slot,
+		"relay": s.relays[best].name,
+		"value": bestValue.String(),
+	}).Debug("Selected the highest bid among relays")
+	return bids[best], nil
 }
 
 // Status retrieves the status of the builder relay network.
 func (s *Service) Status() error {
 	// Return early if builder isn't initialized in service.
-	if s.c == nil {
+	if len(s.relays) == 0 {
 		return nil
 	}
 
 	return nil
 }
 
-// RegisterValidator registers a validator with the builder relay network.
-// It also saves the registration object to the DB.
+// RegisterValidator registers a validator with all relays of the builder relay network.
+// It also saves the registration object to the DB, unless no relay accepted the registrations.
 func (s *Service) RegisterValidator(ctx context.Context, reg []*ethpb.SignedValidatorRegistrationV1) error {
 	ctx, span := trace.StartSpan(ctx, "builder.RegisterValidator")
 	defer span.End()
@@ -141,16 +261,117 @@ func (s *Service) RegisterValidator(ctx context.Context, reg []*ethpb.SignedVali
 		msgs = append(msgs, r.Message)
 		valid = append(valid, r)
 	}
-	if err := s.c.RegisterValidator(ctx, valid); err != nil {
-		return errors.Wrap(err, "could not register validator(s)")
+
+	errs := make([]error, len(s.relays))
+	var wg sync.WaitGroup
+	for i, r := range s.relays {
+		wg.Add(1)
+		go func(i int, r *relay) {
+			defer wg.Done()
+			errs[i] = r.client.RegisterValidator(ctx, valid)
+		}(i, r)
+	}
+	wg.Wait()
+	var failed int
+	for i, err := range errs {
+		if err != nil {
+			relayFailures.WithLabelValues(s.relays[i].name, "register_validator").Inc()
+			log.WithError(err).WithField("relay", s.relays[i].name).Error("Could not register validators with relay")
+			failed++
+		}
+	}
+	if failed == len(s.relays) {
+		if failed == 1 {
+			return errors.Wrap(errs[0], "could not register validator(s)")
+		}
+		return errors.Errorf("could not register validator(s) with any of %d relays", failed)
 	}
 
 	return s.cfg.beaconDB.SaveRegistrationsByValidatorIDs(ctx, idxs, msgs)
 }
 
-// Configured returns true if the user has configured a builder client.
+// Configured returns true if the user has configured at least one builder client.
 func (s *Service) Configured() bool {
-	return s.c != nil && !reflect.ValueOf(s.c).IsNil()
+	return len(s.relays) > 0
+}
+
+// Requests the header from a relay and verifies its bid.
+func (s *Service) relayHeader(ctx context.Context, r *relay, slot types.Slot, parentHash [32]byte, pubKey [48]byte) (*ethpb.SignedBuilderBid, error) {
+	start := time.Now()
+	bid, err := r.client.GetHeader(ctx, slot, parentHash, pubKey)
+	relayGetHeaderLatency.WithLabelValues(r.name).Observe(float64(time.Since(start).Milliseconds()))
+	if err != nil {
+		return nil, err
+	}
+	if bid == nil || bid.Message == nil || bid.Message.Header == nil {
+		return nil, errors.New("relay returned nil bid")
+	}
+	if !bytes.Equal(bid.Message.Header.ParentHash, parentHash[:]) {
+		return nil, fmt.Errorf("incorrect parent hash %#x != %#x", bid.Message.Header.ParentHash, parentHash)
+	}
+	if len(r.pubKey) != 0 && !bytes.Equal(bid.Message.Pubkey, r.pubKey) {
+		return nil, fmt.Errorf("incorrect bid public key %#x != relay public key %#x", bid.Message.Pubkey, r.pubKey)
+	}
+	d, err := signing.ComputeDomain(params.BeaconConfig().DomainApplicationBuilder,
+		nil, /* fork version */
+		nil /* genesis val root */)
+	if err != nil {
+		return nil, err
+	}
+	if err := signing.VerifySigningRoot(bid.Message, bid.Message.Pubkey, bid.Signature, d); err != nil {
+		return nil, errors.Wrap(err, "could not validate builder signature")
+	}
+	gwei, _ := new(big.Float).Quo(new(big.Float).SetInt(bidValue(bid)), big.NewFloat(float64(params.BeaconConfig().GweiPerEth))).Float64()
+	relayBidValue.WithLabelValues(r.name).Set(gwei)
+	return bid, nil
+}
+
+// Returns the relay to submit a blinded block to, which is the relay whose bid won its header.
+func (s *Service) winningRelay(b *ethpb.SignedBlindedBeaconBlockBellatrix) (*relay, error) {
+	if len(s.relays) == 0 {
+		return nil, ErrNoBuilder
+	}
+	if len(s.relays) == 1 {
+		return s.relays[0], nil
+	}
+	if b == nil || b.Block == nil || b.Block.Body == nil || b.Block.Body.ExecutionPayloadHeader == nil {
+		return nil, errors.New("nil blinded block")
+	}
+	hash := bytesutil.ToBytes32(b.Block.Body.ExecutionPayloadHeader.BlockHash)
+	s.winnersLock.Lock()
+	defer s.winnersLock.Unlock()
+	w, ok := s.winners[hash]
+	if !ok {
+		return nil, errors.Errorf("no relay bid for execution block hash %#x", hash)
+	}
+	return w.relay, nil
+}
+
+// Returns the value of a bid in wei, which is encoded as a little endian uint256.
+func bidValue(bid *ethpb.SignedBuilderBid) *big.Int {
+	return new(big.Int).SetBytes(bytesutil.ReverseByteOrder(bid.Message.Value))
+}
+
+// Returns the host of a relay endpoint, leaving out the user info that holds the relay public key.
+func relayName(endpoint string) string {
+	u, err := url.Parse(endpoint)
+	if err != nil || u.Host == "" {
+		return endpoint
+	}
+	return u.Host
+}
+
+// Returns the relay public key held in the user info of a relay endpoint, or nil if the endpoint has none.
+func relayPubKey(endpoint string) ([]byte, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil || u.User == nil || !strings.HasPrefix(u.User.Username(), "0x") {
+		return nil, nil
+	}
+	pubKey, err := hexutil.Decode(u.User.Username())
+	if err != nil || len(pubKey) != fieldparams.BLSPubkeyLength {
+		return nil, errors.Errorf("invalid public key of relay %s", relayName(endpoint))
+	}
+	return pubKey, nil
 }
 
 func (s *Service) pollRelayerStatus(ctx context.Context) {
@@ -159,9 +380,10 @@ func (s *Service) pollRelayerStatus(ctx context.Context) {
 	for {
 		select {
 		case <-ticker.C:
-			if s.c != nil {
-				if err := s.c.Status(ctx); err != nil {
-					log.WithError(err).Error("Failed to call relayer status endpoint, perhaps mev-boost or relayers are down")
+			for _, r := range s.relays {
+				if err := r.client.Status(ctx); err != nil {
+					relayFailures.WithLabelValues(r.name, "status").Inc()
+					log.WithError(err).WithField("relay", r.name).Error("Failed to call relayer status endpoint, perhaps mev-boost or relayers are down")
 				}
 			}
 		case <-ctx.Done():
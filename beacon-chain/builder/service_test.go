@@ -2,12 +2,19 @@ package builder
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"testing"
 
 	buildertesting "github.com/prysmaticlabs/prysm/v3/api/client/builder/testing"
 	blockchainTesting "github.com/prysmaticlabs/prysm/v3/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/signing"
 	dbtesting "github.com/prysmaticlabs/prysm/v3/beacon-chain/db/testing"
+	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v3/config/params"
+	"github.com/prysmaticlabs/prysm/v3/crypto/bls"
 	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
+	v1 "github.com/prysmaticlabs/prysm/v3/proto/engine/v1"
 	eth "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v3/testing/assert"
 	"github.com/prysmaticlabs/prysm/v3/testing/require"
@@ -37,3 +44,130 @@ func Test_RegisterValidator(t *testing.T) {
 	require.NoError(t, s.RegisterValidator(ctx, []*eth.SignedValidatorRegistrationV1{{Message: &eth.ValidatorRegistrationV1{Pubkey: pubkey[:], FeeRecipient: feeRecipient[:]}}}))
 	assert.Equal(t, true, builder.RegisteredVals[pubkey])
 }
+
+func Test_RegisterValidator_MultipleRelays(t *testing.T) {
+	ctx := context.Background()
+	db := dbtesting.SetupDB(t)
+	headFetcher := &blockchainTesting.ChainService{}
+	up := buildertesting.NewClient()
+	up.URL = "http://up:18550"
+	down := buildertesting.NewClient()
+	down.URL = "http://down:18550"
+	down.Err = errors.New("relay down")
+	s, err := NewService(ctx, WithDatabase(db), WithHeadFetcher(headFetcher), WithBuilderClients(&up, &down))
+	require.NoError(t, err)
+	pubkey := bytesutil.ToBytes48([]byte("pubkey"))
+	var feeRecipient [20]byte
+	reg := []*eth.SignedValidatorRegistrationV1{{Message: &eth.ValidatorRegistrationV1{Pubkey: pubkey[:], FeeRecipient: feeRecipient[:]}}}
+	require.NoError(t, s.RegisterValidator(ctx, reg))
+	assert.Equal(t, true, up.RegisteredVals[pubkey])
+
+	s, err = NewService(ctx, WithDatabase(db), WithHeadFetcher(headFetcher), WithBuilderClients(&down, &down))
+	require.NoError(t, err)
+	require.ErrorContains(t, "could not register validator(s) with any of 2 relays", s.RegisterValidator(ctx, reg))
+}
+
+func Test_GetHeader_HighestValidBid(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.BellatrixForkEpoch = 0
+	params.OverrideBeaconConfig(cfg)
+	ctx := context.Background()
+	parentHash := bytesutil.ToBytes32([]byte("parent"))
+	low := buildertesting.NewClient()
+	low.URL = "http://low:18550"
+	low.Bid = testSignedBid(t, parentHash[:], []byte("low"), 1, true)
+	low.Payload = &v1.ExecutionPayload{BlockNumber: 1}
+	high := buildertesting.NewClient()
+	high.Bid = testSignedBid(t, parentHash[:], []byte("high"), 5, true)
+	high.URL = fmt.Sprintf("http://%#x@high:18550", high.Bid.Message.Pubkey)
+	high.Payload = &v1.ExecutionPayload{BlockNumber: 5}
+	wrongPubKey := buildertesting.NewClient()
+	wrongPubKey.Bid = testSignedBid(t, parentHash[:], []byte("wrongpubkey"), 10, true)
+	wrongPubKey.URL = fmt.Sprintf("http://%#x@wrongpubkey:18550", high.Bid.Message.Pubkey)
+	badSig := buildertesting.NewClient()
+	badSig.URL = "http://badsig:18550"
+	badSig.Bid = testSignedBid(t, parentHash[:], []byte("badsig"), 10, false)
+	wrongParent := buildertesting.NewClient()
+	wrongParent.URL = "http://wrongparent:18550"
+	wrongParent.Bid = testSignedBid(t, []byte("other"), []byte("wrongparent"), 10, true)
+	failing := buildertesting.NewClient()
+	failing.URL = "http://failing:18550"
+	failing.Err = errors.New("timeout")
+
+	s, err := NewService(ctx, WithBuilderClients(&low, &badSig, &high, &wrongParent, &failing, &wrongPubKey))
+	require.NoError(t, err)
+	bid, err := s.GetHeader(ctx, 1, parentHash, [48]byte{})
+	require.NoError(t, err)
+	assert.DeepEqual(t, high.Bid, bid)
+	assert.Equal(t, "high:18550", s.relays[2].name)
+
+	// The blinded block goes to the relay that won the bid.
+	blk := &eth.SignedBlindedBeaconBlockBellatrix{Block: &eth.BlindedBeaconBlockBellatrix{
+		Body: &eth.BlindedBeaconBlockBodyBellatrix{ExecutionPayloadHeader: bid.Message.Header},
+	}}
+	payload, err := s.SubmitBlindedBlock(ctx, blk)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(5), payload.BlockNumber)
+
+	blk.Block.Body.ExecutionPayloadHeader = low.Bid.Message.Header
+	_, err = s.SubmitBlindedBlock(ctx, blk)
+	require.ErrorContains(t, "no relay bid for execution block hash", err)
+}
+
+func Test_NewService_InvalidRelayPubKey(t *testing.T) {
+	c := buildertesting.NewClient()
+	c.URL = "http://0xabcd@relay:18550"
+	_, err := NewService(context.Background(), WithBuilderClient(&c))
+	require.ErrorContains(t, "invalid public key of relay relay:18550", err)
+}
+
+func Test_GetHeader_NoValidBid(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.BellatrixForkEpoch = 0
+	params.OverrideBeaconConfig(cfg)
+	ctx := context.Background()
+	parentHash := bytesutil.ToBytes32([]byte("parent"))
+	failing := buildertesting.NewClient()
+	failing.Err = errors.New("timeout")
+	s, err := NewService(ctx, WithBuilderClient(&failing))
+	require.NoError(t, err)
+	_, err = s.GetHeader(ctx, 1, parentHash, [48]byte{})
+	require.ErrorContains(t, "timeout", err)
+
+	badSig := buildertesting.NewClient()
+	badSig.Bid = testSignedBid(t, parentHash[:], []byte("badsig"), 10, false)
+	s, err = NewService(ctx, WithBuilderClients(&failing, &badSig))
+	require.NoError(t, err)
+	_, err = s.GetHeader(ctx, 1, parentHash, [48]byte{})
+	require.ErrorContains(t, "no valid header received from any of 2 relays", err)
+}
+
+func testSignedBid(t *testing.T, parentHash, blockHash []byte, value uint64, validSig bool) *eth.SignedBuilderBid {
+	sk, err := bls.RandKey()
+	require.NoError(t, err)
+	bid := &eth.BuilderBid{
+		Header: &v1.ExecutionPayloadHeader{
+			ParentHash:       bytesutil.PadTo(parentHash, fieldparams.RootLength),
+			FeeRecipient:     make([]byte, fieldparams.FeeRecipientLength),
+			StateRoot:        make([]byte, fieldparams.RootLength),
+			ReceiptsRoot:     make([]byte, fieldparams.RootLength),
+			LogsBloom:        make([]byte, fieldparams.LogsBloomLength),
+			PrevRandao:       make([]byte, fieldparams.RootLength),
+			BaseFeePerGas:    make([]byte, fieldparams.RootLength),
+			BlockHash:        bytesutil.PadTo(blockHash, fieldparams.RootLength),
+			TransactionsRoot: make([]byte, fieldparams.RootLength),
+		},
+		Pubkey: sk.PublicKey().Marshal(),
+		Value:  bytesutil.PadTo(bytesutil.Uint64ToBytesLittleEndian(value), 32),
+	}
+	domain, err := signing.ComputeDomain(params.BeaconConfig().DomainApplicationBuilder, nil, nil)
+	require.NoError(t, err)
+	sr, err := signing.ComputeSigningRoot(bid, domain)
+	require.NoError(t, err)
+	if !validSig {
+		sr = bytesutil.ToBytes32([]byte("wrong root"))
+	}
+	return &eth.SignedBuilderBid{Message: bid, Signature: sk.Sign(sr[:]).Marshal()}
+}
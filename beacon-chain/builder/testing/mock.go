@@ -16,6 +16,8 @@ type MockBuilderService struct {
 	Bid                   *ethpb.SignedBuilderBid
 	ErrGetHeader          error
 	ErrRegisterValidator  error
+	CircuitBreaker        *ethpb.BuilderCircuitBreakerStatus
+	ErrCircuitBreaker     error
 }
 
 // Configured for mocking.
@@ -37,3 +39,19 @@ func (s *MockBuilderService) GetHeader(context.Context, types.Slot, [32]byte, [4
 func (s *MockBuilderService) RegisterValidator(context.Context, []*ethpb.SignedValidatorRegistrationV1) error {
 	return s.ErrRegisterValidator
 }
+
+// CircuitBreakerOpen for mocking.
+func (s *MockBuilderService) CircuitBreakerOpen(context.Context, types.Slot) (bool, error) {
+	if s.ErrCircuitBreaker != nil {
+		return true, s.ErrCircuitBreaker
+	}
+	return s.CircuitBreaker != nil && s.CircuitBreaker.Open, nil
+}
+
+// CircuitBreakerStatus for mocking.
+func (s *MockBuilderService) CircuitBreakerStatus(context.Context, types.Slot) (*ethpb.BuilderCircuitBreakerStatus, error) {
+	if s.CircuitBreaker == nil {
+		return &ethpb.BuilderCircuitBreakerStatus{}, s.ErrCircuitBreaker
+	}
+	return s.CircuitBreaker, s.ErrCircuitBreaker
+}
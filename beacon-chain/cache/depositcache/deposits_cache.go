@@ -55,6 +55,11 @@ type DepositCache struct {
 	finalizedDeposits *FinalizedDeposits
 	depositsByKey     map[[fieldparams.BLSPubkeyLength]byte][]*ethpb.DepositContainer
 	depositsLock      sync.RWMutex
+	// Finalized deposits restored from a deposit snapshot are only known through the finalized
+	// deposits trie, deposits then only holds the deposits which follow them.
+	snapshotDepositCount int64
+	snapshotDepositRoot  [32]byte
+	snapshotBlockHeight  uint64
 }
 
 // New instantiates a new deposit cache
@@ -91,8 +96,8 @@ func (dc *DepositCache) InsertDeposit(ctx context.Context, d *ethpb.Deposit, blo
 	dc.depositsLock.Lock()
 	defer dc.depositsLock.Unlock()
 
-	if int(index) != len(dc.deposits) {
-		return errors.Errorf("wanted deposit with index %d to be inserted but received %d", len(dc.deposits), index)
+	if index != dc.nextDepositIndex() {
+		return errors.Errorf("wanted deposit with index %d to be inserted but received %d", dc.nextDepositIndex(), index)
 	}
 	// Keep the slice sorted on insertion in order to avoid costly sorting on retrieval.
 	heightIdx := sort.Search(len(dc.deposits), func(i int) bool { return dc.deposits[i].Index >= index })
@@ -145,8 +150,8 @@ func (dc *DepositCache) InsertFinalizedDeposits(ctx context.Context, eth1Deposit
 	}
 	// In the event we have less deposits than we need to
 	// finalize we finalize till the index on which we do have it.
-	if len(dc.deposits) <= int(eth1DepositIndex) {
-		eth1DepositIndex = int64(len(dc.deposits)) - 1
+	if dc.nextDepositIndex() <= eth1DepositIndex {
+		eth1DepositIndex = dc.nextDepositIndex() - 1
 	}
 	// If we finalize to some lower deposit index, we
 	// ignore it.
@@ -224,19 +229,25 @@ func (dc *DepositCache) allDeposits(untilBlk *big.Int) []*ethpb.Deposit {
 }
 
 // DepositsNumberAndRootAtHeight returns number of deposits made up to blockheight and the
-// root that corresponds to the latest deposit at that blockheight.
+// root that corresponds to the latest deposit at that blockheight. When the cache was restored
+// from a deposit snapshot, heights from the execution block of the snapshot until the first deposit
+// following it are reported with the deposit count and root of the snapshot. The deposits before
+// the execution block of the snapshot are unknown, so earlier heights are reported with no deposits.
 func (dc *DepositCache) DepositsNumberAndRootAtHeight(ctx context.Context, blockHeight *big.Int) (uint64, [32]byte) {
 	ctx, span := trace.StartSpan(ctx, "DepositsCache.DepositsNumberAndRootAtHeight")
 	defer span.End()
 	dc.depositsLock.RLock()
 	defer dc.depositsLock.RUnlock()
+	if dc.snapshotDepositCount > 0 && blockHeight.Uint64() < dc.snapshotBlockHeight {
+		return 0, [32]byte{}
+	}
 	heightIdx := sort.Search(len(dc.deposits), func(i int) bool { return dc.deposits[i].Eth1BlockHeight > blockHeight.Uint64() })
 	// send the deposit root of the empty trie, if eth1follow distance is greater than the time of the earliest
 	// deposit.
 	if heightIdx == 0 {
-		return 0, [32]byte{}
+		return uint64(dc.snapshotDepositCount), dc.snapshotDepositRoot
 	}
-	return uint64(heightIdx), bytesutil.ToBytes32(dc.deposits[heightIdx-1].DepositRoot)
+	return uint64(dc.snapshotDepositCount) + uint64(heightIdx), bytesutil.ToBytes32(dc.deposits[heightIdx-1].DepositRoot)
 }
 
 // DepositByPubkey looks through historical deposits and finds one which contains
@@ -303,6 +314,8 @@ func (dc *DepositCache) PruneProofs(ctx context.Context, untilDepositIndex int64
 	dc.depositsLock.Lock()
 	defer dc.depositsLock.Unlock()
 
+	// Deposits restored from a snapshot hold no proofs.
+	untilDepositIndex -= dc.snapshotDepositCount
 	if untilDepositIndex >= int64(len(dc.deposits)) {
 		untilDepositIndex = int64(len(dc.deposits) - 1)
 	}
@@ -320,3 +333,73 @@ func (dc *DepositCache) PruneProofs(ctx context.Context, untilDepositIndex int64
 
 	return nil
 }
+
+// InsertDepositSnapshot restores the finalized deposits from an EIP-4881 deposit snapshot. Deposits
+// covered by the snapshot are removed from the cache, the cache then only holds the deposits which follow them.
+func (dc *DepositCache) InsertDepositSnapshot(ctx context.Context, snapshot *ethpb.DepositSnapshot) error {
+	ctx, span := trace.StartSpan(ctx, "DepositsCache.InsertDepositSnapshot")
+	defer span.End()
+	if snapshot == nil {
+		return errors.New("nil deposit snapshot")
+	}
+	depositTrie, err := trie.GenerateTrieFromSubtreeRoots(snapshot.Finalized, snapshot.DepositCount, params.BeaconConfig().DepositContractTreeDepth)
+	if err != nil {
+		return errors.Wrap(err, "could not generate deposit trie from snapshot")
+	}
+	root, err := depositTrie.HashTreeRoot()
+	if err != nil {
+		return errors.Wrap(err, "could not compute deposit root")
+	}
+	if root != bytesutil.ToBytes32(snapshot.DepositRoot) {
+		return errors.Errorf("deposit snapshot root %#x does not match the root of its finalized deposits %#x", snapshot.DepositRoot, root)
+	}
+	count := int64(snapshot.DepositCount) // lint:ignore uintcast -- Deposit count should not exceed int64 in your lifetime.
+
+	dc.depositsLock.Lock()
+	defer dc.depositsLock.Unlock()
+
+	deposits := make([]*ethpb.DepositContainer, 0, len(dc.deposits))
+	depositsByKey := make(map[[fieldparams.BLSPubkeyLength]byte][]*ethpb.DepositContainer)
+	for _, d := range dc.deposits {
+		if d.Index < count {
+			continue
+		}
+		deposits = append(deposits, d)
+		pubkey := bytesutil.ToBytes48(d.Deposit.Data.PublicKey)
+		depositsByKey[pubkey] = append(depositsByKey[pubkey], d)
+	}
+	dc.deposits = deposits
+	dc.depositsByKey = depositsByKey
+	dc.finalizedDeposits = &FinalizedDeposits{Deposits: depositTrie, MerkleTrieIndex: count - 1}
+	dc.snapshotDepositCount = count
+	dc.snapshotDepositRoot = root
+	dc.snapshotBlockHeight = snapshot.ExecutionBlockHeight
+	return nil
+}
+
+// DepositSnapshot returns the EIP-4881 snapshot of the finalized deposits trie. The execution block
+// is the block at which the finalized deposits were observed, it is recorded as is in the snapshot.
+func (dc *DepositCache) DepositSnapshot(ctx context.Context, executionHash []byte, executionHeight uint64) (*ethpb.DepositSnapshot, error) {
+	ctx, span := trace.StartSpan(ctx, "DepositsCache.DepositSnapshot")
+	defer span.End()
+	dc.depositsLock.RLock()
+	defer dc.depositsLock.RUnlock()
+
+	depositTrie := dc.finalizedDeposits.Deposits
+	root, err := depositTrie.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute deposit root")
+	}
+	return &ethpb.DepositSnapshot{
+		Finalized:            depositTrie.SubtreeRoots(),
+		DepositRoot:          root[:],
+		DepositCount:         uint64(depositTrie.NumOfItems()),
+		ExecutionBlockHash:   bytesutil.SafeCopyBytes(executionHash),
+		ExecutionBlockHeight: executionHeight,
+	}, nil
+}
+
+// nextDepositIndex returns the index of the next deposit to be inserted. It requires a lock on deposits.
+func (dc *DepositCache) nextDepositIndex() int64 {
+	return dc.snapshotDepositCount + int64(len(dc.deposits))
+}
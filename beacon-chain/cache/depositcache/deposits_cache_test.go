@@ -1056,3 +1056,98 @@ func makeDepositProof() [][]byte {
 	}
 	return proof
 }
+
+func TestDepositSnapshot_RoundTrip(t *testing.T) {
+	ctx := context.Background()
+	source, err := New()
+	require.NoError(t, err)
+	depositTrie, err := trie.NewTrie(params.BeaconConfig().DepositContractTreeDepth)
+	require.NoError(t, err)
+	roots := make([][32]byte, 0)
+	deposits := make([]*ethpb.DepositContainer, 0)
+	for i := 0; i < 10; i++ {
+		d := &ethpb.Deposit{
+			Data: &ethpb.Deposit_Data{
+				PublicKey:             bytesutil.PadTo([]byte{byte(i)}, 48),
+				WithdrawalCredentials: make([]byte, 32),
+				Signature:             make([]byte, 96),
+			},
+			Proof: [][]byte{{byte(i)}},
+		}
+		h, err := d.Data.HashTreeRoot()
+		require.NoError(t, err)
+		require.NoError(t, depositTrie.Insert(h[:], i))
+		root, err := depositTrie.HashTreeRoot()
+		require.NoError(t, err)
+		roots = append(roots, root)
+		blockNum := uint64(100 + i)
+		require.NoError(t, source.InsertDeposit(ctx, d, blockNum, int64(i), root))
+		deposits = append(deposits, &ethpb.DepositContainer{Deposit: d, Eth1BlockHeight: blockNum, Index: int64(i), DepositRoot: root[:]})
+	}
+	source.InsertFinalizedDeposits(ctx, 6)
+
+	executionHash := bytesutil.PadTo([]byte{'h'}, 32)
+	snapshot, err := source.DepositSnapshot(ctx, executionHash, 106)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(7), snapshot.DepositCount)
+	assert.DeepEqual(t, roots[6][:], snapshot.DepositRoot)
+	assert.DeepEqual(t, executionHash, snapshot.ExecutionBlockHash)
+	assert.Equal(t, uint64(106), snapshot.ExecutionBlockHeight)
+	// The finalized deposits are covered by a subtree of 4 deposits, one of 2 and one of 1.
+	assert.Equal(t, 3, len(snapshot.Finalized))
+
+	dc, err := New()
+	require.NoError(t, err)
+	dc.InsertDepositContainers(ctx, deposits[:8])
+	require.NoError(t, dc.InsertDepositSnapshot(ctx, snapshot))
+	assert.Equal(t, 1, len(dc.AllDepositContainers(ctx)))
+	fDeposits := dc.FinalizedDeposits(ctx)
+	assert.Equal(t, int64(6), fDeposits.MerkleTrieIndex)
+	root, err := fDeposits.Deposits.HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, roots[6], root)
+	// Deposits covered by the snapshot are no longer known by public key.
+	d, _ := dc.DepositByPubkey(ctx, deposits[0].Deposit.Data.PublicKey)
+	assert.Equal(t, (*ethpb.Deposit)(nil), d)
+
+	// Deposits following the snapshot are inserted and finalized on top of it.
+	assert.ErrorContains(t, "wanted deposit with index 8 to be inserted but received 3", dc.InsertDeposit(ctx, deposits[3].Deposit, 103, 3, roots[3]))
+	for _, c := range deposits[8:] {
+		require.NoError(t, dc.InsertDeposit(ctx, c.Deposit, c.Eth1BlockHeight, c.Index, bytesutil.ToBytes32(c.DepositRoot)))
+	}
+	dc.InsertFinalizedDeposits(ctx, 9)
+	fDeposits = dc.FinalizedDeposits(ctx)
+	assert.Equal(t, int64(9), fDeposits.MerkleTrieIndex)
+	root, err = fDeposits.Deposits.HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, roots[9], root)
+
+	count, root := dc.DepositsNumberAndRootAtHeight(ctx, big.NewInt(106))
+	assert.Equal(t, uint64(7), count)
+	assert.Equal(t, roots[6], root)
+	// The deposits before the execution block of the snapshot are unknown.
+	count, root = dc.DepositsNumberAndRootAtHeight(ctx, big.NewInt(105))
+	assert.Equal(t, uint64(0), count)
+	assert.Equal(t, [32]byte{}, root)
+	count, root = dc.DepositsNumberAndRootAtHeight(ctx, big.NewInt(108))
+	assert.Equal(t, uint64(9), count)
+	assert.Equal(t, roots[8], root)
+
+	require.NoError(t, dc.PruneProofs(ctx, 8))
+	ctrs := dc.AllDepositContainers(ctx)
+	assert.DeepEqual(t, [][]byte(nil), ctrs[1].Deposit.Proof)
+	assert.DeepEqual(t, [][]byte{{9}}, ctrs[2].Deposit.Proof)
+}
+
+func TestInsertDepositSnapshot_InvalidRoot(t *testing.T) {
+	dc, err := New()
+	require.NoError(t, err)
+	snapshot := &ethpb.DepositSnapshot{
+		Finalized:    [][]byte{bytesutil.PadTo([]byte{'a'}, 32)},
+		DepositRoot:  bytesutil.PadTo([]byte{'r'}, 32),
+		DepositCount: 1,
+	}
+	assert.ErrorContains(t, "does not match the root of its finalized deposits", dc.InsertDepositSnapshot(context.Background(), snapshot))
+	snapshot.DepositCount = 3
+	assert.ErrorContains(t, "could not generate deposit trie from snapshot", dc.InsertDepositSnapshot(context.Background(), snapshot))
+}
@@ -26,6 +26,11 @@ const (
 	FinalizedCheckpoint
 	// NewHead of the chain event.
 	NewHead
+	// PayloadValidated is sent when the execution payload of a previously optimistic block is validated.
+	PayloadValidated
+	// PayloadInvalidated is sent when an execution payload is reported invalid and the
+	// affected blocks are removed from fork choice.
+	PayloadInvalidated
 )
 
 // BlockProcessedData is the data sent with BlockProcessed events.
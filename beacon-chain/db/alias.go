@@ -26,6 +26,9 @@ type Database = iface.Database
 // SlasherDatabase defines necessary methods for Prysm's slasher implementation.
 type SlasherDatabase = iface.SlasherDatabase
 
+// EncodedBlock is a signed beacon block in the SSZ form it is persisted with in the database.
+type EncodedBlock = iface.EncodedBlock
+
 // ErrExistingGenesisState is an error when the user attempts to save a different genesis state
 // when one already exists in a database.
 var ErrExistingGenesisState = iface.ErrExistingGenesisState
@@ -0,0 +1,13 @@
+package iface
+
+// EncodedBlock is a signed beacon block in the SSZ form it is persisted with in the database.
+// It allows callers which only forward blocks, such as p2p request handlers, to avoid the
+// cost of unmarshaling them.
+type EncodedBlock struct {
+	// Version is the fork version of the block, as defined in runtime/version.
+	Version int
+	// Blinded is true if the block was stored with its execution payload replaced by a header.
+	Blinded bool
+	// SSZ is the SSZ encoding of the signed block.
+	SSZ []byte
+}
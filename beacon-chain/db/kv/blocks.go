@@ -10,6 +10,7 @@ import (
 	"github.com/pkg/errors"
 	ssz "github.com/prysmaticlabs/fastssz"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/db/filters"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/db/iface"
 	"github.com/prysmaticlabs/prysm/v3/config/features"
 	"github.com/prysmaticlabs/prysm/v3/config/params"
 	"github.com/prysmaticlabs/prysm/v3/consensus-types/blocks"
@@ -49,6 +50,30 @@ func (s *Store) Block(ctx context.Context, blockRoot [32]byte) (interfaces.Signe
 	return blk, err
 }
 
+// BlockSSZ retrieves the SSZ encoding of a block by root as it is stored in the database,
+// without unmarshaling it. A nil value is returned if no block exists for the given root.
+func (s *Store) BlockSSZ(ctx context.Context, blockRoot [32]byte) (*iface.EncodedBlock, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.BlockSSZ")
+	defer span.End()
+	var enc []byte
+	err := s.db.View(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(blocksBucket)
+		// The value returned by Get is only valid for the life of the transaction,
+		// decoding it copies the block out of the memory mapped region.
+		v := bkt.Get(blockRoot[:])
+		if v == nil {
+			return nil
+		}
+		var err error
+		enc, err = snappy.Decode(nil, v)
+		return errors.Wrap(err, "could not snappy decode block")
+	})
+	if err != nil || enc == nil {
+		return nil, err
+	}
+	return encodedBlock(enc), nil
+}
+
 // OriginCheckpointBlockRoot returns the value written to the db in SaveOriginCheckpointBlockRoot
 // This is the root of a finalized block within the weak subjectivity period
 // at the time the chain was started, used to initialize the database and chain
@@ -799,6 +824,21 @@ func unmarshalBlock(_ context.Context, enc []byte) (interfaces.SignedBeaconBlock
 	return blocks.NewSignedBeaconBlock(rawBlock)
 }
 
+// encodedBlock strips the fork prefix from a snappy decoded block entry, recording the
+// fork version and blindness it denotes.
+func encodedBlock(enc []byte) *iface.EncodedBlock {
+	switch {
+	case hasAltairKey(enc):
+		return &iface.EncodedBlock{Version: version.Altair, SSZ: enc[len(altairKey):]}
+	case hasBellatrixKey(enc):
+		return &iface.EncodedBlock{Version: version.Bellatrix, SSZ: enc[len(bellatrixKey):]}
+	case hasBellatrixBlindKey(enc):
+		return &iface.EncodedBlock{Version: version.Bellatrix, Blinded: true, SSZ: enc[len(bellatrixBlindKey):]}
+	default:
+		return &iface.EncodedBlock{Version: version.Phase0, SSZ: enc}
+	}
+}
+
 // marshal versioned beacon block from struct type down to bytes.
 func marshalBlock(_ context.Context, blk interfaces.SignedBeaconBlock) ([]byte, error) {
 	var encodedBlock []byte
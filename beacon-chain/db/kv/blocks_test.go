@@ -7,6 +7,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/db/filters"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/db/iface"
 	"github.com/prysmaticlabs/prysm/v3/config/params"
 	"github.com/prysmaticlabs/prysm/v3/consensus-types/blocks"
 	"github.com/prysmaticlabs/prysm/v3/consensus-types/interfaces"
@@ -153,6 +154,39 @@ func TestStore_BlocksCRUD(t *testing.T) {
 	}
 }
 
+func TestStore_BlockSSZ(t *testing.T) {
+	ctx := context.Background()
+
+	for _, tt := range blockTests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := setupDB(t)
+
+			blk, err := tt.newBlock(types.Slot(20), bytesutil.PadTo([]byte{1, 2, 3}, 32))
+			require.NoError(t, err)
+			blockRoot, err := blk.Block().HashTreeRoot()
+			require.NoError(t, err)
+
+			enc, err := db.BlockSSZ(ctx, blockRoot)
+			require.NoError(t, err)
+			assert.Equal(t, (*iface.EncodedBlock)(nil), enc, "Expected nil encoding")
+
+			require.NoError(t, db.SaveBlock(ctx, blk))
+			enc, err = db.BlockSSZ(ctx, blockRoot)
+			require.NoError(t, err)
+			require.NotNil(t, enc)
+			assert.Equal(t, blk.Version(), enc.Version)
+			wanted := blk
+			if enc.Blinded {
+				wanted, err = blk.ToBlinded()
+				require.NoError(t, err)
+			}
+			wantedSSZ, err := wanted.MarshalSSZ()
+			require.NoError(t, err)
+			assert.DeepEqual(t, wantedSSZ, enc.SSZ)
+		})
+	}
+}
+
 func TestStore_BlocksHandleZeroCase(t *testing.T) {
 	for _, tt := range blockTests {
 		t.Run(tt.name, func(t *testing.T) {
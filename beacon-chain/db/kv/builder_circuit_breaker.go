@@ -0,0 +1,54 @@
+package kv
+
+import (
+	"context"
+	"errors"
+
+	"github.com/prysmaticlabs/prysm/v3/monitoring/tracing"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+	"google.golang.org/protobuf/proto"
+)
+
+// SaveBuilderCircuitBreakerState saves the state of the builder circuit breaker, so that it survives restarts.
+func (s *Store) SaveBuilderCircuitBreakerState(ctx context.Context, status *ethpb.BuilderCircuitBreakerStatus) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveBuilderCircuitBreakerState")
+	defer span.End()
+
+	if status == nil {
+		err := errors.New("cannot save nil builder circuit breaker state")
+		tracing.AnnotateError(span, err)
+		return err
+	}
+
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(chainMetadataBucket)
+		enc, err := proto.Marshal(status)
+		if err != nil {
+			return err
+		}
+		return bkt.Put(builderCircuitBreakerKey, enc)
+	})
+	tracing.AnnotateError(span, err)
+	return err
+}
+
+// BuilderCircuitBreakerState retrieves the saved state of the builder circuit breaker. It returns nil if no
+// state has been saved.
+func (s *Store) BuilderCircuitBreakerState(ctx context.Context) (*ethpb.BuilderCircuitBreakerStatus, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.BuilderCircuitBreakerState")
+	defer span.End()
+
+	var status *ethpb.BuilderCircuitBreakerStatus
+	err := s.db.View(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(chainMetadataBucket)
+		enc := bkt.Get(builderCircuitBreakerKey)
+		if len(enc) == 0 {
+			return nil
+		}
+		status = &ethpb.BuilderCircuitBreakerStatus{}
+		return proto.Unmarshal(enc, status)
+	})
+	return status, err
+}
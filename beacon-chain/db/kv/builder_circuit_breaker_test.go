@@ -0,0 +1,35 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+)
+
+func TestStore_BuilderCircuitBreakerState(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	status, err := db.BuilderCircuitBreakerState(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, (*ethpb.BuilderCircuitBreakerStatus)(nil), status)
+
+	require.ErrorContains(t, "cannot save nil builder circuit breaker state", db.SaveBuilderCircuitBreakerState(ctx, nil))
+
+	want := &ethpb.BuilderCircuitBreakerStatus{
+		Open:                true,
+		Reason:              "chain reorg too deep",
+		LastUnhealthySlot:   100,
+		LastReorgSlot:       99,
+		LastReorgDepth:      3,
+		RecentRelayCalls:    10,
+		RecentRelayFailures: 2,
+	}
+	require.NoError(t, db.SaveBuilderCircuitBreakerState(ctx, want))
+	status, err = db.BuilderCircuitBreakerState(ctx)
+	require.NoError(t, err)
+	require.DeepSSZEqual(t, want, status)
+}
@@ -0,0 +1,54 @@
+package kv
+
+import (
+	"context"
+	"errors"
+
+	"github.com/prysmaticlabs/prysm/v3/monitoring/tracing"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+	"google.golang.org/protobuf/proto"
+)
+
+// SaveDepositSnapshot saves the EIP-4881 snapshot of the finalized deposit tree.
+func (s *Store) SaveDepositSnapshot(ctx context.Context, snapshot *ethpb.DepositSnapshot) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveDepositSnapshot")
+	defer span.End()
+
+	if snapshot == nil {
+		err := errors.New("cannot save nil deposit snapshot")
+		tracing.AnnotateError(span, err)
+		return err
+	}
+
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(powchainBucket)
+		enc, err := proto.Marshal(snapshot)
+		if err != nil {
+			return err
+		}
+		return bkt.Put(depositSnapshotKey, enc)
+	})
+	tracing.AnnotateError(span, err)
+	return err
+}
+
+// DepositSnapshot retrieves the saved EIP-4881 snapshot of the finalized deposit tree. It returns nil if no
+// snapshot has been saved.
+func (s *Store) DepositSnapshot(ctx context.Context) (*ethpb.DepositSnapshot, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.DepositSnapshot")
+	defer span.End()
+
+	var snapshot *ethpb.DepositSnapshot
+	err := s.db.View(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(powchainBucket)
+		enc := bkt.Get(depositSnapshotKey)
+		if len(enc) == 0 {
+			return nil
+		}
+		snapshot = &ethpb.DepositSnapshot{}
+		return proto.Unmarshal(enc, snapshot)
+	})
+	return snapshot, err
+}
@@ -0,0 +1,34 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+)
+
+func TestStore_DepositSnapshot(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	snapshot, err := db.DepositSnapshot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, (*ethpb.DepositSnapshot)(nil), snapshot)
+
+	require.ErrorContains(t, "cannot save nil deposit snapshot", db.SaveDepositSnapshot(ctx, nil))
+
+	want := &ethpb.DepositSnapshot{
+		Finalized:            [][]byte{bytesutil.PadTo([]byte{'a'}, 32), bytesutil.PadTo([]byte{'b'}, 32)},
+		DepositRoot:          bytesutil.PadTo([]byte{'r'}, 32),
+		DepositCount:         3,
+		ExecutionBlockHash:   bytesutil.PadTo([]byte{'h'}, 32),
+		ExecutionBlockHeight: 100,
+	}
+	require.NoError(t, db.SaveDepositSnapshot(ctx, want))
+	snapshot, err = db.DepositSnapshot(ctx)
+	require.NoError(t, err)
+	require.DeepSSZEqual(t, want, snapshot)
+}
@@ -0,0 +1,65 @@
+package kv
+
+import (
+	"context"
+	"errors"
+
+	"github.com/prysmaticlabs/prysm/v3/monitoring/tracing"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+	"google.golang.org/protobuf/proto"
+)
+
+// SaveOperationPools saves the contents of the operation pools, so that pending operations survive restarts.
+func (s *Store) SaveOperationPools(ctx context.Context, pools *ethpb.OperationPools) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveOperationPools")
+	defer span.End()
+
+	if pools == nil {
+		err := errors.New("cannot save nil operation pools")
+		tracing.AnnotateError(span, err)
+		return err
+	}
+
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(chainMetadataBucket)
+		enc, err := proto.Marshal(pools)
+		if err != nil {
+			return err
+		}
+		return bkt.Put(operationPoolsKey, enc)
+	})
+	tracing.AnnotateError(span, err)
+	return err
+}
+
+// OperationPools retrieves the saved contents of the operation pools. It returns nil if no contents have been saved.
+func (s *Store) OperationPools(ctx context.Context) (*ethpb.OperationPools, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.OperationPools")
+	defer span.End()
+
+	var pools *ethpb.OperationPools
+	err := s.db.View(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(chainMetadataBucket)
+		enc := bkt.Get(operationPoolsKey)
+		if len(enc) == 0 {
+			return nil
+		}
+		pools = &ethpb.OperationPools{}
+		return proto.Unmarshal(enc, pools)
+	})
+	return pools, err
+}
+
+// DeleteOperationPools deletes the saved contents of the operation pools, once they have been restored.
+func (s *Store) DeleteOperationPools(ctx context.Context) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.DeleteOperationPools")
+	defer span.End()
+
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(chainMetadataBucket).Delete(operationPoolsKey)
+	})
+	tracing.AnnotateError(span, err)
+	return err
+}
@@ -0,0 +1,49 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+	"github.com/prysmaticlabs/prysm/v3/testing/util"
+)
+
+func TestStore_OperationPools(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	pools, err := db.OperationPools(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, (*ethpb.OperationPools)(nil), pools)
+
+	require.ErrorContains(t, "cannot save nil operation pools", db.SaveOperationPools(ctx, nil))
+
+	att := util.HydrateAttestation(&ethpb.Attestation{AggregationBits: []byte{0b1101}})
+	want := &ethpb.OperationPools{
+		AggregatedAttestations: []*ethpb.Attestation{att},
+		SyncCommitteeMessages: []*ethpb.SyncCommitteeMessage{{
+			Slot:           1,
+			BlockRoot:      bytesutil.PadTo([]byte{'a'}, 32),
+			ValidatorIndex: 2,
+			Signature:      make([]byte, 96),
+		}},
+		VoluntaryExits: []*ethpb.SignedVoluntaryExit{{
+			Exit:      &ethpb.VoluntaryExit{Epoch: 3, ValidatorIndex: 4},
+			Signature: make([]byte, 96),
+		}},
+	}
+	require.NoError(t, db.SaveOperationPools(ctx, want))
+	pools, err = db.OperationPools(ctx)
+	require.NoError(t, err)
+	require.DeepEqual(t, want, pools)
+
+	require.NoError(t, db.DeleteOperationPools(ctx))
+	pools, err = db.OperationPools(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, (*ethpb.OperationPools)(nil), pools)
+	// Deleting pools which are not saved is a no-op.
+	require.NoError(t, db.DeleteOperationPools(ctx))
+}
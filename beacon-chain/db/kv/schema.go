@@ -45,7 +45,10 @@ var (
 	justifiedCheckpointKey     = []byte("justified-checkpoint")
 	finalizedCheckpointKey     = []byte("finalized-checkpoint")
 	powchainDataKey            = []byte("powchain-data")
+	depositSnapshotKey         = []byte("deposit-snapshot")
 	lastValidatedCheckpointKey = []byte("last-validated-checkpoint")
+	builderCircuitBreakerKey   = []byte("builder-circuit-breaker")
+	operationPoolsKey          = []byte("operation-pools")
 
 	// Below keys are used to identify objects are to be fork compatible.
 	// Objects that are only compatible with specific forks should be prefixed with such keys.
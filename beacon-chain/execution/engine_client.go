@@ -19,6 +19,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v3/consensus-types/interfaces"
 	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
 	pb "github.com/prysmaticlabs/prysm/v3/proto/engine/v1"
+	"github.com/prysmaticlabs/prysm/v3/runtime/version"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 )
@@ -30,12 +31,19 @@ const (
 	ForkchoiceUpdatedMethod = "engine_forkchoiceUpdatedV1"
 	// GetPayloadMethod v1 request string for JSON-RPC.
 	GetPayloadMethod = "engine_getPayloadV1"
+	// GetPayloadMethodV2 v2 request string for JSON-RPC, which also returns the value of the payload.
+	GetPayloadMethodV2 = "engine_getPayloadV2"
 	// ExchangeTransitionConfigurationMethod v1 request string for JSON-RPC.
 	ExchangeTransitionConfigurationMethod = "engine_exchangeTransitionConfigurationV1"
 	// ExecutionBlockByHashMethod request string for JSON-RPC.
 	ExecutionBlockByHashMethod = "eth_getBlockByHash"
 	// ExecutionBlockByNumberMethod request string for JSON-RPC.
 	ExecutionBlockByNumberMethod = "eth_getBlockByNumber"
+	// GetClientVersionMethod v1 request string for JSON-RPC.
+	GetClientVersionMethod = "engine_getClientVersionV1"
+	// Web3ClientVersionMethod request string for JSON-RPC, used if the execution
+	// client does not support GetClientVersionMethod.
+	Web3ClientVersionMethod = "web3_clientVersion"
 	// Defines the seconds before timing out engine endpoints with non-block execution semantics.
 	defaultEngineTimeout = time.Second
 )
@@ -47,6 +55,21 @@ type ForkchoiceUpdatedResponse struct {
 	PayloadId *pb.PayloadIDBytes `json:"payloadId"`
 }
 
+// GetPayloadV2Response is the response of the engine_getPayloadV2 method, with the value of the
+// payload in wei to the fee recipient.
+type GetPayloadV2Response struct {
+	ExecutionPayload *pb.ExecutionPayload `json:"executionPayload"`
+	BlockValue       *hexutil.Big         `json:"blockValue"`
+}
+
+// ClientVersion identifies a client in the engine_getClientVersionV1 endpoint.
+type ClientVersion struct {
+	Code    string `json:"code"`
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	Commit  string `json:"commit"`
+}
+
 // ExecutionPayloadReconstructor defines a service that can reconstruct a full beacon
 // block with an execution payload from a signed beacon block and a connection
 // to an execution client's engine API.
@@ -67,6 +90,7 @@ type EngineCaller interface {
 		ctx context.Context, state *pb.ForkchoiceState, attrs *pb.PayloadAttributes,
 	) (*pb.PayloadIDBytes, []byte, error)
 	GetPayload(ctx context.Context, payloadId [8]byte) (*pb.ExecutionPayload, error)
+	GetPayloadWithValue(ctx context.Context, payloadId [8]byte) (*pb.ExecutionPayload, *big.Int, error)
 	ExchangeTransitionConfiguration(
 		ctx context.Context, cfg *pb.TransitionConfiguration,
 	) error
@@ -91,7 +115,8 @@ func (s *Service) NewPayload(ctx context.Context, payload interfaces.ExecutionDa
 	if !ok {
 		return nil, errors.New("execution data must be an execution payload")
 	}
-	err := s.rpcClient.CallContext(ctx, result, NewPayloadMethod, payloadPb)
+	err := s.currentRPCClient().CallContext(ctx, result, NewPayloadMethod, payloadPb)
+	s.recordEngineCall(err)
 	if err != nil {
 		return nil, handleRPCError(err)
 	}
@@ -125,10 +150,12 @@ func (s *Service) ForkchoiceUpdated(
 	ctx, cancel := context.WithDeadline(ctx, d)
 	defer cancel()
 	result := &ForkchoiceUpdatedResponse{}
-	err := s.rpcClient.CallContext(ctx, result, ForkchoiceUpdatedMethod, state, attrs)
+	err := s.currentRPCClient().CallContext(ctx, result, ForkchoiceUpdatedMethod, state, attrs)
+	s.recordEngineCall(err)
 	if err != nil {
 		return nil, nil, handleRPCError(err)
 	}
+	s.setLastForkchoiceState(state)
 
 	if result.Status == nil {
 		return nil, nil, ErrNilResponse
@@ -159,10 +186,49 @@ func (s *Service) GetPayload(ctx context.Context, payloadId [8]byte) (*pb.Execut
 	ctx, cancel := context.WithDeadline(ctx, d)
 	defer cancel()
 	result := &pb.ExecutionPayload{}
-	err := s.rpcClient.CallContext(ctx, result, GetPayloadMethod, pb.PayloadIDBytes(payloadId))
+	err := s.currentRPCClient().CallContext(ctx, result, GetPayloadMethod, pb.PayloadIDBytes(payloadId))
+	s.recordEngineCall(err)
 	return result, handleRPCError(err)
 }
 
+// GetPayloadWithValue calls the engine_getPayloadV2 method via JSON-RPC, which returns the payload
+// with its value in wei. The engine_getPayloadV1 method is used for execution clients which do not
+// support it yet, in which case the returned value is nil.
+func (s *Service) GetPayloadWithValue(ctx context.Context, payloadId [8]byte) (*pb.ExecutionPayload, *big.Int, error) {
+	ctx, span := trace.StartSpan(ctx, "powchain.engine-api-client.GetPayloadWithValue")
+	defer span.End()
+	start := time.Now()
+	defer func() {
+		getPayloadLatency.Observe(float64(time.Since(start).Milliseconds()))
+	}()
+
+	d := time.Now().Add(defaultEngineTimeout)
+	ctx, cancel := context.WithDeadline(ctx, d)
+	defer cancel()
+	result := &GetPayloadV2Response{}
+	err := s.currentRPCClient().CallContext(ctx, result, GetPayloadMethodV2, pb.PayloadIDBytes(payloadId))
+	s.recordEngineCall(err)
+	err = handleRPCError(err)
+	switch {
+	case err == nil:
+		if result.ExecutionPayload == nil {
+			return nil, nil, ErrNilResponse
+		}
+		var value *big.Int
+		if result.BlockValue != nil {
+			value = result.BlockValue.ToInt()
+		}
+		return result.ExecutionPayload, value, nil
+	case errors.Is(err, ErrMethodNotFound):
+		payload := &pb.ExecutionPayload{}
+		err := s.currentRPCClient().CallContext(ctx, payload, GetPayloadMethod, pb.PayloadIDBytes(payloadId))
+		s.recordEngineCall(err)
+		return payload, nil, handleRPCError(err)
+	default:
+		return nil, nil, err
+	}
+}
+
 // ExchangeTransitionConfiguration calls the engine_exchangeTransitionConfigurationV1 method via JSON-RPC.
 func (s *Service) ExchangeTransitionConfiguration(
 	ctx context.Context, cfg *pb.TransitionConfiguration,
@@ -177,7 +243,7 @@ func (s *Service) ExchangeTransitionConfiguration(
 	ctx, cancel := context.WithDeadline(ctx, d)
 	defer cancel()
 	result := &pb.TransitionConfiguration{}
-	if err := s.rpcClient.CallContext(ctx, result, ExchangeTransitionConfigurationMethod, cfg); err != nil {
+	if err := s.currentRPCClient().CallContext(ctx, result, ExchangeTransitionConfigurationMethod, cfg); err != nil {
 		return handleRPCError(err)
 	}
 
@@ -297,7 +363,7 @@ func (s *Service) LatestExecutionBlock(ctx context.Context) (*pb.ExecutionBlock,
 	defer span.End()
 
 	result := &pb.ExecutionBlock{}
-	err := s.rpcClient.CallContext(
+	err := s.currentRPCClient().CallContext(
 		ctx,
 		result,
 		ExecutionBlockByNumberMethod,
@@ -307,13 +373,52 @@ func (s *Service) LatestExecutionBlock(ctx context.Context) (*pb.ExecutionBlock,
 	return result, handleRPCError(err)
 }
 
+// ExecutionClientVersion returns the name and version of the execution client in the
+// <name>/<version> format. The engine_getClientVersionV1 method is used, falling back to
+// web3_clientVersion for execution clients which do not support it yet.
+func (s *Service) ExecutionClientVersion(ctx context.Context) (string, error) {
+	ctx, span := trace.StartSpan(ctx, "powchain.engine-api-client.ExecutionClientVersion")
+	defer span.End()
+
+	d := time.Now().Add(defaultEngineTimeout)
+	ctx, cancel := context.WithDeadline(ctx, d)
+	defer cancel()
+	var result []*ClientVersion
+	err := s.currentRPCClient().CallContext(ctx, &result, GetClientVersionMethod, consensusClientVersion())
+	if err == nil && len(result) > 0 {
+		return fmt.Sprintf("%s/%s", result[0].Name, result[0].Version), nil
+	}
+	var clientVersion string
+	if err := s.currentRPCClient().CallContext(ctx, &clientVersion, Web3ClientVersionMethod); err != nil {
+		return "", handleRPCError(err)
+	}
+	return clientVersion, nil
+}
+
+// Identifies this beacon node to the execution client in the engine_getClientVersionV1 endpoint.
+func consensusClientVersion() *ClientVersion {
+	commit := "0x00000000"
+	// The build data is formatted as Prysm/<tag>/<commit>.
+	if parts := strings.Split(version.BuildData(), "/"); len(parts) == 3 && len(parts[2]) >= 8 {
+		if _, err := hexutil.Decode("0x" + parts[2][:8]); err == nil {
+			commit = "0x" + parts[2][:8]
+		}
+	}
+	return &ClientVersion{
+		Code:    "PM",
+		Name:    "Prysm",
+		Version: version.SemanticVersion(),
+		Commit:  commit,
+	}
+}
+
 // ExecutionBlockByHash fetches an execution engine block by hash by calling
 // eth_blockByHash via JSON-RPC.
 func (s *Service) ExecutionBlockByHash(ctx context.Context, hash common.Hash, withTxs bool) (*pb.ExecutionBlock, error) {
 	ctx, span := trace.StartSpan(ctx, "powchain.engine-api-client.ExecutionBlockByHash")
 	defer span.End()
 	result := &pb.ExecutionBlock{}
-	err := s.rpcClient.CallContext(ctx, result, ExecutionBlockByHashMethod, hash, withTxs)
+	err := s.currentRPCClient().CallContext(ctx, result, ExecutionBlockByHashMethod, hash, withTxs)
 	return result, handleRPCError(err)
 }
 
@@ -342,7 +447,7 @@ func (s *Service) ExecutionBlocksByHashes(ctx context.Context, hashes []common.H
 		execBlks = append(execBlks, blk)
 		errs = append(errs, err)
 	}
-	ioErr := s.rpcClient.BatchCall(elems)
+	ioErr := s.currentRPCClient().BatchCall(elems)
 	if ioErr != nil {
 		return nil, ioErr
 	}
@@ -914,6 +914,103 @@ func TestExchangeTransitionConfiguration(t *testing.T) {
 	})
 }
 
+func TestExecutionClientVersion(t *testing.T) {
+	ctx := context.Background()
+	newService := func(t *testing.T, getClientVersionSupported bool) *Service {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			defer func() {
+				require.NoError(t, r.Body.Close())
+			}()
+			req := &struct {
+				Method string `json:"method"`
+			}{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(req))
+			respJSON := map[string]interface{}{
+				"jsonrpc": "2.0",
+				"id":      1,
+			}
+			switch {
+			case req.Method == GetClientVersionMethod && getClientVersionSupported:
+				respJSON["result"] = []*ClientVersion{{Code: "GE", Name: "Geth", Version: "v1.11.0", Commit: "0xfa4ff922"}}
+			case req.Method == Web3ClientVersionMethod:
+				respJSON["result"] = "Geth/v1.10.26-stable/linux-amd64/go1.18.5"
+			default:
+				respJSON["error"] = map[string]interface{}{"code": -32601, "message": "method not found"}
+			}
+			require.NoError(t, json.NewEncoder(w).Encode(respJSON))
+		}))
+		t.Cleanup(srv.Close)
+
+		rpcClient, err := rpc.DialHTTP(srv.URL)
+		require.NoError(t, err)
+		t.Cleanup(rpcClient.Close)
+		return &Service{rpcClient: rpcClient}
+	}
+	t.Run("engine_getClientVersionV1", func(t *testing.T) {
+		clientVersion, err := newService(t, true).ExecutionClientVersion(ctx)
+		require.NoError(t, err)
+		require.Equal(t, "Geth/v1.11.0", clientVersion)
+	})
+	t.Run("web3_clientVersion fallback", func(t *testing.T) {
+		clientVersion, err := newService(t, false).ExecutionClientVersion(ctx)
+		require.NoError(t, err)
+		require.Equal(t, "Geth/v1.10.26-stable/linux-amd64/go1.18.5", clientVersion)
+	})
+}
+
+func TestGetPayloadWithValue(t *testing.T) {
+	ctx := context.Background()
+	want, ok := fixtures()["ExecutionPayload"].(*pb.ExecutionPayload)
+	require.Equal(t, true, ok)
+	newService := func(t *testing.T, getPayloadV2Supported bool) *Service {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			defer func() {
+				require.NoError(t, r.Body.Close())
+			}()
+			req := &struct {
+				Method string `json:"method"`
+			}{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(req))
+			respJSON := map[string]interface{}{
+				"jsonrpc": "2.0",
+				"id":      1,
+			}
+			switch {
+			case req.Method == GetPayloadMethodV2 && getPayloadV2Supported:
+				respJSON["result"] = map[string]interface{}{
+					"executionPayload": want,
+					"blockValue":       "0x2386f26fc10000",
+				}
+			case req.Method == GetPayloadMethod:
+				respJSON["result"] = want
+			default:
+				respJSON["error"] = map[string]interface{}{"code": -32601, "message": "method not found"}
+			}
+			require.NoError(t, json.NewEncoder(w).Encode(respJSON))
+		}))
+		t.Cleanup(srv.Close)
+
+		rpcClient, err := rpc.DialHTTP(srv.URL)
+		require.NoError(t, err)
+		t.Cleanup(rpcClient.Close)
+		return &Service{rpcClient: rpcClient}
+	}
+	t.Run(GetPayloadMethodV2, func(t *testing.T) {
+		payload, value, err := newService(t, true).GetPayloadWithValue(ctx, [8]byte{1})
+		require.NoError(t, err)
+		require.DeepEqual(t, want, payload)
+		require.Equal(t, "10000000000000000", value.String())
+	})
+	t.Run(GetPayloadMethod+" fallback", func(t *testing.T) {
+		payload, value, err := newService(t, false).GetPayloadWithValue(ctx, [8]byte{1})
+		require.NoError(t, err)
+		require.DeepEqual(t, want, payload)
+		require.Equal(t, true, value == nil)
+	})
+}
+
 type customError struct {
 	code    int
 	timeout bool
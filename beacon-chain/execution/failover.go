@@ -0,0 +1,179 @@
+package execution
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	gethRPC "github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/prysm/v3/io/logs"
+	pb "github.com/prysmaticlabs/prysm/v3/proto/engine/v1"
+	"github.com/sirupsen/logrus"
+)
+
+// SyncingMethod request string for JSON-RPC.
+const SyncingMethod = "eth_syncing"
+
+var (
+	// period at which the health of the execution client endpoints is checked.
+	healthCheckPeriod = 12 * time.Second
+	// number of consecutive engine API calls failing to reach the execution client
+	// after which its endpoint is considered unhealthy.
+	maxEngineFailures = uint64(3)
+
+	errExecutionClientSyncing = errors.New("execution client is syncing")
+	errEngineUnreachable      = errors.New("engine API calls are failing")
+)
+
+var (
+	endpointFailoverCount = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "execution_endpoint_failover_count",
+		Help: "The number of times the beacon node switched to another execution client endpoint",
+	})
+	executionEndpointIndex = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "execution_endpoint_index",
+		Help: "The index of the execution client endpoint in use, 0 being the primary endpoint",
+	})
+)
+
+// checkEndpointHealth checks the health of the execution client endpoint in use and switches to the
+// first healthy endpoint in order if it is unhealthy. Endpoints before the one in use are preferred as
+// soon as they are healthy again, so that the node returns to its primary endpoint. It must only be
+// called from the run loop of the service, which all endpoint switching happens on.
+func (s *Service) checkEndpointHealth(ctx context.Context) {
+	currIndex := s.currentEndpointIndex()
+	candidates := make([]int, 0, len(s.cfg.httpEndpoints))
+	err := s.endpointHealth(ctx, s.currentRPCClient())
+	if err == nil && atomic.LoadUint64(&s.engineFailures) >= maxEngineFailures {
+		err = errEngineUnreachable
+	}
+	if err != nil {
+		log.WithError(err).WithField(
+			"endpoint", logs.MaskCredentialsLogging(s.currentEndpoint().Url),
+		).Warn("Execution client endpoint is unhealthy")
+		for i := 1; i < len(s.cfg.httpEndpoints); i++ {
+			candidates = append(candidates, (currIndex+i)%len(s.cfg.httpEndpoints))
+		}
+	} else {
+		for i := 0; i < currIndex; i++ {
+			candidates = append(candidates, i)
+		}
+	}
+	for _, i := range candidates {
+		if err := s.switchEndpoint(ctx, i); err != nil {
+			log.WithError(err).WithField(
+				"endpoint", logs.MaskCredentialsLogging(s.cfg.httpEndpoints[i].Url),
+			).Debug("Could not switch to execution client endpoint")
+			continue
+		}
+		return
+	}
+}
+
+// endpointHealth returns an error if the execution client behind the given client is unreachable
+// or still syncing, and therefore not able to serve the engine API.
+func (s *Service) endpointHealth(ctx context.Context, client RPCClient) error {
+	ctx, cancel := context.WithTimeout(ctx, defaultEngineTimeout)
+	defer cancel()
+	var syncing json.RawMessage
+	if err := client.CallContext(ctx, &syncing, SyncingMethod); err != nil {
+		return errors.Wrap(err, "could not check sync status")
+	}
+	// The execution client returns false once synced, and an object describing its progress otherwise.
+	var isSyncing bool
+	if err := json.Unmarshal(syncing, &isSyncing); err != nil || isSyncing {
+		return errExecutionClientSyncing
+	}
+	return nil
+}
+
+// switchEndpoint connects to the execution client endpoint at the given index if it is healthy,
+// and replays the latest forkchoice update to it so it can catch up with the head right away.
+func (s *Service) switchEndpoint(ctx context.Context, index int) error {
+	endpoint := s.cfg.httpEndpoints[index]
+	client, err := s.newRPCClientWithAuth(ctx, endpoint)
+	if err != nil {
+		return errors.Wrap(err, "could not dial execution node")
+	}
+	err = s.endpointHealth(ctx, client)
+	client.Close()
+	if err != nil {
+		return err
+	}
+
+	currClient := s.currentRPCClient()
+	if err := s.setupExecutionClientConnections(ctx, endpoint); err != nil {
+		return err
+	}
+	// Close previous client, if connection was successful.
+	if currClient != nil {
+		currClient.Close()
+	}
+	s.setCurrentEndpoint(endpoint)
+	atomic.StoreUint64(&s.engineFailures, 0)
+	endpointFailoverCount.Inc()
+	executionEndpointIndex.Set(float64(index))
+	log.WithField(
+		"endpoint", logs.MaskCredentialsLogging(endpoint.Url),
+	).Info("Switched to execution client endpoint")
+
+	s.replayForkchoiceUpdate(ctx)
+	return nil
+}
+
+// replayForkchoiceUpdate sends the latest forkchoice state to the execution client in use.
+func (s *Service) replayForkchoiceUpdate(ctx context.Context) {
+	s.forkchoiceStateLock.RLock()
+	fcs := s.lastForkchoiceState
+	s.forkchoiceStateLock.RUnlock()
+	if fcs == nil {
+		return
+	}
+	_, _, err := s.ForkchoiceUpdated(ctx, fcs, nil)
+	switch {
+	case err == nil:
+	case errors.Is(err, ErrAcceptedSyncingPayloadStatus):
+		log.Debug("Execution client is syncing to the replayed forkchoice state")
+	default:
+		log.WithError(err).WithFields(logrus.Fields{
+			"headBlockHash": fmt.Sprintf("%#x", fcs.HeadBlockHash),
+		}).Error("Could not replay forkchoice update to execution client")
+	}
+}
+
+// currentEndpointIndex returns the position of the endpoint in use in the list of endpoints.
+func (s *Service) currentEndpointIndex() int {
+	curr := s.currentEndpoint()
+	for i, e := range s.cfg.httpEndpoints {
+		if e.Equals(curr) {
+			return i
+		}
+	}
+	return 0
+}
+
+// recordEngineCall keeps track of consecutive engine API calls which failed to reach the execution
+// client. Errors returned by the execution client itself reset the count, as the client is responding.
+func (s *Service) recordEngineCall(err error) {
+	if err == nil {
+		atomic.StoreUint64(&s.engineFailures, 0)
+		return
+	}
+	if _, ok := err.(gethRPC.Error); ok {
+		atomic.StoreUint64(&s.engineFailures, 0)
+		return
+	}
+	atomic.AddUint64(&s.engineFailures, 1)
+}
+
+// setLastForkchoiceState records the latest forkchoice state sent to the execution client.
+func (s *Service) setLastForkchoiceState(fcs *pb.ForkchoiceState) {
+	s.forkchoiceStateLock.Lock()
+	defer s.forkchoiceStateLock.Unlock()
+	s.lastForkchoiceState = fcs
+}
@@ -0,0 +1,269 @@
+package execution
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/cache/depositcache"
+	testDB "github.com/prysmaticlabs/prysm/v3/beacon-chain/db/testing"
+	mockExecution "github.com/prysmaticlabs/prysm/v3/beacon-chain/execution/testing"
+	"github.com/prysmaticlabs/prysm/v3/config/params"
+	contracts "github.com/prysmaticlabs/prysm/v3/contracts/deposit"
+	"github.com/prysmaticlabs/prysm/v3/contracts/deposit/mock"
+	"github.com/prysmaticlabs/prysm/v3/network"
+	pb "github.com/prysmaticlabs/prysm/v3/proto/engine/v1"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+	"github.com/prysmaticlabs/prysm/v3/testing/util"
+)
+
+// fakeExecutionClient serves the JSON-RPC methods used to check the health of an execution client
+// endpoint and records the forkchoice states it receives.
+type fakeExecutionClient struct {
+	*httptest.Server
+	sync.Mutex
+	syncing      bool
+	forkchoiceHs [][]byte
+}
+
+func newFakeExecutionClient(t *testing.T, syncing bool) *fakeExecutionClient {
+	c := &fakeExecutionClient{syncing: syncing}
+	c.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		defer func() {
+			require.NoError(t, r.Body.Close())
+		}()
+		req := &struct {
+			ID     json.RawMessage   `json:"id"`
+			Method string            `json:"method"`
+			Params []json.RawMessage `json:"params"`
+		}{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(req))
+		c.Lock()
+		defer c.Unlock()
+		var result interface{}
+		switch req.Method {
+		case SyncingMethod:
+			if c.syncing {
+				result = map[string]string{"currentBlock": "0x1", "highestBlock": "0x2"}
+			} else {
+				result = false
+			}
+		case "eth_chainId":
+			result = hexutil.EncodeUint64(params.BeaconConfig().DepositChainID)
+		case ForkchoiceUpdatedMethod:
+			fcs := &pb.ForkchoiceState{}
+			require.NoError(t, json.Unmarshal(req.Params[0], fcs))
+			c.forkchoiceHs = append(c.forkchoiceHs, fcs.HeadBlockHash)
+			result = &ForkchoiceUpdatedResponse{Status: &pb.PayloadStatus{Status: pb.PayloadStatus_VALID}}
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]interface{}{
+			"jsonrpc": "2.0",
+			"id":      req.ID,
+			"result":  result,
+		}))
+	}))
+	t.Cleanup(c.Server.Close)
+	return c
+}
+
+func (c *fakeExecutionClient) setSyncing(syncing bool) {
+	c.Lock()
+	defer c.Unlock()
+	c.syncing = syncing
+}
+
+func (c *fakeExecutionClient) forkchoiceHeads() [][]byte {
+	c.Lock()
+	defer c.Unlock()
+	return c.forkchoiceHs
+}
+
+func setupFailoverService(t *testing.T, current int, clients ...*fakeExecutionClient) *Service {
+	endpoints := make([]network.Endpoint, len(clients))
+	for i, c := range clients {
+		endpoints[i] = HttpEndpoint(c.URL)
+	}
+	client, err := rpc.DialHTTP(clients[current].URL)
+	require.NoError(t, err)
+	t.Cleanup(client.Close)
+	return &Service{
+		rpcClient: client,
+		cfg: &config{
+			beaconNodeStatsUpdater: &NopBeaconNodeStatsUpdater{},
+			currHttpEndpoint:       endpoints[current],
+			httpEndpoints:          endpoints,
+		},
+	}
+}
+
+func TestService_checkEndpointHealth(t *testing.T) {
+	ctx := context.Background()
+	head := []byte{'h'}
+
+	t.Run("fails over from syncing endpoint", func(t *testing.T) {
+		primary, fallback := newFakeExecutionClient(t, true), newFakeExecutionClient(t, false)
+		s := setupFailoverService(t, 0, primary, fallback)
+		s.setLastForkchoiceState(&pb.ForkchoiceState{HeadBlockHash: head})
+
+		s.checkEndpointHealth(ctx)
+		require.Equal(t, fallback.URL, s.ExecutionClientEndpoint())
+		require.Equal(t, true, s.ExecutionClientConnected())
+		// The latest forkchoice state is replayed to the new endpoint.
+		require.DeepEqual(t, [][]byte{head}, fallback.forkchoiceHeads())
+	})
+	t.Run("fails over from unreachable engine", func(t *testing.T) {
+		primary, fallback := newFakeExecutionClient(t, false), newFakeExecutionClient(t, false)
+		s := setupFailoverService(t, 0, primary, fallback)
+		for i := uint64(0); i < maxEngineFailures; i++ {
+			s.recordEngineCall(ErrHTTPTimeout)
+		}
+
+		s.checkEndpointHealth(ctx)
+		require.Equal(t, fallback.URL, s.ExecutionClientEndpoint())
+		require.Equal(t, uint64(0), s.engineFailures)
+	})
+	t.Run("stays on healthy endpoint", func(t *testing.T) {
+		primary, fallback := newFakeExecutionClient(t, false), newFakeExecutionClient(t, false)
+		s := setupFailoverService(t, 0, primary, fallback)
+		s.setLastForkchoiceState(&pb.ForkchoiceState{HeadBlockHash: head})
+
+		s.checkEndpointHealth(ctx)
+		require.Equal(t, primary.URL, s.ExecutionClientEndpoint())
+		require.Equal(t, 0, len(primary.forkchoiceHeads()))
+	})
+	t.Run("no healthy endpoint", func(t *testing.T) {
+		primary, fallback := newFakeExecutionClient(t, true), newFakeExecutionClient(t, true)
+		s := setupFailoverService(t, 0, primary, fallback)
+
+		s.checkEndpointHealth(ctx)
+		require.Equal(t, primary.URL, s.ExecutionClientEndpoint())
+	})
+	t.Run("returns to primary endpoint once healthy", func(t *testing.T) {
+		primary, second, third := newFakeExecutionClient(t, true), newFakeExecutionClient(t, false), newFakeExecutionClient(t, false)
+		s := setupFailoverService(t, 2, primary, second, third)
+
+		s.checkEndpointHealth(ctx)
+		require.Equal(t, second.URL, s.ExecutionClientEndpoint())
+
+		primary.setSyncing(false)
+		s.checkEndpointHealth(ctx)
+		require.Equal(t, primary.URL, s.ExecutionClientEndpoint())
+	})
+}
+
+func TestService_fallbackToNextEndpoint(t *testing.T) {
+	endpoints := []network.Endpoint{HttpEndpoint("http://a"), HttpEndpoint("http://b"), HttpEndpoint("http://c")}
+	s := &Service{cfg: &config{currHttpEndpoint: endpoints[1], httpEndpoints: endpoints}}
+	s.fallbackToNextEndpoint()
+	require.Equal(t, "http://c", s.ExecutionClientEndpoint())
+	s.fallbackToNextEndpoint()
+	require.Equal(t, "http://a", s.ExecutionClientEndpoint())
+
+	s = &Service{cfg: &config{currHttpEndpoint: endpoints[0], httpEndpoints: endpoints[:1]}}
+	s.fallbackToNextEndpoint()
+	require.Equal(t, "http://a", s.ExecutionClientEndpoint())
+}
+
+func TestService_ExecutionClientEndpoint_ConcurrentSwitch(t *testing.T) {
+	endpoints := []network.Endpoint{HttpEndpoint("http://a"), HttpEndpoint("http://b")}
+	s := &Service{cfg: &config{currHttpEndpoint: endpoints[0], httpEndpoints: endpoints}}
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			s.fallbackToNextEndpoint()
+		}
+	}()
+	// The endpoint is read by the node RPC server while the run loop switches endpoints.
+	for i := 0; i < 100; i++ {
+		url := s.ExecutionClientEndpoint()
+		require.Equal(t, true, url == "http://a" || url == "http://b")
+	}
+	wg.Wait()
+	require.Equal(t, "http://a", s.ExecutionClientEndpoint())
+}
+
+func TestService_recordEngineCall(t *testing.T) {
+	s := &Service{}
+	s.recordEngineCall(ErrHTTPTimeout)
+	s.recordEngineCall(ErrHTTPTimeout)
+	require.Equal(t, uint64(2), s.engineFailures)
+	// Errors returned by the execution client show that it is reachable.
+	s.recordEngineCall(&customError{code: -32000})
+	require.Equal(t, uint64(0), s.engineFailures)
+	s.recordEngineCall(ErrHTTPTimeout)
+	s.recordEngineCall(nil)
+	require.Equal(t, uint64(0), s.engineFailures)
+}
+
+func TestService_checkEndpointHealth_DepositLogsNotReprocessed(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	bConfig := params.MinimalSpecConfig().Copy()
+	bConfig.SecondsPerETH1Block = 10
+	params.OverrideBeaconConfig(bConfig)
+	nConfig := params.BeaconNetworkConfig()
+	nConfig.ContractDeploymentBlock = 0
+	params.OverrideBeaconNetworkConfig(nConfig)
+	ctx := context.Background()
+
+	testAcc, err := mock.Setup()
+	require.NoError(t, err, "Unable to set up simulated backend")
+	depositCache, err := depositcache.New()
+	require.NoError(t, err)
+	primary, fallback := newFakeExecutionClient(t, true), newFakeExecutionClient(t, false)
+	s, err := NewService(ctx,
+		WithHttpEndpoint(primary.URL),
+		WithFallbackHttpEndpoints([]string{fallback.URL}),
+		WithDepositContractAddress(testAcc.ContractAddr),
+		WithDatabase(testDB.SetupDB(t)),
+		WithDepositCache(depositCache),
+	)
+	require.NoError(t, err)
+	s = setDefaultMocks(s)
+	// Both endpoints serve the chain of the simulated backend.
+	useBackend := func() {
+		s.rpcClient = &mockExecution.RPCClient{Backend: testAcc.Backend}
+		s.httpLogger = testAcc.Backend
+		s.eth1DataFetcher = &goodFetcher{backend: testAcc.Backend}
+		s.depositContractCaller, err = contracts.NewDepositContractCaller(testAcc.ContractAddr, testAcc.Backend)
+		require.NoError(t, err)
+	}
+	useBackend()
+
+	deposits, _, err := util.DeterministicDepositsAndKeys(3)
+	require.NoError(t, err)
+	_, depositRoots, err := util.DeterministicDepositTrie(len(deposits))
+	require.NoError(t, err)
+	for i, d := range deposits {
+		testAcc.TxOpts.Value = mock.Amount32Eth()
+		testAcc.TxOpts.GasLimit = 1000000
+		_, err = testAcc.Contract.Deposit(testAcc.TxOpts, d.Data.PublicKey, d.Data.WithdrawalCredentials, d.Data.Signature, depositRoots[i])
+		require.NoError(t, err, "Could not deposit to deposit contract")
+		testAcc.Backend.Commit()
+	}
+	for i := uint64(0); i < params.BeaconConfig().Eth1FollowDistance; i++ {
+		testAcc.Backend.Commit()
+	}
+	s.latestEth1Data.BlockHeight = testAcc.Backend.Blockchain().CurrentBlock().NumberU64()
+	s.latestEth1Data.BlockTime = testAcc.Backend.Blockchain().CurrentBlock().Time()
+
+	require.NoError(t, s.processPastLogs(ctx))
+	require.Equal(t, len(deposits), len(depositCache.AllDeposits(ctx, nil)))
+	lastRequested := s.latestEth1Data.LastRequestedBlock
+
+	s.checkEndpointHealth(ctx)
+	require.Equal(t, fallback.URL, s.ExecutionClientEndpoint())
+	useBackend()
+
+	require.NoError(t, s.processPastLogs(ctx))
+	require.Equal(t, len(deposits), len(depositCache.AllDeposits(ctx, nil)))
+	require.Equal(t, lastRequested, s.latestEth1Data.LastRequestedBlock)
+	require.Equal(t, int64(len(deposits)-1), s.lastReceivedMerkleIndex)
+}
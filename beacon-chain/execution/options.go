@@ -7,6 +7,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/v3/network"
 	"github.com/prysmaticlabs/prysm/v3/network/authorization"
 )
 
@@ -36,6 +37,44 @@ func WithHttpEndpointAndJWTSecret(endpointString string, secret []byte) Option {
 	}
 }
 
+// WithFallbackHttpEndpoints sets the ordered list of execution client endpoints the service
+// falls back to when the current endpoint is unavailable or unhealthy.
+func WithFallbackHttpEndpoints(endpointStrings []string) Option {
+	return func(s *Service) error {
+		endpoints := make([]network.Endpoint, 0, len(endpointStrings))
+		for _, e := range dedupEndpoints(endpointStrings) {
+			if e == "" {
+				continue
+			}
+			endpoints = append(endpoints, HttpEndpoint(e))
+		}
+		s.cfg.fallbackHttpEndpoints = endpoints
+		return nil
+	}
+}
+
+// WithFallbackHttpEndpointsAndJWTSecret for authenticating the fallback execution node JSON-RPC endpoints.
+func WithFallbackHttpEndpointsAndJWTSecret(endpointStrings []string, secret []byte) Option {
+	return func(s *Service) error {
+		if len(secret) == 0 {
+			return nil
+		}
+		endpoints := make([]network.Endpoint, 0, len(endpointStrings))
+		for _, e := range dedupEndpoints(endpointStrings) {
+			if e == "" {
+				continue
+			}
+			// Overwrite authorization type for all endpoints to be of a bearer type.
+			hEndpoint := HttpEndpoint(e)
+			hEndpoint.Auth.Method = authorization.Bearer
+			hEndpoint.Auth.Value = string(secret)
+			endpoints = append(endpoints, hEndpoint)
+		}
+		s.cfg.fallbackHttpEndpoints = endpoints
+		return nil
+	}
+}
+
 // WithHeaders adds headers to the execution node JSON-RPC requests.
 func WithHeaders(headers []string) Option {
 	return func(s *Service) error {
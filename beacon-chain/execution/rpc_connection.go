@@ -24,9 +24,11 @@ func (s *Service) setupExecutionClientConnections(ctx context.Context, currEndpo
 	}
 	// Attach the clients to the service struct.
 	fetcher := ethclient.NewClient(client)
+	s.clientLock.Lock()
 	s.rpcClient = client
 	s.httpLogger = fetcher
 	s.eth1DataFetcher = fetcher
+	s.clientLock.Unlock()
 
 	depositContractCaller, err := contracts.NewDepositContractCaller(s.cfg.depositContractAddr, fetcher)
 	if err != nil {
@@ -68,17 +70,19 @@ func (s *Service) pollConnectionStatus(ctx context.Context) {
 	for {
 		select {
 		case <-ticker.C:
-			log.Debugf("Trying to dial endpoint: %s", logs.MaskCredentialsLogging(s.cfg.currHttpEndpoint.Url))
-			currClient := s.rpcClient
-			if err := s.setupExecutionClientConnections(ctx, s.cfg.currHttpEndpoint); err != nil {
+			endpoint := s.currentEndpoint()
+			log.Debugf("Trying to dial endpoint: %s", logs.MaskCredentialsLogging(endpoint.Url))
+			currClient := s.currentRPCClient()
+			if err := s.setupExecutionClientConnections(ctx, endpoint); err != nil {
 				errorLogger(err, "Could not connect to execution client endpoint")
+				s.fallbackToNextEndpoint()
 				continue
 			}
 			// Close previous client, if connection was successful.
 			if currClient != nil {
 				currClient.Close()
 			}
-			log.Infof("Connected to new endpoint: %s", logs.MaskCredentialsLogging(s.cfg.currHttpEndpoint.Url))
+			log.Infof("Connected to new endpoint: %s", logs.MaskCredentialsLogging(endpoint.Url))
 			return
 		case <-s.ctx.Done():
 			log.Debug("Received cancelled context,closing existing powchain service")
@@ -93,9 +97,10 @@ func (s *Service) retryExecutionClientConnection(ctx context.Context, err error)
 	s.updateConnectedETH1(false)
 	// Back off for a while before redialing.
 	time.Sleep(backOffPeriod)
-	currClient := s.rpcClient
-	if err := s.setupExecutionClientConnections(ctx, s.cfg.currHttpEndpoint); err != nil {
+	currClient := s.currentRPCClient()
+	if err := s.setupExecutionClientConnections(ctx, s.currentEndpoint()); err != nil {
 		s.runError = err
+		s.fallbackToNextEndpoint()
 		return
 	}
 	// Close previous client, if connection was successful.
@@ -106,6 +111,48 @@ func (s *Service) retryExecutionClientConnection(ctx context.Context, err error)
 	s.runError = nil
 }
 
+// Selects the next endpoint in the list of execution client endpoints, wrapping around to the
+// primary endpoint after the last fallback endpoint.
+func (s *Service) fallbackToNextEndpoint() {
+	if len(s.cfg.httpEndpoints) < 2 {
+		return
+	}
+	nextIndex := (s.currentEndpointIndex() + 1) % len(s.cfg.httpEndpoints)
+	next := s.cfg.httpEndpoints[nextIndex]
+	s.setCurrentEndpoint(next)
+	executionEndpointIndex.Set(float64(nextIndex))
+	log.Infof("Falling back to alternative endpoint: %s", logs.MaskCredentialsLogging(next.Url))
+}
+
+// currentEndpoint returns the execution client endpoint in use.
+func (s *Service) currentEndpoint() network.Endpoint {
+	s.clientLock.RLock()
+	defer s.clientLock.RUnlock()
+	return s.cfg.currHttpEndpoint
+}
+
+// setCurrentEndpoint sets the execution client endpoint in use. It must only be called from the run
+// loop of the service, which all endpoint switching happens on.
+func (s *Service) setCurrentEndpoint(endpoint network.Endpoint) {
+	s.clientLock.Lock()
+	defer s.clientLock.Unlock()
+	s.cfg.currHttpEndpoint = endpoint
+}
+
+// currentRPCClient returns the RPC client of the execution client endpoint in use.
+func (s *Service) currentRPCClient() RPCClient {
+	s.clientLock.RLock()
+	defer s.clientLock.RUnlock()
+	return s.rpcClient
+}
+
+// currentDataFetcher returns the data fetcher of the execution client endpoint in use.
+func (s *Service) currentDataFetcher() RPCDataFetcher {
+	s.clientLock.RLock()
+	defer s.clientLock.RUnlock()
+	return s.eth1DataFetcher
+}
+
 // Initializes an RPC connection with authentication headers.
 func (s *Service) newRPCClientWithAuth(ctx context.Context, endpoint network.Endpoint) (*gethRPC.Client, error) {
 	// Need to handle ipc and http
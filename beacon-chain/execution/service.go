@@ -36,6 +36,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v3/monitoring/clientstats"
 	"github.com/prysmaticlabs/prysm/v3/network"
+	pb "github.com/prysmaticlabs/prysm/v3/proto/engine/v1"
 	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
 	prysmTime "github.com/prysmaticlabs/prysm/v3/time"
 	"github.com/prysmaticlabs/prysm/v3/time/slots"
@@ -80,6 +81,7 @@ type ChainInfoFetcher interface {
 	ExecutionClientConnected() bool
 	ExecutionClientEndpoint() string
 	ExecutionClientConnectionErr() error
+	ExecutionClientVersion(ctx context.Context) (string, error)
 }
 
 // POWBlockFetcher defines a struct that can retrieve mainchain blocks.
@@ -134,6 +136,8 @@ type config struct {
 	eth1HeaderReqLimit      uint64
 	beaconNodeStatsUpdater  BeaconNodeStatsUpdater
 	currHttpEndpoint        network.Endpoint
+	fallbackHttpEndpoints   []network.Endpoint
+	httpEndpoints           []network.Endpoint
 	headers                 []string
 	finalizedStateAtStartup state.BeaconState
 }
@@ -153,6 +157,7 @@ type Service struct {
 	ctx                     context.Context
 	cancel                  context.CancelFunc
 	eth1HeadTicker          *time.Ticker
+	clientLock              sync.RWMutex // Guards the execution client connections and endpoint replaced when switching endpoints.
 	httpLogger              bind.ContractFilterer
 	eth1DataFetcher         RPCDataFetcher
 	rpcClient               RPCClient
@@ -164,6 +169,9 @@ type Service struct {
 	lastReceivedMerkleIndex int64 // Keeps track of the last received index to prevent log spam.
 	runError                error
 	preGenesisState         state.BeaconState
+	engineFailures          uint64 // Consecutive engine API calls which failed to reach the execution client.
+	lastForkchoiceState     *pb.ForkchoiceState
+	forkchoiceStateLock     sync.RWMutex
 }
 
 // NewService sets up a new instance with an ethclient when given a web3 endpoint as a string in the config.
@@ -211,6 +219,14 @@ func NewService(ctx context.Context, opts ...Option) (*Service, error) {
 		}
 	}
 
+	// The current endpoint is the primary one, followed by the fallback endpoints in order.
+	s.cfg.httpEndpoints = []network.Endpoint{s.cfg.currHttpEndpoint}
+	for _, e := range s.cfg.fallbackHttpEndpoints {
+		if !e.Equals(s.cfg.currHttpEndpoint) {
+			s.cfg.httpEndpoints = append(s.cfg.httpEndpoints, e)
+		}
+	}
+
 	if err := s.ensureValidPowchainData(ctx); err != nil {
 		return nil, errors.Wrap(err, "unable to validate powchain data")
 	}
@@ -228,12 +244,12 @@ func NewService(ctx context.Context, opts ...Option) (*Service, error) {
 
 // Start the powchain service's main event loop.
 func (s *Service) Start() {
-	if err := s.setupExecutionClientConnections(s.ctx, s.cfg.currHttpEndpoint); err != nil {
+	if err := s.setupExecutionClientConnections(s.ctx, s.currentEndpoint()); err != nil {
 		log.WithError(err).Error("Could not connect to execution endpoint")
 	}
 	// If the chain has not started already and we don't have access to eth1 nodes, we will not be
 	// able to generate the genesis state.
-	if !s.chainStartData.Chainstarted && s.cfg.currHttpEndpoint.Url == "" {
+	if !s.chainStartData.Chainstarted && s.currentEndpoint().Url == "" {
 		// check for genesis state before shutting down the node,
 		// if a genesis state exists, we can continue on.
 		genState, err := s.cfg.beaconDB.GenesisState(s.ctx)
@@ -261,11 +277,11 @@ func (s *Service) Stop() error {
 	if s.cancel != nil {
 		defer s.cancel()
 	}
-	if s.rpcClient != nil {
-		s.rpcClient.Close()
+	if client := s.currentRPCClient(); client != nil {
+		client.Close()
 	}
-	if s.eth1DataFetcher != nil {
-		s.eth1DataFetcher.Close()
+	if fetcher := s.currentDataFetcher(); fetcher != nil {
+		fetcher.Close()
 	}
 	return nil
 }
@@ -304,7 +320,7 @@ func (s *Service) ExecutionClientConnected() bool {
 
 // ExecutionClientEndpoint returns the URL of the current, connected execution client.
 func (s *Service) ExecutionClientEndpoint() string {
-	return s.cfg.currHttpEndpoint.Url
+	return s.currentEndpoint().Url
 }
 
 // ExecutionClientConnectionErr returns the error (if any) of the current connection.
@@ -386,12 +402,13 @@ func (s *Service) initDepositCaches(ctx context.Context, ctrs []*ethpb.DepositCo
 		}
 	}
 	validDepositsCount.Add(float64(currIndex))
-	// Only add pending deposits if the container slice length
-	// is more than the current index in state.
-	if uint64(len(ctrs)) > currIndex {
-		for _, c := range ctrs[currIndex:] {
-			s.cfg.depositCache.InsertPendingDeposit(ctx, c.Deposit, c.Eth1BlockHeight, c.Index, bytesutil.ToBytes32(c.DepositRoot))
+	// Only add pending deposits which have not been processed by the state. The containers
+	// may not start at index 0 when the node was started from a deposit snapshot.
+	for _, c := range ctrs {
+		if c.Index < int64(currIndex) { // lint:ignore uintcast -- deposit index will not exceed int64 in your lifetime.
+			continue
 		}
+		s.cfg.depositCache.InsertPendingDeposit(ctx, c.Deposit, c.Eth1BlockHeight, c.Index, bytesutil.ToBytes32(c.DepositRoot))
 	}
 	return nil
 }
@@ -400,8 +417,13 @@ func (s *Service) initDepositCaches(ctx context.Context, ctrs []*ethpb.DepositCo
 // updates the latest blockHeight, blockHash, and blockTime properties of the service.
 func (s *Service) processBlockHeader(header *gethTypes.Header) {
 	defer safelyHandlePanic()
-	blockNumberGauge.Set(float64(header.Number.Int64()))
 	s.latestEth1DataLock.Lock()
+	// A fallback endpoint may lag behind the previous one, the latest block is not moved backwards.
+	if header.Number.Uint64() < s.latestEth1Data.BlockHeight {
+		s.latestEth1DataLock.Unlock()
+		return
+	}
+	blockNumberGauge.Set(float64(header.Number.Int64()))
 	s.latestEth1Data.BlockHeight = header.Number.Uint64()
 	s.latestEth1Data.BlockHash = header.Hash().Bytes()
 	s.latestEth1Data.BlockTime = header.Time
@@ -438,7 +460,7 @@ func (s *Service) batchRequestHeaders(startBlock, endBlock uint64) ([]*gethTypes
 		headers = append(headers, header)
 		errs = append(errs, err)
 	}
-	ioErr := s.rpcClient.BatchCall(elems)
+	ioErr := s.currentRPCClient().BatchCall(elems)
 	if ioErr != nil {
 		return nil, ioErr
 	}
@@ -523,7 +545,7 @@ func (s *Service) initPOWService() {
 			return
 		default:
 			ctx := s.ctx
-			header, err := s.eth1DataFetcher.HeaderByNumber(ctx, nil)
+			header, err := s.currentDataFetcher().HeaderByNumber(ctx, nil)
 			if err != nil {
 				s.retryExecutionClientConnection(ctx, err)
 				errorLogger(err, "Unable to retrieve latest execution client header")
@@ -562,7 +584,7 @@ func (s *Service) initPOWService() {
 				// In the event our provided chainstart data references a non-existent block hash,
 				// we assume the genesis block to be 0.
 				if genHash != [32]byte{} {
-					genHeader, err := s.eth1DataFetcher.HeaderByHash(ctx, genHash)
+					genHeader, err := s.currentDataFetcher().HeaderByHash(ctx, genHash)
 					if err != nil {
 						s.retryExecutionClientConnection(ctx, err)
 						errorLogger(err, "Unable to retrieve proof-of-stake genesis block data")
@@ -591,17 +613,26 @@ func (s *Service) run(done <-chan struct{}) {
 	chainstartTicker := time.NewTicker(logPeriod)
 	defer chainstartTicker.Stop()
 
+	// The health of the execution client endpoint is checked on this goroutine, so that endpoints are
+	// never switched while deposit logs are being processed.
+	var healthCheck <-chan time.Time
+	if len(s.cfg.httpEndpoints) > 1 {
+		healthTicker := time.NewTicker(healthCheckPeriod)
+		defer healthTicker.Stop()
+		healthCheck = healthTicker.C
+	}
+
 	for {
 		select {
 		case <-done:
 			s.isRunning = false
 			s.runError = nil
-			s.rpcClient.Close()
+			s.currentRPCClient().Close()
 			s.updateConnectedETH1(false)
 			log.Debug("Context closed, exiting goroutine")
 			return
 		case <-s.eth1HeadTicker.C:
-			head, err := s.eth1DataFetcher.HeaderByNumber(s.ctx, nil)
+			head, err := s.currentDataFetcher().HeaderByNumber(s.ctx, nil)
 			if err != nil {
 				s.pollConnectionStatus(s.ctx)
 				log.WithError(err).Debug("Could not fetch latest eth1 header")
@@ -609,6 +640,8 @@ func (s *Service) run(done <-chan struct{}) {
 			}
 			s.processBlockHeader(head)
 			s.handleETH1FollowDistance()
+		case <-healthCheck:
+			s.checkEndpointHealth(s.ctx)
 		case <-chainstartTicker.C:
 			if s.chainStartData.Chainstarted {
 				chainstartTicker.Stop()
@@ -726,12 +759,15 @@ func (s *Service) determineEarliestVotingBlock(ctx context.Context, followBlock
 // initializes our service from the provided eth1data object by initializing all the relevant
 // fields and data.
 func (s *Service) initializeEth1Data(ctx context.Context, eth1DataInDB *ethpb.ETH1ChainData) error {
+	snapshot, err := s.cfg.beaconDB.DepositSnapshot(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not retrieve deposit snapshot")
+	}
 	// The node has no eth1data persisted on disk, so we exit and instead
-	// request from contract logs.
+	// request from contract logs, starting from the deposit snapshot if one was saved.
 	if eth1DataInDB == nil {
-		return nil
+		return s.initializeFromDepositSnapshot(ctx, snapshot)
 	}
-	var err error
 	s.depositTrie, err = trie.CreateTrieFromProto(eth1DataInDB.Trie)
 	if err != nil {
 		return err
@@ -745,16 +781,83 @@ func (s *Service) initializeEth1Data(ctx context.Context, eth1DataInDB *ethpb.ET
 	}
 	s.latestEth1Data = eth1DataInDB.CurrentEth1Data
 	numOfItems := s.depositTrie.NumOfItems()
+	// A deposit snapshot ahead of the persisted deposits, such as one downloaded during checkpoint
+	// sync, saves the node from processing all the deposit logs it finalizes.
+	if snapshot != nil && snapshot.DepositCount > uint64(numOfItems) {
+		return s.initializeFromDepositSnapshot(ctx, snapshot)
+	}
 	s.lastReceivedMerkleIndex = int64(numOfItems - 1)
-	if err := s.initDepositCaches(ctx, eth1DataInDB.DepositContainers); err != nil {
+	ctrs := eth1DataInDB.DepositContainers
+	// The deposits preceding the persisted containers of a node started from a deposit
+	// snapshot are only known through the snapshot.
+	if snapshot != nil && numOfItems > 0 && (len(ctrs) == 0 || ctrs[0].Index > 0) {
+		if err := s.cfg.depositCache.InsertDepositSnapshot(ctx, snapshot); err != nil {
+			return errors.Wrap(err, "could not insert deposit snapshot")
+		}
+		ctrs = depositContainersFrom(ctrs, snapshot.DepositCount)
+	}
+	if err := s.initDepositCaches(ctx, ctrs); err != nil {
 		return errors.Wrap(err, "could not initialize caches")
 	}
 	return nil
 }
 
+// initializeFromDepositSnapshot initializes the deposit trie and the finalized deposits from an EIP-4881 deposit
+// snapshot. Deposit logs are then requested from the execution block of the snapshot onwards.
+func (s *Service) initializeFromDepositSnapshot(ctx context.Context, snapshot *ethpb.DepositSnapshot) error {
+	if snapshot == nil || snapshot.DepositCount == 0 {
+		return nil
+	}
+	// The snapshot is taken from finalized deposits, deposit logs are only resumed from it once the
+	// chain start data is known from the genesis state.
+	genState, err := s.cfg.beaconDB.GenesisState(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not retrieve genesis state")
+	}
+	if genState == nil || genState.IsNil() {
+		log.Warn("Ignoring deposit snapshot as no genesis state is saved")
+		return nil
+	}
+	depositTrie, err := trie.GenerateTrieFromSubtreeRoots(snapshot.Finalized, snapshot.DepositCount, params.BeaconConfig().DepositContractTreeDepth)
+	if err != nil {
+		return errors.Wrap(err, "could not generate deposit trie from snapshot")
+	}
+	if err := s.cfg.depositCache.InsertDepositSnapshot(ctx, snapshot); err != nil {
+		return errors.Wrap(err, "could not insert deposit snapshot")
+	}
+	s.depositTrie = depositTrie
+	s.lastReceivedMerkleIndex = int64(snapshot.DepositCount) - 1 // lint:ignore uintcast -- deposit count will not exceed int64 in your lifetime.
+	s.latestEth1Data.LastRequestedBlock = snapshot.ExecutionBlockHeight
+	s.chainStartData = &ethpb.ChainStartData{
+		Chainstarted:       true,
+		GenesisTime:        genState.GenesisTime(),
+		GenesisBlock:       0,
+		Eth1Data:           genState.Eth1Data(),
+		ChainstartDeposits: make([]*ethpb.Deposit, 0),
+	}
+	validDepositsCount.Add(float64(snapshot.DepositCount))
+	log.WithFields(logrus.Fields{
+		"depositCount":         snapshot.DepositCount,
+		"executionBlockHeight": snapshot.ExecutionBlockHeight,
+	}).Info("Initialized deposits from deposit snapshot")
+	return s.savePowchainData(ctx)
+}
+
+// depositContainersFrom returns the deposit containers with an index of at least the given index.
+func depositContainersFrom(ctrs []*ethpb.DepositContainer, index uint64) []*ethpb.DepositContainer {
+	filtered := make([]*ethpb.DepositContainer, 0, len(ctrs))
+	for _, c := range ctrs {
+		if uint64(c.Index) >= index {
+			filtered = append(filtered, c)
+		}
+	}
+	return filtered
+}
+
 // Validates that all deposit containers are valid and have their relevant indices
-// in order.
-func validateDepositContainers(ctrs []*ethpb.DepositContainer) bool {
+// in order. The containers of a node started from a deposit snapshot may begin at
+// any deposit covered by the snapshot.
+func validateDepositContainers(ctrs []*ethpb.DepositContainer, snapshot *ethpb.DepositSnapshot) bool {
 	ctrLen := len(ctrs)
 	// Exit for empty containers.
 	if ctrLen == 0 {
@@ -765,6 +868,9 @@ func validateDepositContainers(ctrs []*ethpb.DepositContainer) bool {
 		return ctrs[i].Index < ctrs[j].Index
 	})
 	startIndex := int64(0)
+	if snapshot != nil && uint64(ctrs[0].Index) <= snapshot.DepositCount {
+		startIndex = ctrs[0].Index
+	}
 	for _, c := range ctrs {
 		if c.Index != startIndex {
 			log.Info("Recovering missing deposit containers, node is re-requesting missing deposit data")
@@ -790,7 +896,11 @@ func (s *Service) ensureValidPowchainData(ctx context.Context) error {
 	if err != nil {
 		return errors.Wrap(err, "unable to retrieve eth1 data")
 	}
-	if eth1Data == nil || !eth1Data.ChainstartData.Chainstarted || !validateDepositContainers(eth1Data.DepositContainers) {
+	snapshot, err := s.cfg.beaconDB.DepositSnapshot(ctx)
+	if err != nil {
+		return errors.Wrap(err, "unable to retrieve deposit snapshot")
+	}
+	if eth1Data == nil || !eth1Data.ChainstartData.Chainstarted || !validateDepositContainers(eth1Data.DepositContainers, snapshot) {
 		pbState, err := native.ProtobufBeaconStatePhase0(s.preGenesisState.ToProtoUnsafe())
 		if err != nil {
 			return err
@@ -23,6 +23,7 @@ import (
 	doublylinkedtree "github.com/prysmaticlabs/prysm/v3/beacon-chain/forkchoice/doubly-linked-tree"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/state/stategen"
 	"github.com/prysmaticlabs/prysm/v3/config/params"
+	"github.com/prysmaticlabs/prysm/v3/container/trie"
 	contracts "github.com/prysmaticlabs/prysm/v3/contracts/deposit"
 	"github.com/prysmaticlabs/prysm/v3/contracts/deposit/mock"
 	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
@@ -662,6 +663,62 @@ func TestService_InitializeCorrectly(t *testing.T) {
 	assert.Equal(t, int64(-1), s1.lastReceivedMerkleIndex, "received incorrect last received merkle index")
 }
 
+func TestService_InitializeFromDepositSnapshot(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := dbutil.SetupDB(t)
+	genesisEth1Data := &ethpb.Eth1Data{DepositRoot: make([]byte, 32), BlockHash: bytesutil.PadTo([]byte{'g'}, 32)}
+	genState, err := util.NewBeaconState(func(s *ethpb.BeaconState) error {
+		s.GenesisTime = 1000
+		s.Eth1Data = genesisEth1Data
+		return nil
+	})
+	require.NoError(t, err)
+
+	depositTrie, err := trie.NewTrie(params.BeaconConfig().DepositContractTreeDepth)
+	require.NoError(t, err)
+	for i := 0; i < 5; i++ {
+		require.NoError(t, depositTrie.Insert(bytesutil.PadTo([]byte{byte(i)}, 32), i))
+	}
+	root, err := depositTrie.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveDepositSnapshot(ctx, &ethpb.DepositSnapshot{
+		Finalized:            depositTrie.SubtreeRoots(),
+		DepositRoot:          root[:],
+		DepositCount:         5,
+		ExecutionBlockHash:   bytesutil.PadTo([]byte{'h'}, 32),
+		ExecutionBlockHeight: 100,
+	}))
+
+	// Without genesis state the chain start data is unknown, the snapshot is ignored.
+	cache, err := depositcache.New()
+	require.NoError(t, err)
+	s, err := NewService(ctx, WithDatabase(beaconDB), WithDepositCache(cache))
+	require.NoError(t, err)
+	assert.Equal(t, int64(-1), s.lastReceivedMerkleIndex)
+	assert.Equal(t, false, s.chainStartData.Chainstarted)
+
+	require.NoError(t, beaconDB.SaveGenesisData(ctx, genState))
+	// The node is first started from the snapshot, then restarted from the persisted execution chain data.
+	for i := 0; i < 2; i++ {
+		cache, err := depositcache.New()
+		require.NoError(t, err)
+		s, err := NewService(ctx, WithDatabase(beaconDB), WithDepositCache(cache))
+		require.NoError(t, err)
+
+		assert.Equal(t, int64(4), s.lastReceivedMerkleIndex)
+		assert.Equal(t, uint64(100), s.latestEth1Data.LastRequestedBlock)
+		assert.Equal(t, true, s.chainStartData.Chainstarted)
+		assert.Equal(t, uint64(1000), s.chainStartData.GenesisTime)
+		assert.DeepEqual(t, genesisEth1Data, s.chainStartData.Eth1Data)
+		trieRoot, err := s.depositTrie.HashTreeRoot()
+		require.NoError(t, err)
+		assert.Equal(t, root, trieRoot)
+		count, depositRoot := cache.DepositsNumberAndRootAtHeight(ctx, big.NewInt(100))
+		assert.Equal(t, uint64(5), count)
+		assert.Equal(t, root, depositRoot)
+	}
+}
+
 func TestService_EnsureValidPowchainData(t *testing.T) {
 	beaconDB := dbutil.SetupDB(t)
 	cache, err := depositcache.New()
@@ -701,6 +758,7 @@ func TestService_ValidateDepositContainers(t *testing.T) {
 	var tt = []struct {
 		name        string
 		ctrsFunc    func() []*ethpb.DepositContainer
+		snapshot    *ethpb.DepositSnapshot
 		expectedRes bool
 	}{
 		{
@@ -746,10 +804,34 @@ func TestService_ValidateDepositContainers(t *testing.T) {
 			},
 			expectedRes: false,
 		},
+		{
+			name: "containers following deposit snapshot",
+			ctrsFunc: func() []*ethpb.DepositContainer {
+				ctrs := make([]*ethpb.DepositContainer, 0)
+				for i := 5; i < 10; i++ {
+					ctrs = append(ctrs, &ethpb.DepositContainer{Index: int64(i), Eth1BlockHeight: uint64(i + 10)})
+				}
+				return ctrs
+			},
+			snapshot:    &ethpb.DepositSnapshot{DepositCount: 7},
+			expectedRes: true,
+		},
+		{
+			name: "containers missing after deposit snapshot",
+			ctrsFunc: func() []*ethpb.DepositContainer {
+				ctrs := make([]*ethpb.DepositContainer, 0)
+				for i := 5; i < 10; i++ {
+					ctrs = append(ctrs, &ethpb.DepositContainer{Index: int64(i), Eth1BlockHeight: uint64(i + 10)})
+				}
+				return ctrs
+			},
+			snapshot:    &ethpb.DepositSnapshot{DepositCount: 3},
+			expectedRes: false,
+		},
 	}
 
 	for _, test := range tt {
-		assert.Equal(t, test.expectedRes, validateDepositContainers(test.ctrsFunc()))
+		assert.Equal(t, test.expectedRes, validateDepositContainers(test.ctrsFunc(), test.snapshot))
 	}
 }
 
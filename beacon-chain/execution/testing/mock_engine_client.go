@@ -21,6 +21,7 @@ type EngineClient struct {
 	PayloadIDBytes              *pb.PayloadIDBytes
 	ForkChoiceUpdatedResp       []byte
 	ExecutionPayload            *pb.ExecutionPayload
+	BlockValue                  *big.Int
 	ExecutionBlock              *pb.ExecutionBlock
 	Err                         error
 	ErrLatestExecBlock          error
@@ -31,6 +32,7 @@ type EngineClient struct {
 	ExecutionPayloadByBlockHash map[[32]byte]*pb.ExecutionPayload
 	BlockByHashMap              map[[32]byte]*pb.ExecutionBlock
 	NumReconstructedPayloads    uint64
+	NumReconstructedBatches     uint64
 	TerminalBlockHash           []byte
 	TerminalBlockHashExists     bool
 	OverrideValidHash           [32]byte
@@ -56,6 +58,11 @@ func (e *EngineClient) GetPayload(_ context.Context, _ [8]byte) (*pb.ExecutionPa
 	return e.ExecutionPayload, e.ErrGetPayload
 }
 
+// GetPayloadWithValue --
+func (e *EngineClient) GetPayloadWithValue(_ context.Context, _ [8]byte) (*pb.ExecutionPayload, *big.Int, error) {
+	return e.ExecutionPayload, e.BlockValue, e.ErrGetPayload
+}
+
 // ExchangeTransitionConfiguration --
 func (e *EngineClient) ExchangeTransitionConfiguration(_ context.Context, _ *pb.TransitionConfiguration) error {
 	return e.Err
@@ -96,6 +103,7 @@ func (e *EngineClient) ReconstructFullBellatrixBlock(
 func (e *EngineClient) ReconstructFullBellatrixBlockBatch(
 	ctx context.Context, blindedBlocks []interfaces.SignedBeaconBlock,
 ) ([]interfaces.SignedBeaconBlock, error) {
+	e.NumReconstructedBatches++
 	fullBlocks := make([]interfaces.SignedBeaconBlock, 0, len(blindedBlocks))
 	for _, b := range blindedBlocks {
 		newBlock, err := e.ReconstructFullBellatrixBlock(ctx, b)
@@ -0,0 +1,130 @@
+package doublylinkedtree
+
+import (
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v3/config/params"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v3/time/slots"
+)
+
+// ShouldOverrideFCU returns whether the current head is a late and weakly attested
+// block that the proposer of the next slot is expected to reorg. In that case the
+// caller should not send a forkchoice update for the head with payload attributes,
+// but one for its parent instead.
+//
+// Spec code (simplified):
+// def should_override_forkchoice_update(store: Store, head_root: Root) -> bool:
+//    head_block = store.blocks[head_root]
+//    parent_root = head_block.parent_root
+//    parent_block = store.blocks[parent_root]
+//    current_slot = get_current_slot(store)
+//    proposal_slot = head_block.slot + Slot(1)
+//
+//    head_late = is_head_late(store, head_root)
+//    shuffling_stable = is_shuffling_stable(proposal_slot)
+//    ffg_competitive = is_ffg_competitive(store, head_root, parent_root)
+//    finalization_ok = is_finalization_ok(store, proposal_slot)
+//    parent_slot_ok = parent_block.slot + 1 == head_block.slot
+//    current_time_ok = head_block.slot == current_slot
+//    head_weak = is_head_weak(store, head_root)
+//    parent_strong = is_parent_strong(store, parent_root)
+//
+//    return all([head_late, shuffling_stable, ffg_competitive, finalization_ok,
+//                parent_slot_ok, current_time_ok, head_weak, parent_strong])
+func (f *ForkChoice) ShouldOverrideFCU() bool {
+	f.store.nodesLock.RLock()
+	defer f.store.nodesLock.RUnlock()
+
+	head := f.store.headNode
+	if head == nil || head.parent == nil {
+		return false
+	}
+	if head.slot != slots.CurrentSlot(f.store.genesisTime) {
+		return false
+	}
+	return f.store.shouldReorg(head, head.slot+1)
+}
+
+// GetProposerHead returns the block root the proposer of the current slot should
+// build on. This is the head root, unless the head is a late and weakly attested block
+// from the previous slot, in which case its parent root is returned and the head is
+// reorged. The proposal must happen early in the slot for the reorg to be attempted.
+func (f *ForkChoice) GetProposerHead() [32]byte {
+	f.store.nodesLock.RLock()
+	defer f.store.nodesLock.RUnlock()
+
+	head := f.store.headNode
+	if head == nil {
+		return [32]byte{}
+	}
+	if head.parent == nil {
+		return head.root
+	}
+	if head.slot+1 != slots.CurrentSlot(f.store.genesisTime) {
+		return head.root
+	}
+	if !f.store.proposingOnTime() {
+		return head.root
+	}
+	if !f.store.shouldReorg(head, head.slot+1) {
+		return head.root
+	}
+	return head.parent.root
+}
+
+// shouldReorg checks the conditions, other than timing, under which the proposer of
+// the given slot builds on the parent of the head instead of the head.
+// It requires a lock on nodes.
+func (s *Store) shouldReorg(head *Node, proposalSlot types.Slot) bool {
+	cfg := params.BeaconConfig()
+	parent := head.parent
+	// Only reorg blocks that arrived after the attestation deadline.
+	if !head.arrivedLate(s.genesisTime) {
+		return false
+	}
+	// Do not reorg when the proposer shuffling may change.
+	if proposalSlot%cfg.SlotsPerEpoch == 0 {
+		return false
+	}
+	// Do not reorg if the head carries justification the parent doesn't.
+	if head.unrealizedJustifiedEpoch != parent.unrealizedJustifiedEpoch {
+		return false
+	}
+	s.checkpointsLock.RLock()
+	finalizedEpoch := s.finalizedCheckpoint.Epoch
+	s.checkpointsLock.RUnlock()
+	if slots.ToEpoch(proposalSlot) > finalizedEpoch+cfg.ReorgMaxEpochsSinceFinalization {
+		return false
+	}
+	// Only single slot reorgs are allowed.
+	if parent.slot+1 != head.slot {
+		return false
+	}
+	if head.weight*100 >= s.committeeBalance*cfg.ReorgHeadWeightThreshold {
+		return false
+	}
+	return parent.weight*100 > s.committeeBalance*cfg.ReorgParentWeightThreshold
+}
+
+// proposingOnTime returns whether the current time is early enough in the slot for the
+// proposer to attempt a reorg of the head.
+func (s *Store) proposingOnTime() bool {
+	timeNow := uint64(time.Now().Unix())
+	if timeNow < s.genesisTime {
+		return false
+	}
+	secondsIntoSlot := (timeNow - s.genesisTime) % params.BeaconConfig().SecondsPerSlot
+	proposerReorgCutoff := params.BeaconConfig().SecondsPerSlot / params.BeaconConfig().IntervalsPerSlot / 2
+	return secondsIntoSlot <= proposerReorgCutoff
+}
+
+// arrivedLate returns whether the node was inserted after the attestation deadline of its slot.
+func (n *Node) arrivedLate(genesisTime uint64) bool {
+	secondsPerSlot := params.BeaconConfig().SecondsPerSlot
+	slotStart := genesisTime + uint64(n.slot)*secondsPerSlot
+	if n.timestamp < slotStart {
+		return false
+	}
+	return n.timestamp-slotStart >= secondsPerSlot/params.BeaconConfig().IntervalsPerSlot
+}
@@ -0,0 +1,166 @@
+package doublylinkedtree
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v3/config/params"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+)
+
+// Sets up a forkchoice store whose head is a late and weak block at the given slot,
+// built on a strong parent one slot earlier. Weights are set directly on the nodes.
+func setupLateHead(t *testing.T, headSlot types.Slot) (*ForkChoice, *Node, *Node) {
+	ctx := context.Background()
+	f := setup(0, 0)
+	parentRoot, headRoot := [32]byte{'p'}, [32]byte{'h'}
+	st, root, err := prepareForkchoiceState(ctx, headSlot-1, parentRoot, params.BeaconConfig().ZeroHash, [32]byte{'A'}, 0, 0)
+	require.NoError(t, err)
+	require.NoError(t, f.InsertNode(ctx, st, root))
+	st, root, err = prepareForkchoiceState(ctx, headSlot, headRoot, parentRoot, [32]byte{'B'}, 0, 0)
+	require.NoError(t, err)
+	require.NoError(t, f.InsertNode(ctx, st, root))
+
+	parent := f.store.nodeByRoot[parentRoot]
+	head := f.store.nodeByRoot[headRoot]
+	f.store.headNode = head
+	f.store.committeeBalance = 100
+	parent.weight = 200
+	head.weight = 10
+	// The head arrived 5 seconds into its slot, after the attestation deadline.
+	head.timestamp = f.store.genesisTime + uint64(headSlot)*params.BeaconConfig().SecondsPerSlot + 5
+	return f, parent, head
+}
+
+func TestForkChoice_ShouldOverrideFCU(t *testing.T) {
+	headSlot := types.Slot(66)
+	// Drifts the genesis time so that the current slot is the slot of the head and
+	// keeps the arrival time of the head relative to its slot.
+	atHeadSlot := func(f *ForkChoice, head *Node) {
+		delay := head.timestamp - f.store.genesisTime - uint64(head.slot)*params.BeaconConfig().SecondsPerSlot
+		driftGenesisTime(f, headSlot, 0)
+		head.timestamp = f.store.genesisTime + uint64(head.slot)*params.BeaconConfig().SecondsPerSlot + delay
+	}
+
+	t.Run("late weak head", func(t *testing.T) {
+		f, _, head := setupLateHead(t, headSlot)
+		atHeadSlot(f, head)
+		require.Equal(t, true, f.ShouldOverrideFCU())
+	})
+	t.Run("timely head", func(t *testing.T) {
+		f, _, head := setupLateHead(t, headSlot)
+		head.timestamp -= 5
+		atHeadSlot(f, head)
+		require.Equal(t, false, f.ShouldOverrideFCU())
+	})
+	t.Run("head from a previous slot", func(t *testing.T) {
+		f, _, head := setupLateHead(t, headSlot)
+		atHeadSlot(f, head)
+		driftGenesisTime(f, headSlot+1, 0)
+		require.Equal(t, false, f.ShouldOverrideFCU())
+	})
+	t.Run("strong head", func(t *testing.T) {
+		f, _, head := setupLateHead(t, headSlot)
+		atHeadSlot(f, head)
+		head.weight = 20
+		require.Equal(t, false, f.ShouldOverrideFCU())
+	})
+	t.Run("weak parent", func(t *testing.T) {
+		f, parent, head := setupLateHead(t, headSlot)
+		atHeadSlot(f, head)
+		parent.weight = 160
+		require.Equal(t, false, f.ShouldOverrideFCU())
+	})
+	t.Run("skipped slot before head", func(t *testing.T) {
+		f, parent, head := setupLateHead(t, headSlot)
+		atHeadSlot(f, head)
+		parent.slot = headSlot - 2
+		require.Equal(t, false, f.ShouldOverrideFCU())
+	})
+	t.Run("head carries justification", func(t *testing.T) {
+		f, _, head := setupLateHead(t, headSlot)
+		atHeadSlot(f, head)
+		head.unrealizedJustifiedEpoch = 1
+		require.Equal(t, false, f.ShouldOverrideFCU())
+	})
+	t.Run("proposal at epoch boundary", func(t *testing.T) {
+		slot := params.BeaconConfig().SlotsPerEpoch - 1
+		f, _, head := setupLateHead(t, slot)
+		delay := head.timestamp - f.store.genesisTime - uint64(slot)*params.BeaconConfig().SecondsPerSlot
+		driftGenesisTime(f, slot, 0)
+		head.timestamp = f.store.genesisTime + uint64(slot)*params.BeaconConfig().SecondsPerSlot + delay
+		require.Equal(t, false, f.ShouldOverrideFCU())
+	})
+	t.Run("finalization too old", func(t *testing.T) {
+		params.SetupTestConfigCleanup(t)
+		cfg := params.BeaconConfig().Copy()
+		cfg.ReorgMaxEpochsSinceFinalization = 1
+		params.OverrideBeaconConfig(cfg)
+
+		f, _, head := setupLateHead(t, headSlot)
+		atHeadSlot(f, head)
+		require.Equal(t, false, f.ShouldOverrideFCU())
+	})
+	t.Run("configurable weight thresholds", func(t *testing.T) {
+		params.SetupTestConfigCleanup(t)
+		cfg := params.BeaconConfig().Copy()
+		cfg.ReorgHeadWeightThreshold = 5
+		params.OverrideBeaconConfig(cfg)
+
+		f, _, head := setupLateHead(t, headSlot)
+		atHeadSlot(f, head)
+		require.Equal(t, false, f.ShouldOverrideFCU())
+
+		cfg.ReorgHeadWeightThreshold = 20
+		cfg.ReorgParentWeightThreshold = 250
+		params.OverrideBeaconConfig(cfg)
+		require.Equal(t, false, f.ShouldOverrideFCU())
+	})
+}
+
+func TestForkChoice_GetProposerHead(t *testing.T) {
+	headSlot := types.Slot(66)
+	// Drifts the genesis time so that the current slot is the one after the head, the
+	// given number of seconds in, and keeps the arrival time of the head relative to its slot.
+	atProposalSlot := func(f *ForkChoice, head *Node, secondsIntoSlot uint64) {
+		delay := head.timestamp - f.store.genesisTime - uint64(head.slot)*params.BeaconConfig().SecondsPerSlot
+		driftGenesisTime(f, headSlot+1, secondsIntoSlot)
+		head.timestamp = f.store.genesisTime + uint64(head.slot)*params.BeaconConfig().SecondsPerSlot + delay
+	}
+
+	t.Run("reorgs late weak head", func(t *testing.T) {
+		f, parent, head := setupLateHead(t, headSlot)
+		atProposalSlot(f, head, 0)
+		require.Equal(t, parent.root, f.GetProposerHead())
+	})
+	t.Run("proposing late", func(t *testing.T) {
+		f, _, head := setupLateHead(t, headSlot)
+		atProposalSlot(f, head, 3)
+		require.Equal(t, head.root, f.GetProposerHead())
+	})
+	t.Run("timely head", func(t *testing.T) {
+		f, _, head := setupLateHead(t, headSlot)
+		head.timestamp -= 5
+		atProposalSlot(f, head, 0)
+		require.Equal(t, head.root, f.GetProposerHead())
+	})
+	t.Run("strong head", func(t *testing.T) {
+		f, _, head := setupLateHead(t, headSlot)
+		atProposalSlot(f, head, 0)
+		head.weight = 50
+		require.Equal(t, head.root, f.GetProposerHead())
+	})
+	t.Run("weak parent", func(t *testing.T) {
+		f, parent, head := setupLateHead(t, headSlot)
+		atProposalSlot(f, head, 0)
+		parent.weight = 100
+		require.Equal(t, head.root, f.GetProposerHead())
+	})
+	t.Run("head older than previous slot", func(t *testing.T) {
+		f, _, head := setupLateHead(t, headSlot)
+		atProposalSlot(f, head, 0)
+		driftGenesisTime(f, headSlot+2, 0)
+		require.Equal(t, head.root, f.GetProposerHead())
+	})
+}
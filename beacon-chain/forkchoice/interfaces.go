@@ -70,6 +70,8 @@ type Getter interface {
 	ReceivedBlocksLastEpoch() (uint64, error)
 	ForkChoiceDump(context.Context) (*v1.ForkChoiceResponse, error)
 	VotedFraction(root [32]byte) (uint64, error)
+	ShouldOverrideFCU() bool
+	GetProposerHead() [32]byte
 }
 
 // Setter allows to set forkchoice information
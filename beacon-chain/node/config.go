@@ -68,6 +68,70 @@ func configureBuilderCircuitBreaker(cliCtx *cli.Context) error {
 			return err
 		}
 	}
+	if cliCtx.IsSet(flags.MaxBuilderEpochsSinceFinality.Name) {
+		c := params.BeaconConfig().Copy()
+		c.MaxBuilderEpochsSinceFinality = types.Epoch(cliCtx.Uint64(flags.MaxBuilderEpochsSinceFinality.Name))
+		if err := params.SetActive(c); err != nil {
+			return err
+		}
+	}
+	if cliCtx.IsSet(flags.MaxBuilderReorgDepth.Name) {
+		c := params.BeaconConfig().Copy()
+		c.MaxBuilderReorgDepth = cliCtx.Uint64(flags.MaxBuilderReorgDepth.Name)
+		if err := params.SetActive(c); err != nil {
+			return err
+		}
+	}
+	if cliCtx.IsSet(flags.MaxBuilderRelayFailureRate.Name) {
+		c := params.BeaconConfig().Copy()
+		c.MaxBuilderRelayFailureRate = cliCtx.Uint64(flags.MaxBuilderRelayFailureRate.Name)
+		if err := params.SetActive(c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func configureBuilderBidSelection(cliCtx *cli.Context) error {
+	if cliCtx.IsSet(flags.LocalBlockValueBoost.Name) {
+		c := params.BeaconConfig().Copy()
+		c.LocalBlockValueBoost = cliCtx.Uint64(flags.LocalBlockValueBoost.Name)
+		if err := params.SetActive(c); err != nil {
+			return err
+		}
+	}
+	if cliCtx.IsSet(flags.MinBuilderBid.Name) {
+		c := params.BeaconConfig().Copy()
+		c.MinBuilderBid = cliCtx.Uint64(flags.MinBuilderBid.Name)
+		if err := params.SetActive(c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func configureLateBlockReorg(cliCtx *cli.Context) error {
+	if cliCtx.IsSet(flags.ReorgHeadWeightThreshold.Name) {
+		c := params.BeaconConfig().Copy()
+		c.ReorgHeadWeightThreshold = cliCtx.Uint64(flags.ReorgHeadWeightThreshold.Name)
+		if err := params.SetActive(c); err != nil {
+			return err
+		}
+	}
+	if cliCtx.IsSet(flags.ReorgParentWeightThreshold.Name) {
+		c := params.BeaconConfig().Copy()
+		c.ReorgParentWeightThreshold = cliCtx.Uint64(flags.ReorgParentWeightThreshold.Name)
+		if err := params.SetActive(c); err != nil {
+			return err
+		}
+	}
+	if cliCtx.IsSet(flags.ReorgMaxEpochsSinceFinalization.Name) {
+		c := params.BeaconConfig().Copy()
+		c.ReorgMaxEpochsSinceFinalization = types.Epoch(cliCtx.Uint64(flags.ReorgMaxEpochsSinceFinalization.Name))
+		if err := params.SetActive(c); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -53,6 +53,63 @@ func TestConfigureSlotsPerArchivedPoint(t *testing.T) {
 	assert.Equal(t, types.Slot(100), params.BeaconConfig().SlotsPerArchivedPoint)
 }
 
+func TestConfigureBuilderBidSelection(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.Uint64(flags.LocalBlockValueBoost.Name, 0, "")
+	set.Uint64(flags.MinBuilderBid.Name, 0, "")
+	require.NoError(t, set.Set(flags.LocalBlockValueBoost.Name, "10"))
+	require.NoError(t, set.Set(flags.MinBuilderBid.Name, "50000000"))
+	cliCtx := cli.NewContext(&app, set, nil)
+
+	require.NoError(t, configureBuilderBidSelection(cliCtx))
+
+	assert.Equal(t, uint64(10), params.BeaconConfig().LocalBlockValueBoost)
+	assert.Equal(t, uint64(50000000), params.BeaconConfig().MinBuilderBid)
+}
+
+func TestConfigureBuilderCircuitBreaker(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.Uint64(flags.MaxBuilderEpochsSinceFinality.Name, 0, "")
+	set.Uint64(flags.MaxBuilderReorgDepth.Name, 0, "")
+	set.Uint64(flags.MaxBuilderRelayFailureRate.Name, 0, "")
+	require.NoError(t, set.Set(flags.MaxBuilderEpochsSinceFinality.Name, "8"))
+	require.NoError(t, set.Set(flags.MaxBuilderReorgDepth.Name, "3"))
+	require.NoError(t, set.Set(flags.MaxBuilderRelayFailureRate.Name, "25"))
+	cliCtx := cli.NewContext(&app, set, nil)
+
+	require.NoError(t, configureBuilderCircuitBreaker(cliCtx))
+
+	assert.Equal(t, types.Epoch(8), params.BeaconConfig().MaxBuilderEpochsSinceFinality)
+	assert.Equal(t, uint64(3), params.BeaconConfig().MaxBuilderReorgDepth)
+	assert.Equal(t, uint64(25), params.BeaconConfig().MaxBuilderRelayFailureRate)
+}
+
+func TestConfigureLateBlockReorg(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.Uint64(flags.ReorgHeadWeightThreshold.Name, 0, "")
+	set.Uint64(flags.ReorgParentWeightThreshold.Name, 0, "")
+	set.Uint64(flags.ReorgMaxEpochsSinceFinalization.Name, 0, "")
+	require.NoError(t, set.Set(flags.ReorgHeadWeightThreshold.Name, "10"))
+	require.NoError(t, set.Set(flags.ReorgParentWeightThreshold.Name, "200"))
+	require.NoError(t, set.Set(flags.ReorgMaxEpochsSinceFinalization.Name, "4"))
+	cliCtx := cli.NewContext(&app, set, nil)
+
+	require.NoError(t, configureLateBlockReorg(cliCtx))
+
+	assert.Equal(t, uint64(10), params.BeaconConfig().ReorgHeadWeightThreshold)
+	assert.Equal(t, uint64(200), params.BeaconConfig().ReorgParentWeightThreshold)
+	assert.Equal(t, types.Epoch(4), params.BeaconConfig().ReorgMaxEpochsSinceFinalization)
+}
+
 func TestConfigureProofOfWork(t *testing.T) {
 	params.SetupTestConfigCleanup(t)
 
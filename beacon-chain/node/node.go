@@ -134,6 +134,12 @@ func New(cliCtx *cli.Context, opts ...Option) (*BeaconNode, error) {
 	if err != nil {
 		return nil, err
 	}
+	if err := configureBuilderBidSelection(cliCtx); err != nil {
+		return nil, err
+	}
+	if err := configureLateBlockReorg(cliCtx); err != nil {
+		return nil, err
+	}
 	if err := configureSlotsPerArchivedPoint(cliCtx); err != nil {
 		return nil, err
 	}
@@ -190,6 +196,11 @@ func New(cliCtx *cli.Context, opts ...Option) (*BeaconNode, error) {
 		return nil, err
 	}
 
+	log.Debugln("Restoring Operation Pools")
+	if err := beacon.loadOperationPools(); err != nil {
+		log.WithError(err).Warn("Could not restore operation pools from database")
+	}
+
 	log.Debugln("Starting Slashing DB")
 	if err := beacon.startSlasherDB(cliCtx); err != nil {
 		return nil, err
@@ -340,6 +351,9 @@ func (b *BeaconNode) Close() {
 
 	log.Info("Stopping beacon node")
 	b.services.StopAll()
+	if err := b.saveOperationPools(); err != nil {
+		log.WithError(err).Error("Failed to save operation pools")
+	}
 	if err := b.db.Close(); err != nil {
 		log.WithError(err).Error("Failed to close database")
 	}
@@ -590,6 +604,7 @@ func (b *BeaconNode) registerBlockchainService(fc forkchoice.ForkChoicer) error
 		blockchain.WithDepositCache(b.depositCache),
 		blockchain.WithChainStartFetcher(web3Service),
 		blockchain.WithExecutionEngineCaller(web3Service),
+		blockchain.WithExecutionBlockFetcher(web3Service),
 		blockchain.WithAttestationPool(b.attestationPool),
 		blockchain.WithExitPool(b.exitPool),
 		blockchain.WithSlashingPool(b.slashingsPool),
@@ -804,6 +819,7 @@ func (b *BeaconNode) registerRPCService() error {
 		GenesisTimeFetcher:            chainService,
 		GenesisFetcher:                chainService,
 		OptimisticModeFetcher:         chainService,
+		PayloadInvalidationFetcher:    chainService,
 		AttestationsPool:              b.attestationPool,
 		ExitPool:                      b.exitPool,
 		SlashingsPool:                 b.slashingsPool,
@@ -963,6 +979,9 @@ func (b *BeaconNode) registerBuilderService() error {
 
 	opts := append(b.serviceFlagOpts.builderOpts,
 		builder.WithHeadFetcher(chainService),
+		builder.WithForkFetcher(chainService),
+		builder.WithFinalizationFetcher(chainService),
+		builder.WithStateNotifier(b),
 		builder.WithDatabase(b.db))
 	svc, err := builder.NewService(b.ctx, opts...)
 	if err != nil {
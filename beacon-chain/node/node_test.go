@@ -147,12 +147,15 @@ func TestClearDB(t *testing.T) {
 	set.String("suggested-fee-recipient", "0x6e35733c5af9B61374A128e6F85f553aF09ff89A", "fee recipient")
 	require.NoError(t, set.Set("suggested-fee-recipient", "0x6e35733c5af9B61374A128e6F85f553aF09ff89A"))
 	context := cli.NewContext(&app, set, nil)
-	_, err = New(context, WithExecutionChainOptions([]execution.Option{
+	node, err := New(context, WithExecutionChainOptions([]execution.Option{
 		execution.WithHttpEndpoint(endpoint),
 	}))
 	require.NoError(t, err)
 
 	require.LogsContain(t, hook, "Removing database")
+	// Closing the node closes its database, which unregisters the prometheus collector of the database.
+	// Otherwise the next test of the package opening a database fails with a duplicate metrics collector registration.
+	node.Close()
 }
 
 func TestMonitor_RegisteredCorrectly(t *testing.T) {
@@ -0,0 +1,99 @@
+package node
+
+import (
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/sirupsen/logrus"
+)
+
+// saveOperationPools saves the contents of the operation pools in the database, so that pending
+// operations are not lost when the beacon node restarts.
+func (b *BeaconNode) saveOperationPools() error {
+	unaggregatedAtts, err := b.attestationPool.UnaggregatedAttestations()
+	if err != nil {
+		return errors.Wrap(err, "could not get unaggregated attestations")
+	}
+	messages, err := b.syncCommitteePool.AllSyncCommitteeMessages()
+	if err != nil {
+		return errors.Wrap(err, "could not get sync committee messages")
+	}
+	contributions, err := b.syncCommitteePool.AllSyncCommitteeContributions()
+	if err != nil {
+		return errors.Wrap(err, "could not get sync committee contributions")
+	}
+	proposerSlashings, attesterSlashings := b.slashingsPool.AllPendingSlashings()
+	pools := &ethpb.OperationPools{
+		AggregatedAttestations:     b.attestationPool.AggregatedAttestations(),
+		UnaggregatedAttestations:   unaggregatedAtts,
+		BlockAttestations:          b.attestationPool.BlockAttestations(),
+		ForkchoiceAttestations:     b.attestationPool.ForkchoiceAttestations(),
+		SyncCommitteeMessages:      messages,
+		SyncCommitteeContributions: contributions,
+		ProposerSlashings:          proposerSlashings,
+		AttesterSlashings:          attesterSlashings,
+		VoluntaryExits:             b.exitPool.AllPendingExits(),
+	}
+	if err := b.db.SaveOperationPools(b.ctx, pools); err != nil {
+		return errors.Wrap(err, "could not save operation pools")
+	}
+	log.WithFields(operationPoolsFields(pools)).Info("Saved operation pools to database")
+	return nil
+}
+
+// loadOperationPools restores the contents of the operation pools saved in the database, and deletes them
+// from the database once restored. Expired attestations are pruned by the attestation pool service once the
+// genesis time is known, and the other operations are checked against the head state when they are requested
+// for a block.
+func (b *BeaconNode) loadOperationPools() error {
+	pools, err := b.db.OperationPools(b.ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get operation pools")
+	}
+	if pools == nil {
+		return nil
+	}
+	if err := b.attestationPool.SaveAggregatedAttestations(pools.AggregatedAttestations); err != nil {
+		return errors.Wrap(err, "could not restore aggregated attestations")
+	}
+	if err := b.attestationPool.SaveUnaggregatedAttestations(pools.UnaggregatedAttestations); err != nil {
+		return errors.Wrap(err, "could not restore unaggregated attestations")
+	}
+	if err := b.attestationPool.SaveBlockAttestations(pools.BlockAttestations); err != nil {
+		return errors.Wrap(err, "could not restore block attestations")
+	}
+	if err := b.attestationPool.SaveForkchoiceAttestations(pools.ForkchoiceAttestations); err != nil {
+		return errors.Wrap(err, "could not restore fork choice attestations")
+	}
+	for _, msg := range pools.SyncCommitteeMessages {
+		if err := b.syncCommitteePool.SaveSyncCommitteeMessage(msg); err != nil {
+			return errors.Wrap(err, "could not restore sync committee message")
+		}
+	}
+	for _, contr := range pools.SyncCommitteeContributions {
+		if err := b.syncCommitteePool.SaveSyncCommitteeContribution(contr); err != nil {
+			return errors.Wrap(err, "could not restore sync committee contribution")
+		}
+	}
+	b.slashingsPool.RestorePendingSlashings(pools.ProposerSlashings, pools.AttesterSlashings)
+	b.exitPool.RestorePendingExits(pools.VoluntaryExits)
+	// The restored operations are saved again on shutdown, they must not be restored once more after a crash.
+	if err := b.db.DeleteOperationPools(b.ctx); err != nil {
+		return errors.Wrap(err, "could not delete restored operation pools")
+	}
+	log.WithFields(operationPoolsFields(pools)).Info("Restored operation pools from database")
+	return nil
+}
+
+func operationPoolsFields(pools *ethpb.OperationPools) logrus.Fields {
+	return logrus.Fields{
+		"aggregatedAttestations":     len(pools.AggregatedAttestations),
+		"unaggregatedAttestations":   len(pools.UnaggregatedAttestations),
+		"blockAttestations":          len(pools.BlockAttestations),
+		"forkchoiceAttestations":     len(pools.ForkchoiceAttestations),
+		"syncCommitteeMessages":      len(pools.SyncCommitteeMessages),
+		"syncCommitteeContributions": len(pools.SyncCommitteeContributions),
+		"proposerSlashings":          len(pools.ProposerSlashings),
+		"attesterSlashings":          len(pools.AttesterSlashings),
+		"voluntaryExits":             len(pools.VoluntaryExits),
+	}
+}
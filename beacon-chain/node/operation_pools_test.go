@@ -0,0 +1,87 @@
+package node
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/go-bitfield"
+	dbtest "github.com/prysmaticlabs/prysm/v3/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/operations/attestations"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/operations/slashings"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/operations/synccommittee"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/operations/voluntaryexits"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+	"github.com/prysmaticlabs/prysm/v3/testing/util"
+)
+
+func TestOperationPools_SaveAndLoad(t *testing.T) {
+	ctx := context.Background()
+	d := dbtest.SetupDB(t)
+	newNode := func() *BeaconNode {
+		return &BeaconNode{
+			ctx:               ctx,
+			db:                d,
+			attestationPool:   attestations.NewPool(),
+			exitPool:          voluntaryexits.NewPool(),
+			slashingsPool:     slashings.NewPool(),
+			syncCommitteePool: synccommittee.NewPool(),
+		}
+	}
+
+	// Nothing is restored before the pools are saved.
+	b := newNode()
+	require.NoError(t, b.loadOperationPools())
+	assert.Equal(t, 0, b.attestationPool.AggregatedAttestationCount())
+
+	aggregated := util.HydrateAttestation(&ethpb.Attestation{AggregationBits: bitfield.Bitlist{0b1101}})
+	unaggregated := util.HydrateAttestation(&ethpb.Attestation{AggregationBits: bitfield.Bitlist{0b1001}})
+	require.NoError(t, b.attestationPool.SaveAggregatedAttestation(aggregated))
+	require.NoError(t, b.attestationPool.SaveUnaggregatedAttestation(unaggregated))
+	require.NoError(t, b.attestationPool.SaveBlockAttestation(aggregated))
+	require.NoError(t, b.attestationPool.SaveForkchoiceAttestation(unaggregated))
+	msg := &ethpb.SyncCommitteeMessage{Slot: 1, ValidatorIndex: 2, BlockRoot: make([]byte, 32), Signature: make([]byte, 96)}
+	require.NoError(t, b.syncCommitteePool.SaveSyncCommitteeMessage(msg))
+	contr := &ethpb.SyncCommitteeContribution{Slot: 1, SubcommitteeIndex: 3, BlockRoot: make([]byte, 32), Signature: make([]byte, 96)}
+	require.NoError(t, b.syncCommitteePool.SaveSyncCommitteeContribution(contr))
+	attSlashing := &ethpb.AttesterSlashing{
+		Attestation_1: &ethpb.IndexedAttestation{AttestingIndices: []uint64{4, 5}},
+		Attestation_2: &ethpb.IndexedAttestation{AttestingIndices: []uint64{4, 5}},
+	}
+	propSlashing := &ethpb.ProposerSlashing{
+		Header_1: &ethpb.SignedBeaconBlockHeader{Header: &ethpb.BeaconBlockHeader{ProposerIndex: 6}},
+		Header_2: &ethpb.SignedBeaconBlockHeader{Header: &ethpb.BeaconBlockHeader{ProposerIndex: 6}},
+	}
+	b.slashingsPool.RestorePendingSlashings([]*ethpb.ProposerSlashing{propSlashing}, []*ethpb.AttesterSlashing{attSlashing})
+	exit := &ethpb.SignedVoluntaryExit{Exit: &ethpb.VoluntaryExit{ValidatorIndex: 7}, Signature: make([]byte, 96)}
+	b.exitPool.RestorePendingExits([]*ethpb.SignedVoluntaryExit{exit})
+	require.NoError(t, b.saveOperationPools())
+
+	restored := newNode()
+	require.NoError(t, restored.loadOperationPools())
+	assert.DeepSSZEqual(t, []*ethpb.Attestation{aggregated}, restored.attestationPool.AggregatedAttestations())
+	unaggregatedAtts, err := restored.attestationPool.UnaggregatedAttestations()
+	require.NoError(t, err)
+	assert.DeepSSZEqual(t, []*ethpb.Attestation{unaggregated}, unaggregatedAtts)
+	assert.DeepSSZEqual(t, []*ethpb.Attestation{aggregated}, restored.attestationPool.BlockAttestations())
+	assert.DeepSSZEqual(t, []*ethpb.Attestation{unaggregated}, restored.attestationPool.ForkchoiceAttestations())
+	msgs, err := restored.syncCommitteePool.SyncCommitteeMessages(1)
+	require.NoError(t, err)
+	assert.DeepSSZEqual(t, []*ethpb.SyncCommitteeMessage{msg}, msgs)
+	contrs, err := restored.syncCommitteePool.SyncCommitteeContributions(1)
+	require.NoError(t, err)
+	assert.DeepSSZEqual(t, []*ethpb.SyncCommitteeContribution{contr}, contrs)
+	propSlashings, attSlashings := restored.slashingsPool.AllPendingSlashings()
+	assert.DeepSSZEqual(t, []*ethpb.ProposerSlashing{propSlashing}, propSlashings)
+	assert.DeepSSZEqual(t, []*ethpb.AttesterSlashing{attSlashing}, attSlashings)
+	assert.DeepSSZEqual(t, []*ethpb.SignedVoluntaryExit{exit}, restored.exitPool.AllPendingExits())
+
+	// The saved pools are deleted once restored, so they are not restored again after a crash.
+	pools, err := d.OperationPools(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, (*ethpb.OperationPools)(nil), pools)
+	again := newNode()
+	require.NoError(t, again.loadOperationPools())
+	assert.Equal(t, 0, again.attestationPool.AggregatedAttestationCount())
+}
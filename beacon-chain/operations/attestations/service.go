@@ -70,7 +70,12 @@ func (s *Service) Status() error {
 	return nil
 }
 
-// SetGenesisTime sets genesis time for operation service to use.
+// SetGenesisTime sets genesis time for operation service to use. Attestations in the pool which
+// expired before the genesis time was known, such as the ones restored from the database on
+// startup, are pruned right away.
 func (s *Service) SetGenesisTime(t uint64) {
 	s.genesisTime = t
+	if s.cfg.Pool != nil {
+		s.pruneExpiredAtts()
+	}
 }
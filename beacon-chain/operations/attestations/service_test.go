@@ -5,8 +5,13 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/v3/config/params"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v3/testing/assert"
 	"github.com/prysmaticlabs/prysm/v3/testing/require"
+	"github.com/prysmaticlabs/prysm/v3/testing/util"
+	prysmTime "github.com/prysmaticlabs/prysm/v3/time"
 )
 
 func TestStop_OK(t *testing.T) {
@@ -21,3 +26,19 @@ func TestStatus_Error(t *testing.T) {
 	s := &Service{err: err}
 	assert.ErrorContains(t, s.err.Error(), s.Status())
 }
+
+func TestSetGenesisTime_PrunesExpiredAtts(t *testing.T) {
+	s, err := NewService(context.Background(), &Config{Pool: NewPool()})
+	require.NoError(t, err)
+
+	// Attestations restored from the database before the genesis time is known.
+	att1 := &ethpb.Attestation{Data: util.HydrateAttestationData(&ethpb.AttestationData{}), AggregationBits: bitfield.Bitlist{0b1101}}
+	att2 := &ethpb.Attestation{Data: util.HydrateAttestationData(&ethpb.AttestationData{Slot: 1}), AggregationBits: bitfield.Bitlist{0b1101}}
+	require.NoError(t, s.cfg.Pool.SaveAggregatedAttestations([]*ethpb.Attestation{att1, att2}))
+	require.NoError(t, s.cfg.Pool.SaveBlockAttestations([]*ethpb.Attestation{att1, att2}))
+
+	// Genesis was one epoch ago, the attestations of slot 0 expired.
+	s.SetGenesisTime(uint64(prysmTime.Now().Unix()) - uint64(params.BeaconConfig().SlotsPerEpoch.Mul(params.BeaconConfig().SecondsPerSlot)))
+	assert.DeepEqual(t, []*ethpb.Attestation{att2}, s.cfg.Pool.AggregatedAttestations())
+	assert.DeepEqual(t, []*ethpb.Attestation{att2}, s.cfg.Pool.BlockAttestations())
+}
@@ -44,3 +44,14 @@ func (*PoolMock) MarkIncludedAttesterSlashing(_ *ethpb.AttesterSlashing) {
 func (*PoolMock) MarkIncludedProposerSlashing(_ *ethpb.ProposerSlashing) {
 	panic("implement me")
 }
+
+// AllPendingSlashings --
+func (m *PoolMock) AllPendingSlashings() ([]*ethpb.ProposerSlashing, []*ethpb.AttesterSlashing) {
+	return m.PendingPropSlashings, m.PendingAttSlashings
+}
+
+// RestorePendingSlashings --
+func (m *PoolMock) RestorePendingSlashings(proposerSlashings []*ethpb.ProposerSlashing, attesterSlashings []*ethpb.AttesterSlashing) {
+	m.PendingPropSlashings = append(m.PendingPropSlashings, proposerSlashings...)
+	m.PendingAttSlashings = append(m.PendingAttSlashings, attesterSlashings...)
+}
@@ -261,6 +261,78 @@ func (p *Pool) MarkIncludedProposerSlashing(ps *ethpb.ProposerSlashing) {
 	numProposerSlashingsIncluded.Inc()
 }
 
+// AllPendingSlashings returns all the proposer and attester slashings in the pool, without checking
+// whether they can still be included into a block. An attester slashing slashing several validators is
+// only returned once.
+func (p *Pool) AllPendingSlashings() ([]*ethpb.ProposerSlashing, []*ethpb.AttesterSlashing) {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	proposerSlashings := make([]*ethpb.ProposerSlashing, len(p.pendingProposerSlashing))
+	copy(proposerSlashings, p.pendingProposerSlashing)
+
+	seen := make(map[*ethpb.AttesterSlashing]bool)
+	attesterSlashings := make([]*ethpb.AttesterSlashing, 0, len(p.pendingAttesterSlashing))
+	for _, slashing := range p.pendingAttesterSlashing {
+		if seen[slashing.attesterSlashing] {
+			continue
+		}
+		seen[slashing.attesterSlashing] = true
+		attesterSlashings = append(attesterSlashings, slashing.attesterSlashing)
+	}
+	return proposerSlashings, attesterSlashings
+}
+
+// RestorePendingSlashings inserts slashings which were previously returned by AllPendingSlashings back
+// into the pool, such as after a restart. The slashings are not verified against a state, as they were
+// when first inserted; slashings which can no longer be included are removed when the pending slashings
+// are requested for a block.
+func (p *Pool) RestorePendingSlashings(proposerSlashings []*ethpb.ProposerSlashing, attesterSlashings []*ethpb.AttesterSlashing) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	for _, slashing := range proposerSlashings {
+		if slashing == nil || slashing.Header_1 == nil || slashing.Header_1.Header == nil {
+			continue
+		}
+		idx := slashing.Header_1.Header.ProposerIndex
+		found := sort.Search(len(p.pendingProposerSlashing), func(i int) bool {
+			return p.pendingProposerSlashing[i].Header_1.Header.ProposerIndex >= idx
+		})
+		if found != len(p.pendingProposerSlashing) && p.pendingProposerSlashing[found].Header_1.Header.ProposerIndex == idx {
+			continue
+		}
+		p.pendingProposerSlashing = append(p.pendingProposerSlashing, slashing)
+		sort.Slice(p.pendingProposerSlashing, func(i, j int) bool {
+			return p.pendingProposerSlashing[i].Header_1.Header.ProposerIndex < p.pendingProposerSlashing[j].Header_1.Header.ProposerIndex
+		})
+	}
+	numPendingProposerSlashings.Set(float64(len(p.pendingProposerSlashing)))
+
+	for _, slashing := range attesterSlashings {
+		if slashing == nil || slashing.Attestation_1 == nil || slashing.Attestation_2 == nil {
+			continue
+		}
+		slashedVal := slice.IntersectionUint64(slashing.Attestation_1.AttestingIndices, slashing.Attestation_2.AttestingIndices)
+		for _, val := range slashedVal {
+			found := sort.Search(len(p.pendingAttesterSlashing), func(i int) bool {
+				return uint64(p.pendingAttesterSlashing[i].validatorToSlash) >= val
+			})
+			if found != len(p.pendingAttesterSlashing) && uint64(p.pendingAttesterSlashing[found].validatorToSlash) == val {
+				continue
+			}
+			p.pendingAttesterSlashing = append(p.pendingAttesterSlashing, &PendingAttesterSlashing{
+				attesterSlashing: slashing,
+				validatorToSlash: types.ValidatorIndex(val),
+			})
+			sort.Slice(p.pendingAttesterSlashing, func(i, j int) bool {
+				return p.pendingAttesterSlashing[i].validatorToSlash < p.pendingAttesterSlashing[j].validatorToSlash
+			})
+		}
+	}
+	numPendingAttesterSlashings.Set(float64(len(p.pendingAttesterSlashing)))
+}
+
 // this function checks a few items about a validator before proceeding with inserting
 // a proposer/attester slashing into the pool. First, it checks if the validator
 // has been recently included in the pool, then it checks if the validator is slashable.
@@ -4,6 +4,9 @@ import (
 	"testing"
 
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/operations/slashings/mock"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
 	"github.com/prysmaticlabs/prysm/v3/testing/require"
 )
 
@@ -19,3 +22,39 @@ func TestPool_validatorSlashingPreconditionCheck_requiresLock(t *testing.T) {
 	_, err := p.validatorSlashingPreconditionCheck(nil, 0)
 	require.ErrorContains(t, "caller must hold read/write lock", err)
 }
+
+func TestPool_AllPendingSlashings_RestorePendingSlashings(t *testing.T) {
+	p := NewPool()
+	attSlashing := attesterSlashingForValIdx(1, 3)
+	p.pendingProposerSlashing = []*ethpb.ProposerSlashing{proposerSlashingForValIdx(2), proposerSlashingForValIdx(5)}
+	p.pendingAttesterSlashing = []*PendingAttesterSlashing{
+		{attesterSlashing: attSlashing, validatorToSlash: 1},
+		{attesterSlashing: attSlashing, validatorToSlash: 3},
+		pendingSlashingForValIdx(4),
+	}
+
+	proposerSlashings, attesterSlashings := p.AllPendingSlashings()
+	require.DeepEqual(t, p.pendingProposerSlashing, proposerSlashings)
+	require.Equal(t, 2, len(attesterSlashings))
+	assert.DeepEqual(t, attSlashing, attesterSlashings[0])
+	assert.DeepEqual(t, attesterSlashingForValIdx(4), attesterSlashings[1])
+
+	restored := NewPool()
+	restored.pendingProposerSlashing = []*ethpb.ProposerSlashing{proposerSlashingForValIdx(5)}
+	restored.RestorePendingSlashings(
+		append(proposerSlashings, proposerSlashingForValIdx(0), nil),
+		append(attesterSlashings, attesterSlashingForValIdx(0, 3)),
+	)
+	require.DeepEqual(t, []*ethpb.ProposerSlashing{
+		proposerSlashingForValIdx(0),
+		proposerSlashingForValIdx(2),
+		proposerSlashingForValIdx(5),
+	}, restored.pendingProposerSlashing)
+	var validators []types.ValidatorIndex
+	for _, slashing := range restored.pendingAttesterSlashing {
+		validators = append(validators, slashing.validatorToSlash)
+	}
+	require.DeepEqual(t, []types.ValidatorIndex{0, 1, 3, 4}, validators)
+	// The validator slashed by two slashings keeps the first one.
+	assert.DeepEqual(t, attSlashing, restored.pendingAttesterSlashing[2].attesterSlashing)
+}
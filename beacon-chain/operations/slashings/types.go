@@ -31,6 +31,8 @@ type PoolManager interface {
 	PendingProposerSlashings(ctx context.Context, state state.ReadOnlyBeaconState, noLimit bool) []*ethpb.ProposerSlashing
 	MarkIncludedAttesterSlashing(as *ethpb.AttesterSlashing)
 	MarkIncludedProposerSlashing(ps *ethpb.ProposerSlashing)
+	AllPendingSlashings() ([]*ethpb.ProposerSlashing, []*ethpb.AttesterSlashing)
+	RestorePendingSlashings(proposerSlashings []*ethpb.ProposerSlashing, attesterSlashings []*ethpb.AttesterSlashing)
 }
 
 // Pool is a concrete implementation of PoolManager.
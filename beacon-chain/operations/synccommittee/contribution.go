@@ -85,6 +85,24 @@ func (s *Store) SyncCommitteeContributions(slot types.Slot) ([]*ethpb.SyncCommit
 	return contributions, nil
 }
 
+// AllSyncCommitteeContributions returns the sync committee contributions of all slots in the priority queue,
+// ordered by slot. The contributions are not removed from the queue.
+func (s *Store) AllSyncCommitteeContributions() ([]*ethpb.SyncCommitteeContribution, error) {
+	s.contributionLock.RLock()
+	defer s.contributionLock.RUnlock()
+
+	var all []*ethpb.SyncCommitteeContribution
+	for _, item := range s.contributionCache.Items() {
+		contributions, ok := item.Value.([]*ethpb.SyncCommitteeContribution)
+		if !ok {
+			return nil, errors.New("not typed []ethpb.SyncCommitteeContribution")
+		}
+		all = append(all, contributions...)
+	}
+
+	return all, nil
+}
+
 func syncCommitteeKey(slot types.Slot) string {
 	return strconv.FormatUint(uint64(slot), 10)
 }
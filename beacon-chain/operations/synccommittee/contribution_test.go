@@ -106,3 +106,27 @@ func TestSyncCommitteeContributionCache_RoundTrip(t *testing.T) {
 		{Slot: 6, SubcommitteeIndex: 1, Signature: []byte{'l'}},
 	}, conts)
 }
+
+func TestSyncCommitteeContributionCache_All(t *testing.T) {
+	store := NewStore()
+
+	conts, err := store.AllSyncCommitteeContributions()
+	require.NoError(t, err)
+	require.Equal(t, 0, len(conts))
+
+	for _, cont := range []*ethpb.SyncCommitteeContribution{
+		{Slot: 2, SubcommitteeIndex: 0, Signature: []byte{'a'}},
+		{Slot: 1, SubcommitteeIndex: 0, Signature: []byte{'b'}},
+		{Slot: 2, SubcommitteeIndex: 1, Signature: []byte{'c'}},
+	} {
+		require.NoError(t, store.SaveSyncCommitteeContribution(cont))
+	}
+
+	conts, err = store.AllSyncCommitteeContributions()
+	require.NoError(t, err)
+	require.DeepSSZEqual(t, []*ethpb.SyncCommitteeContribution{
+		{Slot: 1, SubcommitteeIndex: 0, Signature: []byte{'b'}},
+		{Slot: 2, SubcommitteeIndex: 0, Signature: []byte{'a'}},
+		{Slot: 2, SubcommitteeIndex: 1, Signature: []byte{'c'}},
+	}, conts)
+}
@@ -107,3 +107,32 @@ func TestSyncCommitteeSignatureCache_RoundTrip(t *testing.T) {
 		{Slot: 6, ValidatorIndex: 1, Signature: []byte{'l'}},
 	}, msgs)
 }
+
+func TestSyncCommitteeSignatureCache_All(t *testing.T) {
+	store := NewStore()
+
+	msgs, err := store.AllSyncCommitteeMessages()
+	require.NoError(t, err)
+	require.Equal(t, 0, len(msgs))
+
+	for _, msg := range []*ethpb.SyncCommitteeMessage{
+		{Slot: 2, ValidatorIndex: 0, Signature: []byte{'a'}},
+		{Slot: 1, ValidatorIndex: 0, Signature: []byte{'b'}},
+		{Slot: 2, ValidatorIndex: 1, Signature: []byte{'c'}},
+	} {
+		require.NoError(t, store.SaveSyncCommitteeMessage(msg))
+	}
+
+	msgs, err = store.AllSyncCommitteeMessages()
+	require.NoError(t, err)
+	require.DeepSSZEqual(t, []*ethpb.SyncCommitteeMessage{
+		{Slot: 1, ValidatorIndex: 0, Signature: []byte{'b'}},
+		{Slot: 2, ValidatorIndex: 0, Signature: []byte{'a'}},
+		{Slot: 2, ValidatorIndex: 1, Signature: []byte{'c'}},
+	}, msgs)
+
+	// Messages should persist after retrieval.
+	msgs, err = store.SyncCommitteeMessages(1)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(msgs))
+}
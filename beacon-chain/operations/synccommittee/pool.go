@@ -15,10 +15,12 @@ type Pool interface {
 	// Methods for Sync Contributions.
 	SaveSyncCommitteeContribution(contr *ethpb.SyncCommitteeContribution) error
 	SyncCommitteeContributions(slot types.Slot) ([]*ethpb.SyncCommitteeContribution, error)
+	AllSyncCommitteeContributions() ([]*ethpb.SyncCommitteeContribution, error)
 
 	// Methods for Sync Committee Messages.
 	SaveSyncCommitteeMessage(sig *ethpb.SyncCommitteeMessage) error
 	SyncCommitteeMessages(slot types.Slot) ([]*ethpb.SyncCommitteeMessage, error)
+	AllSyncCommitteeMessages() ([]*ethpb.SyncCommitteeMessage, error)
 }
 
 // NewPool returns the sync committee store fulfilling the pool interface.
@@ -27,3 +27,13 @@ func (m *PoolMock) InsertVoluntaryExit(_ context.Context, _ state.ReadOnlyBeacon
 func (*PoolMock) MarkIncluded(_ *eth.SignedVoluntaryExit) {
 	panic("implement me")
 }
+
+// AllPendingExits --
+func (m *PoolMock) AllPendingExits() []*eth.SignedVoluntaryExit {
+	return m.Exits
+}
+
+// RestorePendingExits --
+func (m *PoolMock) RestorePendingExits(exits []*eth.SignedVoluntaryExit) {
+	m.Exits = append(m.Exits, exits...)
+}
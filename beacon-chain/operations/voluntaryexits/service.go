@@ -19,6 +19,8 @@ type PoolManager interface {
 	PendingExits(state state.ReadOnlyBeaconState, slot types.Slot, noLimit bool) []*ethpb.SignedVoluntaryExit
 	InsertVoluntaryExit(ctx context.Context, state state.ReadOnlyBeaconState, exit *ethpb.SignedVoluntaryExit)
 	MarkIncluded(exit *ethpb.SignedVoluntaryExit)
+	AllPendingExits() []*ethpb.SignedVoluntaryExit
+	RestorePendingExits(exits []*ethpb.SignedVoluntaryExit)
 }
 
 // Pool is a concrete implementation of PoolManager.
@@ -113,6 +115,37 @@ func (p *Pool) MarkIncluded(exit *ethpb.SignedVoluntaryExit) {
 	}
 }
 
+// AllPendingExits returns all the exits in the pool, without checking whether they can be included
+// into a block.
+func (p *Pool) AllPendingExits() []*ethpb.SignedVoluntaryExit {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	exits := make([]*ethpb.SignedVoluntaryExit, len(p.pending))
+	copy(exits, p.pending)
+	return exits
+}
+
+// RestorePendingExits inserts exits which were previously returned by AllPendingExits back into the
+// pool, such as after a restart. Exits of validators which already have a pending exit are ignored.
+// The exits are not verified against a state, exits of validators which already exited are skipped
+// when the pending exits are requested for a block.
+func (p *Pool) RestorePendingExits(exits []*ethpb.SignedVoluntaryExit) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	for _, exit := range exits {
+		if exit == nil || exit.Exit == nil {
+			continue
+		}
+		if exists, _ := existsInList(p.pending, exit.Exit.ValidatorIndex); exists {
+			continue
+		}
+		p.pending = append(p.pending, exit)
+		sort.Slice(p.pending, func(i, j int) bool {
+			return p.pending[i].Exit.ValidatorIndex < p.pending[j].Exit.ValidatorIndex
+		})
+	}
+}
+
 // Binary search to check if the index exists in the list of pending exits.
 func existsInList(pending []*ethpb.SignedVoluntaryExit, searchingFor types.ValidatorIndex) (bool, int) {
 	i := sort.Search(len(pending), func(j int) bool {
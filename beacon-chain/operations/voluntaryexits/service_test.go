@@ -347,6 +347,28 @@ func TestPool_MarkIncluded(t *testing.T) {
 	}
 }
 
+func TestPool_AllPendingExits_RestorePendingExits(t *testing.T) {
+	p := &Pool{
+		pending: []*ethpb.SignedVoluntaryExit{
+			{Exit: &ethpb.VoluntaryExit{ValidatorIndex: 1, Epoch: 2}},
+			{Exit: &ethpb.VoluntaryExit{ValidatorIndex: 3}},
+		},
+	}
+	exits := p.AllPendingExits()
+	require.DeepEqual(t, p.pending, exits)
+
+	restored := NewPool()
+	restored.pending = []*ethpb.SignedVoluntaryExit{
+		{Exit: &ethpb.VoluntaryExit{ValidatorIndex: 1}},
+	}
+	restored.RestorePendingExits(append(exits, &ethpb.SignedVoluntaryExit{Exit: &ethpb.VoluntaryExit{ValidatorIndex: 0}}, nil))
+	require.DeepEqual(t, []*ethpb.SignedVoluntaryExit{
+		{Exit: &ethpb.VoluntaryExit{ValidatorIndex: 0}},
+		{Exit: &ethpb.VoluntaryExit{ValidatorIndex: 1}},
+		{Exit: &ethpb.VoluntaryExit{ValidatorIndex: 3}},
+	}, restored.pending)
+}
+
 func TestPool_PendingExits(t *testing.T) {
 	type fields struct {
 		pending []*ethpb.SignedVoluntaryExit
@@ -403,6 +403,10 @@ func receiveEvents(eventChan <-chan *sse.Event, w http.ResponseWriter, req *http
 				data = &EventChainReorgJson{}
 			case events.SyncCommitteeContributionTopic:
 				data = &SignedContributionAndProofJson{}
+			case events.PayloadValidatedTopic:
+				data = &EventPayloadValidatedJson{}
+			case events.PayloadInvalidatedTopic:
+				data = &EventPayloadInvalidatedJson{}
 			case "error":
 				data = &EventErrorJson{}
 			default:
@@ -31,6 +31,20 @@ type WeakSubjectivityResponse struct {
 	} `json:"data"`
 }
 
+// DepositSnapshotResponseJson is used to marshal/unmarshal the response for the
+// /eth/v1/beacon/deposit_snapshot endpoint.
+type DepositSnapshotResponseJson struct {
+	Data *DepositSnapshotJson `json:"data"`
+}
+
+type DepositSnapshotJson struct {
+	Finalized            []string `json:"finalized" hex:"true"`
+	DepositRoot          string   `json:"deposit_root" hex:"true"`
+	DepositCount         string   `json:"deposit_count"`
+	ExecutionBlockHash   string   `json:"execution_block_hash" hex:"true"`
+	ExecutionBlockHeight string   `json:"execution_block_height"`
+}
+
 type FeeRecipientsRequestJSON struct {
 	Recipients []*FeeRecipientJson `json:"recipients"`
 }
@@ -561,6 +575,17 @@ type VoluntaryExitJson struct {
 	ValidatorIndex string `json:"validator_index"`
 }
 
+type SignedBLSToExecutionChangeJson struct {
+	Message   *BLSToExecutionChangeJson `json:"message"`
+	Signature string                    `json:"signature" hex:"true"`
+}
+
+type BLSToExecutionChangeJson struct {
+	ValidatorIndex     string `json:"validator_index"`
+	FromBLSPubkey      string `json:"from_bls_pubkey" hex:"true"`
+	ToExecutionAddress string `json:"to_execution_address" hex:"true"`
+}
+
 type SyncCommitteeMessageJson struct {
 	Slot            string `json:"slot"`
 	BeaconBlockRoot string `json:"beacon_block_root" hex:"true"`
@@ -926,6 +951,19 @@ type EventChainReorgJson struct {
 	ExecutionOptimistic bool   `json:"execution_optimistic"`
 }
 
+type EventPayloadValidatedJson struct {
+	Slot               string `json:"slot"`
+	Block              string `json:"block" hex:"true"`
+	ExecutionBlockHash string `json:"execution_block_hash" hex:"true"`
+}
+
+type EventPayloadInvalidatedJson struct {
+	Slot              string   `json:"slot"`
+	Block             string   `json:"block" hex:"true"`
+	LatestValidHash   string   `json:"latest_valid_hash" hex:"true"`
+	InvalidatedBlocks []string `json:"invalidated_blocks" hex:"true"`
+}
+
 // ---------------
 // Error handling.
 // ---------------
@@ -0,0 +1,35 @@
+package beacon
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	ethpbv1 "github.com/prysmaticlabs/prysm/v3/proto/eth/v1"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetDepositSnapshot retrieves the EIP-4881 snapshot of the finalized deposits, which a new node can use
+// to start tracking deposits without processing the historical deposit logs.
+func (bs *Server) GetDepositSnapshot(ctx context.Context, _ *empty.Empty) (*ethpbv1.DepositSnapshotResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "beacon.GetDepositSnapshot")
+	defer span.End()
+
+	snapshot, err := bs.BeaconDB.DepositSnapshot(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get deposit snapshot: %v", err)
+	}
+	if snapshot == nil {
+		return nil, status.Error(codes.NotFound, "No deposit snapshot available")
+	}
+	return &ethpbv1.DepositSnapshotResponse{
+		Data: &ethpbv1.DepositSnapshot{
+			Finalized:            snapshot.Finalized,
+			DepositRoot:          snapshot.DepositRoot,
+			DepositCount:         snapshot.DepositCount,
+			ExecutionBlockHash:   snapshot.ExecutionBlockHash,
+			ExecutionBlockHeight: snapshot.ExecutionBlockHeight,
+		},
+	}, nil
+}
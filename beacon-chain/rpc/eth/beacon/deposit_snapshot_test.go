@@ -0,0 +1,38 @@
+package beacon
+
+import (
+	"context"
+	"testing"
+
+	dbTest "github.com/prysmaticlabs/prysm/v3/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func TestServer_GetDepositSnapshot(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := dbTest.SetupDB(t)
+	bs := &Server{BeaconDB: beaconDB}
+
+	_, err := bs.GetDepositSnapshot(ctx, &emptypb.Empty{})
+	assert.ErrorContains(t, "No deposit snapshot available", err)
+
+	snapshot := &ethpb.DepositSnapshot{
+		Finalized:            [][]byte{bytesutil.PadTo([]byte{'a'}, 32), bytesutil.PadTo([]byte{'b'}, 32)},
+		DepositRoot:          bytesutil.PadTo([]byte{'r'}, 32),
+		DepositCount:         3,
+		ExecutionBlockHash:   bytesutil.PadTo([]byte{'h'}, 32),
+		ExecutionBlockHeight: 100,
+	}
+	require.NoError(t, beaconDB.SaveDepositSnapshot(ctx, snapshot))
+	resp, err := bs.GetDepositSnapshot(ctx, &emptypb.Empty{})
+	require.NoError(t, err)
+	assert.DeepEqual(t, snapshot.Finalized, resp.Data.Finalized)
+	assert.DeepEqual(t, snapshot.DepositRoot, resp.Data.DepositRoot)
+	assert.Equal(t, snapshot.DepositCount, resp.Data.DepositCount)
+	assert.DeepEqual(t, snapshot.ExecutionBlockHash, resp.Data.ExecutionBlockHash)
+	assert.Equal(t, snapshot.ExecutionBlockHeight, resp.Data.ExecutionBlockHeight)
+}
@@ -33,6 +33,11 @@ const (
 	ChainReorgTopic = "chain_reorg"
 	// SyncCommitteeContributionTopic represents a new sync committee contribution event topic.
 	SyncCommitteeContributionTopic = "contribution_and_proof"
+	// PayloadValidatedTopic represents an event topic for optimistic blocks whose execution payload became valid.
+	PayloadValidatedTopic = "payload_validated"
+	// PayloadInvalidatedTopic represents an event topic for execution payloads reported invalid,
+	// along with the blocks removed from fork choice as a result.
+	PayloadInvalidatedTopic = "payload_invalidated"
 )
 
 var casesHandled = map[string]bool{
@@ -43,6 +48,8 @@ var casesHandled = map[string]bool{
 	FinalizedCheckpointTopic:       true,
 	ChainReorgTopic:                true,
 	SyncCommitteeContributionTopic: true,
+	PayloadValidatedTopic:          true,
+	PayloadInvalidatedTopic:        true,
 }
 
 // StreamEvents allows requesting all events from a set of topics defined in the Ethereum consensus API standard.
@@ -214,6 +221,24 @@ func handleStateEvents(
 			return nil
 		}
 		return streamData(stream, ChainReorgTopic, reorg)
+	case statefeed.PayloadValidated:
+		if _, ok := requestedTopics[PayloadValidatedTopic]; !ok {
+			return nil
+		}
+		validated, ok := event.Data.(*ethpb.EventPayloadValidated)
+		if !ok {
+			return nil
+		}
+		return streamData(stream, PayloadValidatedTopic, validated)
+	case statefeed.PayloadInvalidated:
+		if _, ok := requestedTopics[PayloadInvalidatedTopic]; !ok {
+			return nil
+		}
+		invalidated, ok := event.Data.(*ethpb.EventPayloadInvalidated)
+		if !ok {
+			return nil
+		}
+		return streamData(stream, PayloadInvalidatedTopic, invalidated)
 	default:
 		return nil
 	}
@@ -335,6 +335,67 @@ func TestStreamEvents_StateEvents(t *testing.T) {
 			feed: srv.StateNotifier.StateFeed(),
 		})
 	})
+	t.Run(PayloadValidatedTopic, func(t *testing.T) {
+		ctx := context.Background()
+		srv, ctrl, mockStream := setupServer(ctx, t)
+		defer ctrl.Finish()
+
+		wantedValidated := &ethpb.EventPayloadValidated{
+			Slot:               8,
+			Block:              make([]byte, 32),
+			ExecutionBlockHash: make([]byte, 32),
+		}
+		genericResponse, err := anypb.New(wantedValidated)
+		require.NoError(t, err)
+		wantedMessage := &gateway.EventSource{
+			Event: PayloadValidatedTopic,
+			Data:  genericResponse,
+		}
+
+		assertFeedSendAndReceive(ctx, &assertFeedArgs{
+			t:             t,
+			srv:           srv,
+			topics:        []string{PayloadValidatedTopic},
+			stream:        mockStream,
+			shouldReceive: wantedMessage,
+			itemToSend: &feed.Event{
+				Type: statefeed.PayloadValidated,
+				Data: wantedValidated,
+			},
+			feed: srv.StateNotifier.StateFeed(),
+		})
+	})
+	t.Run(PayloadInvalidatedTopic, func(t *testing.T) {
+		ctx := context.Background()
+		srv, ctrl, mockStream := setupServer(ctx, t)
+		defer ctrl.Finish()
+
+		wantedInvalidated := &ethpb.EventPayloadInvalidated{
+			Slot:              8,
+			Block:             make([]byte, 32),
+			LatestValidHash:   make([]byte, 32),
+			InvalidatedBlocks: [][]byte{make([]byte, 32), make([]byte, 32)},
+		}
+		genericResponse, err := anypb.New(wantedInvalidated)
+		require.NoError(t, err)
+		wantedMessage := &gateway.EventSource{
+			Event: PayloadInvalidatedTopic,
+			Data:  genericResponse,
+		}
+
+		assertFeedSendAndReceive(ctx, &assertFeedArgs{
+			t:             t,
+			srv:           srv,
+			topics:        []string{PayloadInvalidatedTopic},
+			stream:        mockStream,
+			shouldReceive: wantedMessage,
+			itemToSend: &feed.Event{
+				Type: statefeed.PayloadInvalidated,
+				Data: wantedInvalidated,
+			},
+			feed: srv.StateNotifier.StateFeed(),
+		})
+	})
 }
 
 func TestStreamEvents_CommaSeparatedTopics(t *testing.T) {
@@ -0,0 +1,16 @@
+package debug
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	pbrpc "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+)
+
+// ListPayloadInvalidations returns the most recent execution payload invalidations observed by the
+// beacon node, along with the blocks removed from fork choice by each of them.
+func (ds *Server) ListPayloadInvalidations(_ context.Context, _ *empty.Empty) (*pbrpc.PayloadInvalidationsResponse, error) {
+	return &pbrpc.PayloadInvalidationsResponse{
+		Invalidations: ds.PayloadInvalidationFetcher.RecentPayloadInvalidations(),
+	}, nil
+}
@@ -0,0 +1,35 @@
+package debug
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	mock "github.com/prysmaticlabs/prysm/v3/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+)
+
+func TestServer_ListPayloadInvalidations(t *testing.T) {
+	invalidations := []*ethpb.PayloadInvalidation{
+		{
+			Slot:             10,
+			BlockRoot:        bytesutil.PadTo([]byte{'a'}, 32),
+			LatestValidHash:  bytesutil.PadTo([]byte{'b'}, 32),
+			InvalidatedRoots: [][]byte{bytesutil.PadTo([]byte{'a'}, 32), bytesutil.PadTo([]byte{'c'}, 32)},
+			Timestamp:        1000,
+		},
+	}
+	ds := &Server{PayloadInvalidationFetcher: &mock.ChainService{PayloadInvalidations: invalidations}}
+	res, err := ds.ListPayloadInvalidations(context.Background(), &empty.Empty{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(res.Invalidations))
+	assert.DeepEqual(t, invalidations[0], res.Invalidations[0])
+
+	ds = &Server{PayloadInvalidationFetcher: &mock.ChainService{}}
+	res, err = ds.ListPayloadInvalidations(context.Background(), &empty.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(res.Invalidations))
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/libp2p/go-libp2p/core/network"
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/builder"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/execution"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/p2p"
@@ -41,6 +42,7 @@ type Server struct {
 	GenesisTimeFetcher   blockchain.TimeFetcher
 	GenesisFetcher       blockchain.GenesisFetcher
 	POWChainInfoFetcher  execution.ChainInfoFetcher
+	BlockBuilder         builder.BlockBuilder
 	BeaconMonitoringHost string
 	BeaconMonitoringPort int
 }
@@ -76,13 +78,36 @@ func (ns *Server) GetGenesis(ctx context.Context, _ *empty.Empty) (*ethpb.Genesi
 	}, nil
 }
 
-// GetVersion checks the version information of the beacon node.
-func (_ *Server) GetVersion(_ context.Context, _ *empty.Empty) (*ethpb.Version, error) {
+// GetVersion checks the version information of the beacon node. The metadata holds the
+// name and version of the connected execution client, if it is known.
+func (ns *Server) GetVersion(ctx context.Context, _ *empty.Empty) (*ethpb.Version, error) {
+	var metadata string
+	// The version of the beacon node is returned even if the execution client cannot be reached.
+	if ns.POWChainInfoFetcher != nil && ns.POWChainInfoFetcher.ExecutionClientConnected() {
+		executionClientVersion, err := ns.POWChainInfoFetcher.ExecutionClientVersion(ctx)
+		if err == nil {
+			metadata = executionClientVersion
+		}
+	}
 	return &ethpb.Version{
-		Version: version.Version(),
+		Version:  version.Version(),
+		Metadata: metadata,
 	}, nil
 }
 
+// GetBuilderCircuitBreaker returns whether the builder circuit breaker is open at the current slot,
+// along with the chain and relay health it is based on. It does not change the circuit breaker state.
+func (ns *Server) GetBuilderCircuitBreaker(ctx context.Context, _ *empty.Empty) (*ethpb.BuilderCircuitBreakerStatus, error) {
+	if ns.BlockBuilder == nil || !ns.BlockBuilder.Configured() {
+		return nil, status.Error(codes.FailedPrecondition, "Builder is not configured")
+	}
+	breaker, err := ns.BlockBuilder.CircuitBreakerStatus(ctx, ns.GenesisTimeFetcher.CurrentSlot())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get builder circuit breaker status: %v", err)
+	}
+	return breaker, nil
+}
+
 // ListImplementedServices lists the services implemented and enabled by this node.
 //
 // Any service not present in this list may return UNIMPLEMENTED or
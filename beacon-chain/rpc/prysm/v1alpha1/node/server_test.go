@@ -10,7 +10,9 @@ import (
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/p2p/enode"
 	mock "github.com/prysmaticlabs/prysm/v3/beacon-chain/blockchain/testing"
+	builderTest "github.com/prysmaticlabs/prysm/v3/beacon-chain/builder/testing"
 	dbutil "github.com/prysmaticlabs/prysm/v3/beacon-chain/db/testing"
+	mockExecution "github.com/prysmaticlabs/prysm/v3/beacon-chain/execution/testing"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/p2p"
 	mockP2p "github.com/prysmaticlabs/prysm/v3/beacon-chain/p2p/testing"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/rpc/testutil"
@@ -77,6 +79,32 @@ func TestNodeServer_GetVersion(t *testing.T) {
 	res, err := ns.GetVersion(context.Background(), &emptypb.Empty{})
 	require.NoError(t, err)
 	assert.Equal(t, v, res.Version)
+	assert.Equal(t, "", res.Metadata)
+
+	ns.POWChainInfoFetcher = &mockExecution.Chain{ClientVersion: "Geth/v1.10.26-stable"}
+	res, err = ns.GetVersion(context.Background(), &emptypb.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, v, res.Version)
+	assert.Equal(t, "Geth/v1.10.26-stable", res.Metadata)
+}
+
+func TestNodeServer_GetBuilderCircuitBreaker(t *testing.T) {
+	ns := &Server{GenesisTimeFetcher: &mock.ChainService{}}
+	_, err := ns.GetBuilderCircuitBreaker(context.Background(), &emptypb.Empty{})
+	require.ErrorContains(t, "Builder is not configured", err)
+
+	ns.BlockBuilder = &builderTest.MockBuilderService{
+		HasConfigured:  true,
+		CircuitBreaker: &ethpb.BuilderCircuitBreakerStatus{Open: true, Reason: "deep chain reorg"},
+	}
+	res, err := ns.GetBuilderCircuitBreaker(context.Background(), &emptypb.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, true, res.Open)
+	assert.Equal(t, "deep chain reorg", res.Reason)
+
+	ns.BlockBuilder = &builderTest.MockBuilderService{HasConfigured: true, ErrCircuitBreaker: errors.New("no fork choicer configured")}
+	_, err = ns.GetBuilderCircuitBreaker(context.Background(), &emptypb.Empty{})
+	require.ErrorContains(t, "Could not get builder circuit breaker status", err)
 }
 
 func TestNodeServer_GetImplementedServices(t *testing.T) {
@@ -2,29 +2,47 @@ package validator
 
 import (
 	"context"
+	"fmt"
 	"sort"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/go-bitfield"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/altair"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/helpers"
+	coreTime "github.com/prysmaticlabs/prysm/v3/beacon-chain/core/time"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/v3/config/params"
 	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
 	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1/attestation"
 	"github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1/attestation/aggregation"
 	attaggregation "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1/attestation/aggregation/attestations"
 	"github.com/prysmaticlabs/prysm/v3/runtime/version"
 	"go.opencensus.io/trace"
 )
 
+// attestationPackingTimeBudget bounds the time spent improving the selection of attestations by reward.
+var attestationPackingTimeBudget = 100 * time.Millisecond
+
 type proposerAtts []*ethpb.Attestation
 
 func (vs *Server) packAttestations(ctx context.Context, latestState state.BeaconState) ([]*ethpb.Attestation, error) {
 	ctx, span := trace.StartSpan(ctx, "ProposerServer.packAttestations")
 	defer span.End()
 
+	// Validating the attestations records their participation in the state, the participation earning no
+	// reward is captured beforehand.
+	var rewards *attRewardCalculator
+	if latestState.Version() >= version.Altair {
+		var err error
+		rewards, err = newAttRewardCalculator(latestState)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not compute attestation rewards")
+		}
+	}
+
 	atts := vs.AttPool.AggregatedAttestations()
 	atts, err := vs.validateAndDeleteAttsInPool(ctx, latestState, atts)
 	if err != nil {
@@ -40,10 +58,60 @@ func (vs *Server) packAttestations(ctx context.Context, latestState state.Beacon
 		return nil, errors.Wrap(err, "could not filter attestations")
 	}
 	atts = append(atts, uAtts...)
+	return packValidAttestations(ctx, rewards, atts)
+}
 
+// PackAttestations returns the attestations a block built on the state would include out of the given
+// attestations, the ones earning the highest reward once aggregated. Invalid attestations are left out.
+// The state and the attestations are not modified.
+func PackAttestations(ctx context.Context, st state.BeaconState, atts []*ethpb.Attestation) ([]*ethpb.Attestation, error) {
+	st = st.Copy()
+	var rewards *attRewardCalculator
+	if st.Version() >= version.Altair {
+		var err error
+		rewards, err = newAttRewardCalculator(st)
+		if err != nil {
+			return nil, err
+		}
+	}
+	valid, _ := proposerAtts(atts).filter(ctx, st)
+	for i, att := range valid {
+		valid[i] = ethpb.CopyAttestation(att)
+	}
+	return packValidAttestations(ctx, rewards, valid)
+}
+
+// AttestationsReward returns the proposer reward, in Gwei, earned for the participation flags of the
+// attesters of the attestations, when including them in a block built on the state.
+func AttestationsReward(ctx context.Context, st state.BeaconState, atts []*ethpb.Attestation) (uint64, error) {
+	rewards, err := newAttRewardCalculator(st)
+	if err != nil {
+		return 0, err
+	}
+	covered := make(map[attaggregation.RewardKey]bool)
+	numerator := uint64(0)
+	for _, att := range atts {
+		attRewards, err := rewards.rewards(ctx, att)
+		if err != nil {
+			return 0, err
+		}
+		for _, r := range attRewards {
+			if !covered[r.Key] {
+				covered[r.Key] = true
+				numerator += r.Amount
+			}
+		}
+	}
+	cfg := params.BeaconConfig()
+	return numerator / ((cfg.WeightDenominator - cfg.ProposerWeight) * cfg.WeightDenominator / cfg.ProposerWeight), nil
+}
+
+// packValidAttestations aggregates the valid attestations and orders them by profitability, limited to the
+// maximum attestations per block. The attestations are ordered by reward when rewards is not nil.
+func packValidAttestations(ctx context.Context, rewards *attRewardCalculator, atts []*ethpb.Attestation) ([]*ethpb.Attestation, error) {
 	// Remove duplicates from both aggregated/unaggregated attestations. This
 	// prevents inefficient aggregates being created.
-	atts, err = proposerAtts(atts).dedup()
+	atts, err := proposerAtts(atts).dedup()
 	if err != nil {
 		return nil, err
 	}
@@ -69,7 +137,12 @@ func (vs *Server) packAttestations(ctx context.Context, latestState state.Beacon
 	if err != nil {
 		return nil, err
 	}
-	sorted, err := deduped.sortByProfitability()
+	var sorted proposerAtts
+	if rewards != nil {
+		sorted, err = deduped.sortByReward(ctx, rewards)
+	} else {
+		sorted, err = deduped.sortByProfitability()
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -186,6 +259,46 @@ func (a proposerAtts) sortByProfitabilityUsingMaxCover() (proposerAtts, error) {
 	return sortedAtts, nil
 }
 
+// sortByReward orders attestations so that the attestations earning the highest total reward for the
+// participation flags of their attesters come first. A reward is only counted once, and not at all when
+// the participation is already recorded in the state. The selection is bounded by the maximum attestations
+// per block and by attestationPackingTimeBudget, attestations not adding any reward to the selection are
+// ordered after it by highest slot and aggregation bit count.
+func (a proposerAtts) sortByReward(ctx context.Context, rewards *attRewardCalculator) (proposerAtts, error) {
+	if len(a) < 2 {
+		return a, nil
+	}
+	candidates := make([][]attaggregation.Reward, len(a))
+	for i, att := range a {
+		var err error
+		candidates[i], err = rewards.rewards(ctx, att)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, attestationPackingTimeBudget)
+	defer cancel()
+	selectedIndices, _ := attaggregation.MaxRewardCover(ctx, candidates, int(params.BeaconConfig().MaxAttestations))
+	selected := make(proposerAtts, 0, len(a))
+	isSelected := make(map[int]bool, len(selectedIndices))
+	for _, idx := range selectedIndices {
+		selected = append(selected, a[idx])
+		isSelected[idx] = true
+	}
+	leftover := make(proposerAtts, 0, len(a)-len(selected))
+	for i, att := range a {
+		if !isSelected[i] {
+			leftover = append(leftover, att)
+		}
+	}
+	leftover, err := leftover.sortByProfitability()
+	if err != nil {
+		return nil, err
+	}
+	return append(selected, leftover...), nil
+}
+
 // limitToMaxAttestations limits attestations to maximum attestations per block.
 func (a proposerAtts) limitToMaxAttestations() proposerAtts {
 	if uint64(len(a)) > params.BeaconConfig().MaxAttestations {
@@ -242,6 +355,101 @@ func (a proposerAtts) dedup() (proposerAtts, error) {
 	return uniqAtts, nil
 }
 
+// attRewardCalculator computes the rewards attestations earn for the participation flags of their attesters,
+// when included in a block built on a state. The participation recorded in the state is captured when the
+// calculator is created, rewards for participation already recorded are not earned again.
+type attRewardCalculator struct {
+	st                    state.BeaconState
+	currentEpoch          types.Epoch
+	currentParticipation  []byte
+	previousParticipation []byte
+	totalBalance          uint64
+}
+
+func newAttRewardCalculator(st state.BeaconState) (*attRewardCalculator, error) {
+	currentParticipation, err := st.CurrentEpochParticipation()
+	if err != nil {
+		return nil, err
+	}
+	previousParticipation, err := st.PreviousEpochParticipation()
+	if err != nil {
+		return nil, err
+	}
+	totalBalance, err := helpers.TotalActiveBalance(st)
+	if err != nil {
+		return nil, err
+	}
+	return &attRewardCalculator{
+		st:                    st,
+		currentEpoch:          coreTime.CurrentEpoch(st),
+		currentParticipation:  currentParticipation,
+		previousParticipation: previousParticipation,
+		totalBalance:          totalBalance,
+	}, nil
+}
+
+// rewards returns the rewards of the attestation, each being the base reward of an attester times the
+// weight of a participation flag, as counted in the proposer reward.
+func (c *attRewardCalculator) rewards(ctx context.Context, att *ethpb.Attestation) ([]attaggregation.Reward, error) {
+	delay, err := c.st.Slot().SafeSubSlot(att.Data.Slot)
+	if err != nil {
+		return nil, fmt.Errorf("att slot %d can't be greater than state slot %d", att.Data.Slot, c.st.Slot())
+	}
+	participatedFlags, err := altair.AttestationParticipationFlagIndices(c.st, att.Data, delay)
+	if err != nil {
+		return nil, err
+	}
+	committee, err := helpers.BeaconCommitteeFromState(ctx, c.st, att.Data.Slot, att.Data.CommitteeIndex)
+	if err != nil {
+		return nil, err
+	}
+	indices, err := attestation.AttestingIndices(att.AggregationBits, committee)
+	if err != nil {
+		return nil, err
+	}
+	participation := c.previousParticipation
+	if att.Data.Target.Epoch == c.currentEpoch {
+		participation = c.currentParticipation
+	}
+
+	cfg := params.BeaconConfig()
+	flagWeights := []struct {
+		flag   uint8
+		weight uint64
+	}{
+		{cfg.TimelySourceFlagIndex, cfg.TimelySourceWeight},
+		{cfg.TimelyTargetFlagIndex, cfg.TimelyTargetWeight},
+		{cfg.TimelyHeadFlagIndex, cfg.TimelyHeadWeight},
+	}
+	rewards := make([]attaggregation.Reward, 0, len(indices)*len(flagWeights))
+	for _, index := range indices {
+		if index >= uint64(len(participation)) {
+			return nil, fmt.Errorf("index %d exceeds participation length %d", index, len(participation))
+		}
+		br, err := altair.BaseRewardWithTotalBalance(c.st, types.ValidatorIndex(index), c.totalBalance)
+		if err != nil {
+			return nil, err
+		}
+		for _, fw := range flagWeights {
+			if !participatedFlags[fw.flag] {
+				continue
+			}
+			has, err := altair.HasValidatorFlag(participation[index], fw.flag)
+			if err != nil {
+				return nil, err
+			}
+			if has {
+				continue
+			}
+			rewards = append(rewards, attaggregation.Reward{
+				Key:    attaggregation.RewardKey{Validator: types.ValidatorIndex(index), Epoch: att.Data.Target.Epoch, Flag: fw.flag},
+				Amount: br * fw.weight,
+			})
+		}
+	}
+	return rewards, nil
+}
+
 // This filters the input attestations to return a list of valid attestations to be packaged inside a beacon block.
 func (vs *Server) validateAndDeleteAttsInPool(ctx context.Context, st state.BeaconState, atts []*ethpb.Attestation) ([]*ethpb.Attestation, error) {
 	ctx, span := trace.StartSpan(ctx, "ProposerServer.validateAndDeleteAttsInPool")
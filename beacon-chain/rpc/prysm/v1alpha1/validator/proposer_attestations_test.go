@@ -2,12 +2,19 @@ package validator
 
 import (
 	"bytes"
+	"context"
 	"sort"
 	"testing"
 
 	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/altair"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v3/config/params"
 	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v3/crypto/bls"
 	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	attaggregation "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1/attestation/aggregation/attestations"
 	"github.com/prysmaticlabs/prysm/v3/testing/assert"
 	"github.com/prysmaticlabs/prysm/v3/testing/require"
 	"github.com/prysmaticlabs/prysm/v3/testing/util"
@@ -188,6 +195,144 @@ func TestProposer_ProposerAtts_sortByProfitabilityUsingMaxCover(t *testing.T) {
 	})
 }
 
+// rewardTestState returns an Altair state at slot 1, where the validators at the positions in the committee
+// of slot 0 have their timely flags recorded, along with the committee.
+func rewardTestState(t *testing.T, recorded ...int) (state.BeaconState, []types.ValidatorIndex) {
+	st, _ := util.DeterministicGenesisStateAltair(t, 256)
+	require.NoError(t, st.SetSlot(1))
+	committee, err := helpers.BeaconCommitteeFromState(context.Background(), st, 0, 0)
+	require.NoError(t, err)
+	require.Equal(t, 8, len(committee))
+	participation, err := st.CurrentEpochParticipation()
+	require.NoError(t, err)
+	cfg := params.BeaconConfig()
+	for _, i := range recorded {
+		for _, flag := range []uint8{cfg.TimelySourceFlagIndex, cfg.TimelyTargetFlagIndex, cfg.TimelyHeadFlagIndex} {
+			participation[committee[i]], err = altair.AddValidatorFlag(participation[committee[i]], flag)
+			require.NoError(t, err)
+		}
+	}
+	require.NoError(t, st.SetCurrentParticipationBits(participation))
+	return st, committee
+}
+
+// rewardTestAtt returns an attestation of slot 0 earning all flags, by the validators at the positions in the
+// committee.
+func rewardTestAtt(positions ...uint64) *ethpb.Attestation {
+	bits := bitfield.NewBitlist(8)
+	for _, i := range positions {
+		bits.SetBitAt(i, true)
+	}
+	return util.HydrateAttestation(&ethpb.Attestation{AggregationBits: bits})
+}
+
+func TestProposer_attRewardCalculator_rewards(t *testing.T) {
+	st, committee := rewardTestState(t, 1)
+	calc, err := newAttRewardCalculator(st)
+	require.NoError(t, err)
+
+	rewards, err := calc.rewards(context.Background(), rewardTestAtt(0, 1))
+	require.NoError(t, err)
+	cfg := params.BeaconConfig()
+	totalBalance, err := helpers.TotalActiveBalance(st)
+	require.NoError(t, err)
+	br, err := altair.BaseRewardWithTotalBalance(st, committee[0], totalBalance)
+	require.NoError(t, err)
+	want := []attaggregation.Reward{
+		{Key: attaggregation.RewardKey{Validator: committee[0], Flag: cfg.TimelySourceFlagIndex}, Amount: br * cfg.TimelySourceWeight},
+		{Key: attaggregation.RewardKey{Validator: committee[0], Flag: cfg.TimelyTargetFlagIndex}, Amount: br * cfg.TimelyTargetWeight},
+		{Key: attaggregation.RewardKey{Validator: committee[0], Flag: cfg.TimelyHeadFlagIndex}, Amount: br * cfg.TimelyHeadWeight},
+		// The participation of the second attester is already recorded.
+	}
+	assert.DeepEqual(t, want, rewards)
+
+	t.Run("future attestation", func(t *testing.T) {
+		att := rewardTestAtt(0)
+		att.Data.Slot = 2
+		_, err := calc.rewards(context.Background(), att)
+		assert.ErrorContains(t, "can't be greater than state slot", err)
+	})
+}
+
+func TestProposer_ProposerAtts_sortByReward(t *testing.T) {
+	// The first attestation has the most bits, but the participation of three of its attesters is already
+	// recorded, so the second attestation earns more.
+	st, _ := rewardTestState(t, 0, 1, 2)
+	calc, err := newAttRewardCalculator(st)
+	require.NoError(t, err)
+
+	atts := proposerAtts{
+		rewardTestAtt(0, 1, 2, 3),
+		rewardTestAtt(4, 5),
+		rewardTestAtt(4),
+	}
+	sorted, err := atts.sortByReward(context.Background(), calc)
+	require.NoError(t, err)
+	want := proposerAtts{
+		rewardTestAtt(4, 5),
+		rewardTestAtt(0, 1, 2, 3),
+		// Adds no reward once the second attestation is included.
+		rewardTestAtt(4),
+	}
+	assert.DeepEqual(t, want, sorted)
+
+	sorted, err = atts.sortByProfitability()
+	require.NoError(t, err)
+	assert.DeepEqual(t, atts, sorted)
+}
+
+func TestProposer_PackAttestations(t *testing.T) {
+	st, _ := rewardTestState(t)
+	future := rewardTestAtt(6)
+	future.Data.Slot = 2
+	atts := []*ethpb.Attestation{
+		rewardTestAtt(0, 1),
+		rewardTestAtt(2, 3),
+		rewardTestAtt(1),
+		future,
+	}
+	priv, err := bls.RandKey()
+	require.NoError(t, err)
+	for _, att := range atts {
+		att.Signature = priv.Sign([]byte("attestation")).Marshal()
+	}
+	packed, err := PackAttestations(context.Background(), st, atts)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(packed))
+	assert.DeepEqual(t, rewardTestAtt(0, 1, 2, 3).AggregationBits, packed[0].AggregationBits)
+
+	// The state is not modified.
+	participation, err := st.CurrentEpochParticipation()
+	require.NoError(t, err)
+	assert.DeepEqual(t, make([]byte, 256), participation)
+}
+
+func TestProposer_AttestationsReward(t *testing.T) {
+	st, _ := rewardTestState(t, 1)
+	atts := []*ethpb.Attestation{rewardTestAtt(0, 1, 2)}
+	reward, err := AttestationsReward(context.Background(), st, atts)
+	require.NoError(t, err)
+	assert.NotEqual(t, uint64(0), reward)
+
+	// The reward matches the balance increase of the proposer when processing the attestation.
+	proposer, err := helpers.BeaconProposerIndex(context.Background(), st)
+	require.NoError(t, err)
+	before, err := st.BalanceAtIndex(proposer)
+	require.NoError(t, err)
+	totalBalance, err := helpers.TotalActiveBalance(st)
+	require.NoError(t, err)
+	post, err := altair.ProcessAttestationNoVerifySignature(context.Background(), st.Copy(), atts[0], totalBalance)
+	require.NoError(t, err)
+	after, err := post.BalanceAtIndex(proposer)
+	require.NoError(t, err)
+	assert.Equal(t, after-before, reward)
+
+	// Rewards earned by several attestations are only counted once.
+	dupReward, err := AttestationsReward(context.Background(), st, append(atts, rewardTestAtt(0, 1)))
+	require.NoError(t, err)
+	assert.Equal(t, reward, dupReward)
+}
+
 func TestProposer_ProposerAtts_dedup(t *testing.T) {
 	data1 := util.HydrateAttestationData(&ethpb.AttestationData{
 		Slot: 4,
@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"math/big"
+	"sync"
 	"time"
 
 	"github.com/pkg/errors"
@@ -34,6 +35,34 @@ var builderGetPayloadMissCount = promauto.NewCounter(prometheus.CounterOpts{
 	Help: "The number of get payload misses for validator requests to builder",
 })
 
+var (
+	// builderBidValueGwei is the value of the last builder bid compared with the local execution payload.
+	builderBidValueGwei = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "builder_bid_value_gwei",
+		Help: "The value in gwei of the last builder bid compared with the local execution payload",
+	})
+	// localBlockValueGwei is the value of the last local execution payload compared with a builder bid.
+	localBlockValueGwei = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "local_block_value_gwei",
+		Help: "The value in gwei of the last local execution payload compared with a builder bid",
+	})
+	// blockSourceCount tracks whether the builder or the local execution payload was chosen for proposals.
+	blockSourceCount = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "proposer_block_source_count",
+		Help: "The number of proposals that used the builder or the local execution payload after comparing their values, or that fell back to the local execution payload because the builder failed",
+	}, []string{"source"})
+	// builderValueCollectedGwei tracks the total value of builder bids used for proposals.
+	builderValueCollectedGwei = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "builder_value_collected_gwei",
+		Help: "The total value in gwei of the builder bids used for proposals",
+	})
+	// builderValueSkippedGwei tracks the total value of builder bids skipped for the local execution payload.
+	builderValueSkippedGwei = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "builder_value_skipped_gwei",
+		Help: "The total value in gwei of the builder bids skipped in favor of the local execution payload",
+	})
+)
+
 // blockBuilderTimeout is the maximum amount of time allowed for a block builder to respond to a
 // block request. This value is known as `BUILDER_PROPOSAL_DELAY_TOLERANCE` in builder spec.
 const blockBuilderTimeout = 1 * time.Second
@@ -44,18 +73,34 @@ func (vs *Server) getBellatrixBeaconBlock(ctx context.Context, req *ethpb.BlockR
 		return nil, err
 	}
 
-	if !req.SkipMevBoost {
+	// The builder block and the local execution payload are requested in parallel, so that the builder bid
+	// can be compared with the value of the local payload, which is also the fallback if the builder fails.
+	var builderReady, builderFailed bool
+	var builderBlk *ethpb.GenericBeaconBlock
+	var builderValue *big.Int
+	var wg sync.WaitGroup
+	headRoot, err := vs.HeadFetcher.HeadRoot(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not retrieve head root")
+	}
+	// Builders build on top of the head, they are skipped when the proposal reorgs a late head block.
+	onHead := bytes.Equal(headRoot, altairBlk.ParentRoot)
+	if !req.SkipMevBoost && onHead {
 		registered, err := vs.validatorRegistered(ctx, altairBlk.ProposerIndex)
 		if registered && err == nil {
-			builderReady, b, err := vs.GetAndBuildBlindBlock(ctx, altairBlk)
-			if err != nil {
-				// In the event of an error, the node should fall back to default execution engine for building block.
-				log.WithError(err).Error("Failed to build a block from external builder, falling " +
-					"back to local execution client")
-				builderGetPayloadMissCount.Inc()
-			} else if builderReady {
-				return b, nil
-			}
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				var err error
+				builderReady, builderBlk, builderValue, err = vs.getAndBuildBlindBlock(ctx, altairBlk)
+				if err != nil {
+					// In the event of an error, the node should fall back to default execution engine for building block.
+					log.WithError(err).Error("Failed to build a block from external builder, falling " +
+						"back to local execution client")
+					builderGetPayloadMissCount.Inc()
+					builderReady, builderFailed = false, true
+				}
+			}()
 		} else if err != nil {
 			log.WithError(err).WithFields(logrus.Fields{
 				"slot":           req.Slot,
@@ -63,10 +108,23 @@ func (vs *Server) getBellatrixBeaconBlock(ctx context.Context, req *ethpb.BlockR
 			}).Error("Could not determine validator has registered. Defaulting to local execution client")
 		}
 	}
-	payload, err := vs.getExecutionPayload(ctx, req.Slot, altairBlk.ProposerIndex, bytesutil.ToBytes32(altairBlk.ParentRoot))
+	payload, localValue, err := vs.getExecutionPayload(ctx, req.Slot, altairBlk.ProposerIndex, bytesutil.ToBytes32(altairBlk.ParentRoot))
+	wg.Wait()
+	if builderReady {
+		if err != nil {
+			log.WithError(err).Warn("Could not get payload from local execution client, using builder block")
+			return builderBlk, nil
+		}
+		if builderBidWins(req.Slot, builderValue, localValue) {
+			return builderBlk, nil
+		}
+	}
 	if err != nil {
 		return nil, err
 	}
+	if builderFailed {
+		blockSourceCount.WithLabelValues("local").Inc()
+	}
 
 	blk := &ethpb.BeaconBlockBellatrix{
 		Slot:          altairBlk.Slot,
@@ -102,61 +160,61 @@ func (vs *Server) getBellatrixBeaconBlock(ctx context.Context, req *ethpb.BlockR
 	return &ethpb.GenericBeaconBlock{Block: &ethpb.GenericBeaconBlock_Bellatrix{Bellatrix: blk}}, nil
 }
 
-// This function retrieves the payload header given the slot number and the validator index.
+// This function retrieves the payload header and the bid value in wei given the slot number and the validator index.
 // It's a no-op if the latest head block is not versioned bellatrix.
-func (vs *Server) getPayloadHeaderFromBuilder(ctx context.Context, slot types.Slot, idx types.ValidatorIndex) (*enginev1.ExecutionPayloadHeader, error) {
+func (vs *Server) getPayloadHeaderFromBuilder(ctx context.Context, slot types.Slot, idx types.ValidatorIndex) (*enginev1.ExecutionPayloadHeader, *big.Int, error) {
 	b, err := vs.HeadFetcher.HeadBlock(ctx)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	if blocks.IsPreBellatrixVersion(b.Version()) {
-		return nil, nil
+		return nil, nil, nil
 	}
 
 	h, err := b.Block().Body().Execution()
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	pk, err := vs.HeadFetcher.HeadValidatorIndexToPublicKey(ctx, idx)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	bid, err := vs.BlockBuilder.GetHeader(ctx, slot, bytesutil.ToBytes32(h.BlockHash()), pk)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	if bid == nil || bid.Message == nil {
-		return nil, errors.New("builder returned nil bid")
+		return nil, nil, errors.New("builder returned nil bid")
 	}
 
 	v := new(big.Int).SetBytes(bytesutil.ReverseByteOrder(bid.Message.Value))
 	if v.String() == "0" {
-		return nil, errors.New("builder returned header with 0 bid amount")
+		return nil, nil, errors.New("builder returned header with 0 bid amount")
 	}
 
 	emptyRoot, err := ssz.TransactionsRoot([][]byte{})
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	if bytesutil.ToBytes32(bid.Message.Header.TransactionsRoot) == emptyRoot {
-		return nil, errors.New("builder returned header with an empty tx root")
+		return nil, nil, errors.New("builder returned header with an empty tx root")
 	}
 
 	if !bytes.Equal(bid.Message.Header.ParentHash, h.BlockHash()) {
-		return nil, fmt.Errorf("incorrect parent hash %#x != %#x", bid.Message.Header.ParentHash, h.BlockHash())
+		return nil, nil, fmt.Errorf("incorrect parent hash %#x != %#x", bid.Message.Header.ParentHash, h.BlockHash())
 	}
 
 	t, err := slots.ToTime(uint64(vs.TimeFetcher.GenesisTime().Unix()), slot)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	if bid.Message.Header.Timestamp != uint64(t.Unix()) {
-		return nil, fmt.Errorf("incorrect timestamp %d != %d", bid.Message.Header.Timestamp, uint64(t.Unix()))
+		return nil, nil, fmt.Errorf("incorrect timestamp %d != %d", bid.Message.Header.Timestamp, uint64(t.Unix()))
 	}
 
 	if err := vs.validateBuilderSignature(bid); err != nil {
-		return nil, errors.Wrap(err, "could not validate builder signature")
+		return nil, nil, errors.Wrap(err, "could not validate builder signature")
 	}
 
 	log.WithFields(logrus.Fields{
@@ -164,7 +222,7 @@ func (vs *Server) getPayloadHeaderFromBuilder(ctx context.Context, slot types.Sl
 		"builderPubKey": fmt.Sprintf("%#x", bid.Message.Pubkey),
 		"blockHash":     fmt.Sprintf("%#x", bid.Message.Header.BlockHash),
 	}).Info("Received header with bid")
-	return bid.Message.Header, nil
+	return bid.Message.Header, v, nil
 }
 
 // This function constructs the builder block given the input altair block and the header. It returns a generic beacon block for signing
@@ -337,84 +395,42 @@ func (vs *Server) readyForBuilder(ctx context.Context) (bool, error) {
 	return blocks.IsExecutionBlock(b.Block().Body())
 }
 
-// circuitBreakBuilder returns true if the builder is not allowed to be used due to circuit breaker conditions.
-func (vs *Server) circuitBreakBuilder(s types.Slot) (bool, error) {
-	if vs.ForkFetcher == nil || vs.ForkFetcher.ForkChoicer() == nil {
-		return true, errors.New("no fork choicer configured")
-	}
-
-	// Circuit breaker is active if the missing consecutive slots greater than `MaxBuilderConsecutiveMissedSlots`.
-	highestReceivedSlot := vs.ForkFetcher.ForkChoicer().HighestReceivedBlockSlot()
-	maxConsecutiveSkipSlotsAllowed := params.BeaconConfig().MaxBuilderConsecutiveMissedSlots
-	diff, err := s.SafeSubSlot(highestReceivedSlot)
-	if err != nil {
-		return true, err
-	}
-	if diff > maxConsecutiveSkipSlotsAllowed {
-		log.WithFields(logrus.Fields{
-			"currentSlot":                    s,
-			"highestReceivedSlot":            highestReceivedSlot,
-			"maxConsecutiveSkipSlotsAllowed": maxConsecutiveSkipSlotsAllowed,
-		}).Warn("Builder circuit breaker activated due to missing consecutive slot")
-		return true, nil
-	}
-
-	// Not much reason to check missed slots epoch rolling window if input slot is less than epoch.
-	if s < params.BeaconConfig().SlotsPerEpoch {
-		return false, nil
-	}
-
-	// Circuit breaker is active if the missing slots per epoch (rolling window) greater than `MaxBuilderEpochMissedSlots`.
-	receivedCount, err := vs.ForkFetcher.ForkChoicer().ReceivedBlocksLastEpoch()
-	if err != nil {
-		return true, err
-	}
-	maxEpochSkipSlotsAllowed := params.BeaconConfig().MaxBuilderEpochMissedSlots
-	diff, err = params.BeaconConfig().SlotsPerEpoch.SafeSub(receivedCount)
-	if err != nil {
-		return true, err
-	}
-	if diff > maxEpochSkipSlotsAllowed {
-		log.WithFields(logrus.Fields{
-			"totalMissed":              diff,
-			"maxEpochSkipSlotsAllowed": maxEpochSkipSlotsAllowed,
-		}).Warn("Builder circuit breaker activated due to missing enough slots last epoch")
-		return true, nil
-	}
-
-	return false, nil
-}
-
 // GetAndBuildBlindBlock builds blind block from builder network. Returns a boolean status, built block and error.
 // If the status is false that means builder the header block is disallowed.
 // This routine is time limited by `blockBuilderTimeout`.
 func (vs *Server) GetAndBuildBlindBlock(ctx context.Context, b *ethpb.BeaconBlockAltair) (bool, *ethpb.GenericBeaconBlock, error) {
+	ready, gb, _, err := vs.getAndBuildBlindBlock(ctx, b)
+	return ready, gb, err
+}
+
+// This builds blind block from builder network like GetAndBuildBlindBlock, and also returns the bid value in wei.
+func (vs *Server) getAndBuildBlindBlock(ctx context.Context, b *ethpb.BeaconBlockAltair) (bool, *ethpb.GenericBeaconBlock, *big.Int, error) {
 	// No op. Builder is not defined. User did not specify a user URL. We should use local EE.
 	if vs.BlockBuilder == nil || !vs.BlockBuilder.Configured() {
-		return false, nil, nil
+		return false, nil, nil, nil
 	}
 	ctx, cancel := context.WithTimeout(ctx, blockBuilderTimeout)
 	defer cancel()
 	// Does the protocol allow for builder at this current moment. Builder is only allowed post merge after finalization.
 	ready, err := vs.readyForBuilder(ctx)
 	if err != nil {
-		return false, nil, errors.Wrap(err, "could not determine if builder is ready")
+		return false, nil, nil, errors.Wrap(err, "could not determine if builder is ready")
 	}
 	if !ready {
-		return false, nil, nil
+		return false, nil, nil, nil
 	}
 
-	circuitBreak, err := vs.circuitBreakBuilder(b.Slot)
+	circuitBreak, err := vs.BlockBuilder.CircuitBreakerOpen(ctx, b.Slot)
 	if err != nil {
-		return false, nil, errors.Wrap(err, "could not determine if builder circuit breaker condition")
+		return false, nil, nil, errors.Wrap(err, "could not determine if builder circuit breaker condition")
 	}
 	if circuitBreak {
-		return false, nil, nil
+		return false, nil, nil, nil
 	}
 
-	h, err := vs.getPayloadHeaderFromBuilder(ctx, b.Slot, b.ProposerIndex)
+	h, value, err := vs.getPayloadHeaderFromBuilder(ctx, b.Slot, b.ProposerIndex)
 	if err != nil {
-		return false, nil, errors.Wrap(err, "could not get payload header")
+		return false, nil, nil, errors.Wrap(err, "could not get payload header")
 	}
 	log.WithFields(logrus.Fields{
 		"blockHash":    fmt.Sprintf("%#x", h.BlockHash),
@@ -424,9 +440,51 @@ func (vs *Server) GetAndBuildBlindBlock(ctx context.Context, b *ethpb.BeaconBloc
 	}).Info("Retrieved header from builder")
 	gb, err := vs.buildBlindBlock(ctx, b, h)
 	if err != nil {
-		return false, nil, errors.Wrap(err, "could not combine altair block with payload header")
+		return false, nil, nil, errors.Wrap(err, "could not combine altair block with payload header")
 	}
-	return true, gb, nil
+	return true, gb, value, nil
+}
+
+// Returns true if the builder bid should be used over the local execution payload. Bids below the minimum
+// builder bid are skipped. When the execution client reports the value of the local payload, the bid must
+// also be worth more than that value increased by the local block value boost. Both values and the choice
+// are logged and exported as metrics.
+func builderBidWins(slot types.Slot, builderValue, localValue *big.Int) bool {
+	cfg := params.BeaconConfig()
+	if builderValue == nil {
+		builderValue = big.NewInt(0)
+	}
+	builderGwei := weiToGwei(builderValue)
+	builderBidValueGwei.Set(float64(builderGwei))
+	fields := logrus.Fields{
+		"slot":             slot,
+		"builderValueGwei": builderGwei,
+		"minBuilderBid":    cfg.MinBuilderBid,
+	}
+	useBuilder := builderGwei >= cfg.MinBuilderBid
+	if localValue != nil {
+		localGwei := weiToGwei(localValue)
+		localBlockValueGwei.Set(float64(localGwei))
+		boosted := new(big.Int).Mul(localValue, new(big.Int).SetUint64(100+cfg.LocalBlockValueBoost))
+		boosted.Div(boosted, big.NewInt(100))
+		useBuilder = useBuilder && builderValue.Cmp(boosted) > 0
+		fields["localValueGwei"] = localGwei
+		fields["localBlockValueBoost"] = cfg.LocalBlockValueBoost
+	}
+	if useBuilder {
+		blockSourceCount.WithLabelValues("builder").Inc()
+		builderValueCollectedGwei.Add(float64(builderGwei))
+		log.WithFields(fields).Info("Using builder block, bid is higher than local block value")
+	} else {
+		blockSourceCount.WithLabelValues("local").Inc()
+		builderValueSkippedGwei.Add(float64(builderGwei))
+		log.WithFields(fields).Info("Using local block, builder bid is too low")
+	}
+	return useBuilder
+}
+
+func weiToGwei(v *big.Int) uint64 {
+	return new(big.Int).Div(v, new(big.Int).SetUint64(params.BeaconConfig().GweiPerEth)).Uint64()
 }
 
 // validatorRegistered returns true if validator with index `id` was previously registered in the database.
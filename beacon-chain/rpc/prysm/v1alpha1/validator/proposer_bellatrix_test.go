@@ -2,6 +2,7 @@ package validator
 
 import (
 	"context"
+	"math/big"
 	"testing"
 	"time"
 
@@ -22,8 +23,6 @@ import (
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/operations/slashings"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/operations/synccommittee"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/operations/voluntaryexits"
-	"github.com/prysmaticlabs/prysm/v3/beacon-chain/state"
-	state_native "github.com/prysmaticlabs/prysm/v3/beacon-chain/state/state-native"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/state/stategen"
 	mockSync "github.com/prysmaticlabs/prysm/v3/beacon-chain/sync/initial-sync/testing"
 	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
@@ -34,7 +33,6 @@ import (
 	"github.com/prysmaticlabs/prysm/v3/crypto/bls"
 	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v3/encoding/ssz"
-	enginev1 "github.com/prysmaticlabs/prysm/v3/proto/engine/v1"
 	v1 "github.com/prysmaticlabs/prysm/v3/proto/engine/v1"
 	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v3/testing/require"
@@ -223,7 +221,7 @@ func TestServer_getPayloadHeader(t *testing.T) {
 			vs := &Server{BlockBuilder: tc.mock, HeadFetcher: tc.fetcher, TimeFetcher: &blockchainTest.ChainService{
 				Genesis: time.Now(),
 			}}
-			h, err := vs.getPayloadHeaderFromBuilder(context.Background(), 0, 0)
+			h, _, err := vs.getPayloadHeaderFromBuilder(context.Background(), 0, 0)
 			if tc.err != "" {
 				require.ErrorContains(t, tc.err, err)
 			} else {
@@ -949,76 +947,36 @@ func TestServer_validateBuilderSignature(t *testing.T) {
 	require.ErrorIs(t, s.validateBuilderSignature(sBid), signing.ErrSigFailedToVerify)
 }
 
-func TestServer_circuitBreakBuilder(t *testing.T) {
-	hook := logTest.NewGlobal()
-	s := &Server{}
-	_, err := s.circuitBreakBuilder(0)
-	require.ErrorContains(t, "no fork choicer configured", err)
-
-	s.ForkFetcher = &blockchainTest.ChainService{ForkChoiceStore: doublylinkedtree.New()}
-	s.ForkFetcher.ForkChoicer().SetGenesisTime(uint64(time.Now().Unix()))
-	b, err := s.circuitBreakBuilder(params.BeaconConfig().MaxBuilderConsecutiveMissedSlots + 1)
-	require.NoError(t, err)
-	require.Equal(t, true, b)
-	require.LogsContain(t, hook, "Builder circuit breaker activated due to missing consecutive slot")
-
-	ojc := &ethpb.Checkpoint{Root: params.BeaconConfig().ZeroHash[:]}
-	ofc := &ethpb.Checkpoint{Root: params.BeaconConfig().ZeroHash[:]}
-	ctx := context.Background()
-	st, blkRoot, err := createState(1, [32]byte{'a'}, [32]byte{}, params.BeaconConfig().ZeroHash, ojc, ofc)
-	require.NoError(t, err)
-	require.NoError(t, s.ForkFetcher.ForkChoicer().InsertNode(ctx, st, blkRoot))
-	b, err = s.circuitBreakBuilder(params.BeaconConfig().MaxBuilderConsecutiveMissedSlots + 1)
-	require.NoError(t, err)
-	require.Equal(t, false, b)
-
-	params.SetupTestConfigCleanup(t)
-	cfg := params.BeaconConfig().Copy()
-	cfg.MaxBuilderEpochMissedSlots = 4
-	params.OverrideBeaconConfig(cfg)
-	st, blkRoot, err = createState(params.BeaconConfig().SlotsPerEpoch, [32]byte{'b'}, [32]byte{'a'}, params.BeaconConfig().ZeroHash, ojc, ofc)
-	require.NoError(t, err)
-	require.NoError(t, s.ForkFetcher.ForkChoicer().InsertNode(ctx, st, blkRoot))
-	b, err = s.circuitBreakBuilder(params.BeaconConfig().SlotsPerEpoch + 1)
-	require.NoError(t, err)
-	require.Equal(t, true, b)
-	require.LogsContain(t, hook, "Builder circuit breaker activated due to missing enough slots last epoch")
-
-	want := params.BeaconConfig().SlotsPerEpoch - params.BeaconConfig().MaxBuilderEpochMissedSlots
-	for i := types.Slot(2); i <= want+2; i++ {
-		st, blkRoot, err = createState(i, [32]byte{byte(i)}, [32]byte{'a'}, params.BeaconConfig().ZeroHash, ojc, ofc)
-		require.NoError(t, err)
-		require.NoError(t, s.ForkFetcher.ForkChoicer().InsertNode(ctx, st, blkRoot))
+func TestServer_builderBidWins(t *testing.T) {
+	gwei := func(v uint64) *big.Int {
+		return new(big.Int).Mul(new(big.Int).SetUint64(v), big.NewInt(1e9))
 	}
-	b, err = s.circuitBreakBuilder(params.BeaconConfig().SlotsPerEpoch + 1)
-	require.NoError(t, err)
-	require.Equal(t, false, b)
-}
-
-func createState(
-	slot types.Slot,
-	blockRoot [32]byte,
-	parentRoot [32]byte,
-	payloadHash [32]byte,
-	justified *ethpb.Checkpoint,
-	finalized *ethpb.Checkpoint,
-) (state.BeaconState, [32]byte, error) {
-
-	base := &ethpb.BeaconStateBellatrix{
-		Slot:                       slot,
-		RandaoMixes:                make([][]byte, params.BeaconConfig().EpochsPerHistoricalVector),
-		BlockRoots:                 make([][]byte, 1),
-		CurrentJustifiedCheckpoint: justified,
-		FinalizedCheckpoint:        finalized,
-		LatestExecutionPayloadHeader: &enginev1.ExecutionPayloadHeader{
-			BlockHash: payloadHash[:],
-		},
-		LatestBlockHeader: &ethpb.BeaconBlockHeader{
-			ParentRoot: parentRoot[:],
-		},
+	tests := []struct {
+		name         string
+		boost        uint64
+		minBid       uint64
+		builderValue *big.Int
+		localValue   *big.Int
+		want         bool
+	}{
+		{name: "no local value", builderValue: gwei(1), want: true},
+		{name: "no local value, below minimum bid", minBid: 2, builderValue: gwei(1), want: false},
+		{name: "builder higher", builderValue: gwei(11), localValue: gwei(10), want: true},
+		{name: "builder equal", builderValue: gwei(10), localValue: gwei(10), want: false},
+		{name: "builder lower", builderValue: gwei(9), localValue: gwei(10), want: false},
+		{name: "builder higher than boosted local", boost: 10, builderValue: gwei(12), localValue: gwei(10), want: true},
+		{name: "builder lower than boosted local", boost: 10, builderValue: gwei(11), localValue: gwei(10), want: false},
+		{name: "builder higher but below minimum bid", minBid: 20, builderValue: gwei(11), localValue: gwei(10), want: false},
+		{name: "nil builder value", localValue: gwei(10), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params.SetupTestConfigCleanup(t)
+			cfg := params.BeaconConfig().Copy()
+			cfg.LocalBlockValueBoost = tt.boost
+			cfg.MinBuilderBid = tt.minBid
+			params.OverrideBeaconConfig(cfg)
+			require.Equal(t, tt.want, builderBidWins(1, tt.builderValue, tt.localValue))
+		})
 	}
-
-	base.BlockRoots[0] = append(base.BlockRoots[0], blockRoot[:]...)
-	st, err := state_native.InitializeFromProtoBellatrix(base)
-	return st, blockRoot, err
 }
@@ -6,7 +6,9 @@ import (
 
 	"github.com/pkg/errors"
 	fastssz "github.com/prysmaticlabs/fastssz"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/cache/depositcache"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/execution"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/v3/config/features"
 	"github.com/prysmaticlabs/prysm/v3/config/params"
@@ -19,17 +21,8 @@ import (
 )
 
 // eth1DataMajorityVote determines the appropriate eth1data for a block proposal using
-// an algorithm called Voting with the Majority. The algorithm works as follows:
-//  - Determine the timestamp for the start slot for the eth1 voting period.
-//  - Determine the earliest and latest timestamps that a valid block can have.
-//  - Determine the first block not before the earliest timestamp. This block is the lower bound.
-//  - Determine the last block not after the latest timestamp. This block is the upper bound.
-//  - If the last block is too early, use current eth1data from the beacon state.
-//  - Filter out votes on unknown blocks and blocks which are outside of the range determined by the lower and upper bounds.
-//  - If no blocks are left after filtering votes, use eth1data from the latest valid block.
-//  - Otherwise:
-//    - Determine the vote with the highest count. Prefer the vote with the highest eth1 block height in the event of a tie.
-//    - This vote's block is the eth1 block to use for the block proposal.
+// an algorithm called Voting with the Majority, starting from the timestamp of the
+// start slot of the eth1 voting period. See Eth1DataMajorityVote.
 func (vs *Server) eth1DataMajorityVote(ctx context.Context, beaconState state.BeaconState) (*ethpb.Eth1Data, error) {
 	ctx, cancel := context.WithTimeout(ctx, eth1dataTimeout)
 	defer cancel()
@@ -45,29 +38,63 @@ func (vs *Server) eth1DataMajorityVote(ctx context.Context, beaconState state.Be
 	}
 	eth1DataNotification = false
 
+	vote, err := Eth1DataMajorityVote(
+		ctx,
+		vs.Eth1BlockFetcher,
+		vs.DepositFetcher,
+		votingPeriodStartTime,
+		vs.HeadFetcher.HeadETH1Data(),
+		vs.ChainStartFetcher.ChainStartEth1Data(),
+	)
+	if err != nil {
+		log.WithError(err).Error("Could not compute eth1 data majority vote")
+		return vs.randomETH1DataVote(ctx)
+	}
+	return vote, nil
+}
+
+// Eth1DataMajorityVote determines the eth1data a proposer votes for in the voting period starting at the
+// given time, using an algorithm called Voting with the Majority. The algorithm works as follows:
+//  - Determine the earliest and latest timestamps that a valid block can have.
+//  - Determine the first block not before the earliest timestamp. This block is the lower bound.
+//  - Determine the last block not after the latest timestamp. This block is the upper bound.
+//  - If the last block is too early, use current eth1data from the beacon state.
+//  - Filter out votes on unknown blocks and blocks which are outside of the range determined by the lower and upper bounds.
+//  - If no blocks are left after filtering votes, use eth1data from the latest valid block.
+//  - Otherwise:
+//    - Determine the vote with the highest count. Prefer the vote with the highest eth1 block height in the event of a tie.
+//    - This vote's block is the eth1 block to use for the block proposal.
+//
+// It only depends on the execution chain and deposits, so that tooling can reproduce the votes of a node.
+func Eth1DataMajorityVote(
+	ctx context.Context,
+	blockFetcher execution.POWBlockFetcher,
+	depositFetcher depositcache.DepositFetcher,
+	votingPeriodStartTime uint64,
+	headEth1Data *ethpb.Eth1Data,
+	chainStartEth1Data *ethpb.Eth1Data,
+) (*ethpb.Eth1Data, error) {
 	eth1FollowDistance := params.BeaconConfig().Eth1FollowDistance
 	earliestValidTime := votingPeriodStartTime - 2*params.BeaconConfig().SecondsPerETH1Block*eth1FollowDistance
 	latestValidTime := votingPeriodStartTime - params.BeaconConfig().SecondsPerETH1Block*eth1FollowDistance
 
-	lastBlockByLatestValidTime, err := vs.Eth1BlockFetcher.BlockByTimestamp(ctx, latestValidTime)
+	lastBlockByLatestValidTime, err := blockFetcher.BlockByTimestamp(ctx, latestValidTime)
 	if err != nil {
-		log.WithError(err).Error("Could not get last block by latest valid time")
-		return vs.randomETH1DataVote(ctx)
+		return nil, errors.Wrap(err, "could not get last block by latest valid time")
 	}
 	if lastBlockByLatestValidTime.Time < earliestValidTime {
-		return vs.HeadFetcher.HeadETH1Data(), nil
+		return headEth1Data, nil
 	}
 
-	lastBlockDepositCount, lastBlockDepositRoot := vs.DepositFetcher.DepositsNumberAndRootAtHeight(ctx, lastBlockByLatestValidTime.Number)
+	lastBlockDepositCount, lastBlockDepositRoot := depositFetcher.DepositsNumberAndRootAtHeight(ctx, lastBlockByLatestValidTime.Number)
 	if lastBlockDepositCount == 0 {
-		return vs.ChainStartFetcher.ChainStartEth1Data(), nil
+		return chainStartEth1Data, nil
 	}
 
-	if lastBlockDepositCount >= vs.HeadFetcher.HeadETH1Data().DepositCount {
-		h, err := vs.Eth1BlockFetcher.BlockHashByHeight(ctx, lastBlockByLatestValidTime.Number)
+	if lastBlockDepositCount >= headEth1Data.DepositCount {
+		h, err := blockFetcher.BlockHashByHeight(ctx, lastBlockByLatestValidTime.Number)
 		if err != nil {
-			log.WithError(err).Error("Could not get hash of last block by latest valid time")
-			return vs.randomETH1DataVote(ctx)
+			return nil, errors.Wrap(err, "could not get hash of last block by latest valid time")
 		}
 		return &ethpb.Eth1Data{
 			BlockHash:    h.Bytes(),
@@ -75,7 +102,7 @@ func (vs *Server) eth1DataMajorityVote(ctx context.Context, beaconState state.Be
 			DepositRoot:  lastBlockDepositRoot[:],
 		}, nil
 	}
-	return vs.HeadFetcher.HeadETH1Data(), nil
+	return headEth1Data, nil
 }
 
 func (vs *Server) slotStartTime(slot types.Slot) uint64 {
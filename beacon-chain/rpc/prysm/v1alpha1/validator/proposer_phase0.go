@@ -10,11 +10,13 @@ import (
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/transition"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/transition/interop"
 	v "github.com/prysmaticlabs/prysm/v3/beacon-chain/core/validators"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/v3/config/params"
 	consensusblocks "github.com/prysmaticlabs/prysm/v3/consensus-types/blocks"
 	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
 	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 )
 
@@ -97,6 +99,20 @@ func (vs *Server) buildPhase0BlockData(ctx context.Context, req *ethpb.BlockRequ
 		return nil, fmt.Errorf("could not get head state %v", err)
 	}
 
+	// Build on the parent of the head instead, if the head is a late and weakly attested block to reorg.
+	if proposerHead := vs.proposerHead(bytesutil.ToBytes32(parentRoot)); proposerHead != bytesutil.ToBytes32(parentRoot) {
+		head, err = vs.StateGen.StateByRoot(ctx, proposerHead)
+		if err != nil {
+			return nil, fmt.Errorf("could not get parent state of late head block %v", err)
+		}
+		log.WithFields(logrus.Fields{
+			"slot":       req.Slot,
+			"headRoot":   fmt.Sprintf("%#x", bytesutil.Trunc(parentRoot)),
+			"parentRoot": fmt.Sprintf("%#x", bytesutil.Trunc(proposerHead[:])),
+		}).Info("Proposing on parent of late head block")
+		parentRoot = proposerHead[:]
+	}
+
 	head, err = transition.ProcessSlotsUsingNextSlotCache(ctx, head, parentRoot, req.Slot)
 	if err != nil {
 		return nil, fmt.Errorf("could not advance slots to calculate proposer index: %v", err)
@@ -168,3 +184,31 @@ func (vs *Server) buildPhase0BlockData(ctx context.Context, req *ethpb.BlockRequ
 		VoluntaryExits:    validExits,
 	}, nil
 }
+
+// proposerHead returns the root of the block to build a proposal on, given the head root. This is the head
+// root, unless fork choice decides to reorg a late and weakly attested head block, in which case the root of
+// its parent is returned.
+func (vs *Server) proposerHead(headRoot [32]byte) [32]byte {
+	if vs.ForkFetcher == nil || vs.ForkFetcher.ForkChoicer() == nil {
+		return headRoot
+	}
+	fc := vs.ForkFetcher.ForkChoicer()
+	// The head may have changed since it was retrieved, only reorg the head the proposal was started with.
+	if fc.CachedHeadRoot() != headRoot {
+		return headRoot
+	}
+	return fc.GetProposerHead()
+}
+
+// parentState returns the state of the given parent block of a proposal. This is the head state, unless the
+// proposer reorgs a late head block and builds on its parent.
+func (vs *Server) parentState(ctx context.Context, parentRoot [32]byte) (state.BeaconState, error) {
+	headRoot, err := vs.HeadFetcher.HeadRoot(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not retrieve head root")
+	}
+	if bytesutil.ToBytes32(headRoot) == parentRoot {
+		return vs.HeadFetcher.HeadState(ctx)
+	}
+	return vs.StateGen.StateByRoot(ctx, parentRoot)
+}
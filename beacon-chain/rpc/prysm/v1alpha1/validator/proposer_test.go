@@ -2495,3 +2495,37 @@ func TestProposer_GetFeeRecipientByPubKey(t *testing.T) {
 
 	require.Equal(t, common.HexToAddress("0x055Fb65722E7b2455012BFEBf6177F1D2e9728D8").Hex(), common.BytesToAddress(resp.FeeRecipient).Hex())
 }
+
+func TestProposer_parentState(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	params.OverrideBeaconConfig(params.MainnetConfig())
+	ctx := context.Background()
+	db := dbutil.SetupDB(t)
+	headState, _ := util.DeterministicGenesisState(t, 1)
+	require.NoError(t, headState.SetSlot(2))
+	parentState, _ := util.DeterministicGenesisState(t, 1)
+	require.NoError(t, parentState.SetSlot(1))
+	headRoot, parentRoot := [32]byte{'h'}, [32]byte{'p'}
+	require.NoError(t, db.SaveState(ctx, parentState, parentRoot))
+
+	proposerServer := &Server{
+		HeadFetcher: &mock.ChainService{Root: headRoot[:], State: headState},
+		StateGen:    stategen.New(db, doublylinkedtree.New()),
+	}
+	st, err := proposerServer.parentState(ctx, headRoot)
+	require.NoError(t, err)
+	require.Equal(t, types.Slot(2), st.Slot())
+	st, err = proposerServer.parentState(ctx, parentRoot)
+	require.NoError(t, err)
+	require.Equal(t, types.Slot(1), st.Slot())
+}
+
+func TestProposer_proposerHead(t *testing.T) {
+	headRoot := [32]byte{'h'}
+	proposerServer := &Server{}
+	require.Equal(t, headRoot, proposerServer.proposerHead(headRoot))
+
+	// The fork choice head is not the head the proposal was started with.
+	proposerServer.ForkFetcher = &mock.ChainService{ForkChoiceStore: doublylinkedtree.New()}
+	require.Equal(t, headRoot, proposerServer.proposerHead(headRoot))
+}
@@ -115,6 +115,7 @@ type Config struct {
 	ProposerIdsCache              *cache.ProposerPayloadIDsCache
 	OptimisticModeFetcher         blockchain.OptimisticModeFetcher
 	BlockBuilder                  builder.BlockBuilder
+	PayloadInvalidationFetcher    blockchain.PayloadInvalidationFetcher
 }
 
 // NewService instantiates a new RPC service instance that will
@@ -254,6 +255,7 @@ func (s *Service) Start() {
 		PeerManager:          s.cfg.PeerManager,
 		GenesisFetcher:       s.cfg.GenesisFetcher,
 		POWChainInfoFetcher:  s.cfg.ExecutionChainInfoFetcher,
+		BlockBuilder:         s.cfg.BlockBuilder,
 		BeaconMonitoringHost: s.cfg.BeaconMonitoringHost,
 		BeaconMonitoringPort: s.cfg.BeaconMonitoringPort,
 	}
@@ -333,13 +335,14 @@ func (s *Service) Start() {
 	if s.cfg.EnableDebugRPCEndpoints {
 		log.Info("Enabled debug gRPC endpoints")
 		debugServer := &debugv1alpha1.Server{
-			GenesisTimeFetcher: s.cfg.GenesisTimeFetcher,
-			BeaconDB:           s.cfg.BeaconDB,
-			StateGen:           s.cfg.StateGen,
-			HeadFetcher:        s.cfg.HeadFetcher,
-			PeerManager:        s.cfg.PeerManager,
-			PeersFetcher:       s.cfg.PeersFetcher,
-			ReplayerBuilder:    ch,
+			GenesisTimeFetcher:         s.cfg.GenesisTimeFetcher,
+			BeaconDB:                   s.cfg.BeaconDB,
+			StateGen:                   s.cfg.StateGen,
+			HeadFetcher:                s.cfg.HeadFetcher,
+			PeerManager:                s.cfg.PeerManager,
+			PeersFetcher:               s.cfg.PeersFetcher,
+			ReplayerBuilder:            ch,
+			PayloadInvalidationFetcher: s.cfg.PayloadInvalidationFetcher,
 		}
 		debugServerV1 := &debug.Server{
 			BeaconDB:    s.cfg.BeaconDB,
@@ -0,0 +1,64 @@
+package checkpoint
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v3/api/client/beacon"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/state"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	log "github.com/sirupsen/logrus"
+)
+
+// saveDepositSnapshot downloads the finalized deposit tree snapshot of the checkpoint provider alongside the origin
+// state, so that the node can resume processing deposit logs from the snapshot instead of from the deposit contract
+// deployment. The snapshot is optional; failing to retrieve or verify it only means the node falls back to syncing
+// the full deposit history.
+func saveDepositSnapshot(ctx context.Context, d db.Database, c *beacon.Client, st state.BeaconState) {
+	snapshot, err := c.GetDepositSnapshot(ctx)
+	if err != nil {
+		log.WithError(err).WithField("provider", c.NodeURL()).Warn("Could not retrieve deposit snapshot, deposit logs will be synced from genesis")
+		return
+	}
+	if err := verifyDepositSnapshot(snapshot, st); err != nil {
+		log.WithError(err).WithField("provider", c.NodeURL()).Warn("Ignoring invalid deposit snapshot")
+		return
+	}
+	if err := d.SaveDepositSnapshot(ctx, snapshot); err != nil {
+		log.WithError(err).Warn("Could not save deposit snapshot")
+		return
+	}
+	log.WithFields(log.Fields{
+		"depositCount":         snapshot.DepositCount,
+		"executionBlockHeight": snapshot.ExecutionBlockHeight,
+	}).Info("Saved deposit snapshot from checkpoint provider")
+}
+
+// verifyDepositSnapshot checks that the snapshot covers exactly the deposits of the eth1 data of the origin state,
+// which the state has all processed, so that it can be verified against the deposit root and execution block voted
+// into the state. Snapshots of fewer deposits cannot be verified against the state and are rejected.
+func verifyDepositSnapshot(snapshot *ethpb.DepositSnapshot, st state.BeaconState) error {
+	if st == nil || st.IsNil() {
+		return errors.New("nil origin state")
+	}
+	if snapshot.DepositCount > st.Eth1DepositIndex() {
+		return fmt.Errorf("snapshot deposit count %d is greater than origin state deposit index %d", snapshot.DepositCount, st.Eth1DepositIndex())
+	}
+	eth1Data := st.Eth1Data()
+	if eth1Data == nil {
+		return errors.New("nil origin state eth1 data")
+	}
+	if snapshot.DepositCount != eth1Data.DepositCount {
+		return fmt.Errorf("snapshot deposit count %d does not match origin state eth1 data deposit count %d", snapshot.DepositCount, eth1Data.DepositCount)
+	}
+	if !bytes.Equal(snapshot.DepositRoot, eth1Data.DepositRoot) {
+		return fmt.Errorf("snapshot deposit root %#x does not match origin state deposit root %#x", snapshot.DepositRoot, eth1Data.DepositRoot)
+	}
+	if !bytes.Equal(snapshot.ExecutionBlockHash, eth1Data.BlockHash) {
+		return fmt.Errorf("snapshot execution block %#x does not match origin state eth1 data block %#x", snapshot.ExecutionBlockHash, eth1Data.BlockHash)
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+package checkpoint
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+	"github.com/prysmaticlabs/prysm/v3/testing/util"
+)
+
+func TestVerifyDepositSnapshot(t *testing.T) {
+	root := bytesutil.PadTo([]byte{'r'}, 32)
+	blockHash := bytesutil.PadTo([]byte{'b'}, 32)
+	st, err := util.NewBeaconState(func(s *ethpb.BeaconState) error {
+		s.Eth1Data = &ethpb.Eth1Data{DepositCount: 10, DepositRoot: root, BlockHash: blockHash}
+		s.Eth1DepositIndex = 10
+		return nil
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, verifyDepositSnapshot(&ethpb.DepositSnapshot{DepositCount: 10, DepositRoot: root, ExecutionBlockHash: blockHash}, st))
+	// Snapshots of older finalized deposits cannot be checked against the state deposit root.
+	err = verifyDepositSnapshot(&ethpb.DepositSnapshot{DepositCount: 8, DepositRoot: []byte{'o'}, ExecutionBlockHash: blockHash}, st)
+	require.ErrorContains(t, "does not match origin state eth1 data deposit count", err)
+	err = verifyDepositSnapshot(&ethpb.DepositSnapshot{DepositCount: 10, DepositRoot: []byte{'o'}, ExecutionBlockHash: blockHash}, st)
+	require.ErrorContains(t, "does not match origin state deposit root", err)
+	err = verifyDepositSnapshot(&ethpb.DepositSnapshot{DepositCount: 10, DepositRoot: root, ExecutionBlockHash: []byte{'o'}}, st)
+	require.ErrorContains(t, "does not match origin state eth1 data block", err)
+	err = verifyDepositSnapshot(&ethpb.DepositSnapshot{DepositCount: 11, DepositRoot: root, ExecutionBlockHash: blockHash}, st)
+	require.ErrorContains(t, "greater than origin state deposit index", err)
+
+	// Deposits of the eth1 data still pending inclusion in the state are not finalized yet.
+	require.NoError(t, st.SetEth1DepositIndex(8))
+	err = verifyDepositSnapshot(&ethpb.DepositSnapshot{DepositCount: 10, DepositRoot: root, ExecutionBlockHash: blockHash}, st)
+	require.ErrorContains(t, "greater than origin state deposit index", err)
+}
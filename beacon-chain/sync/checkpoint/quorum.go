@@ -0,0 +1,142 @@
+package checkpoint
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v3/api/client/beacon"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/v3/config/params"
+	log "github.com/sirupsen/logrus"
+)
+
+var errNoQuorum = errors.New("checkpoint providers did not reach quorum on the finalized block root")
+
+// QuorumAPIInitializer manages initializing the beacon node using checkpoint sync from several remote beacon node
+// apis. The finalized block root reported by each provider is compared, and the origin state and block are only
+// downloaded once a quorum of providers agree on that root.
+type QuorumAPIInitializer struct {
+	clients []*beacon.Client
+	quorum  int
+}
+
+// NewQuorumAPIInitializer creates a QuorumAPIInitializer, handling the set up of a beacon node api client for each
+// of the provided host strings. A quorum of 0 defaults to a simple majority of the providers.
+func NewQuorumAPIInitializer(beaconNodeHosts []string, quorum int) (*QuorumAPIInitializer, error) {
+	if len(beaconNodeHosts) == 0 {
+		return nil, errors.New("at least one checkpoint provider is required")
+	}
+	if quorum == 0 {
+		quorum = len(beaconNodeHosts)/2 + 1
+	}
+	if quorum < 0 || quorum > len(beaconNodeHosts) {
+		return nil, fmt.Errorf("checkpoint sync quorum %d must be between 1 and the number of providers (%d)", quorum, len(beaconNodeHosts))
+	}
+	clients := make([]*beacon.Client, len(beaconNodeHosts))
+	for i, h := range beaconNodeHosts {
+		c, err := beacon.NewClient(h)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to parse beacon node url or hostname - %s", h)
+		}
+		clients[i] = c
+	}
+	return &QuorumAPIInitializer{clients: clients, quorum: quorum}, nil
+}
+
+// Initialize queries every provider for its finalized block root and, once a quorum agrees, downloads the origin
+// state and block from one of the agreeing providers. The downloaded data is checked against the agreed root before
+// the database records are initialized to prepare the node to begin syncing from that point.
+func (dl *QuorumAPIInitializer) Initialize(ctx context.Context, d db.Database) error {
+	origin, err := d.OriginCheckpointBlockRoot(ctx)
+	if err == nil && origin != params.BeaconConfig().ZeroHash {
+		log.Warnf("origin checkpoint root %#x found in db, ignoring checkpoint sync flags", origin)
+		return nil
+	} else {
+		if !errors.Is(err, db.ErrNotFound) {
+			return errors.Wrap(err, "error while checking database for origin root")
+		}
+	}
+	votes := dl.finalizedRootVotes(ctx)
+	root, agreeing, err := tallyFinalizedRoots(votes, dl.quorum)
+	if err != nil {
+		return err
+	}
+	log.WithField("root", fmt.Sprintf("%#x", root)).Infof("%d of %d checkpoint providers agree on the finalized block root", len(agreeing), len(votes))
+
+	// The finality of a provider may advance between the root query and the download, so each agreeing
+	// provider is tried in turn until one returns data matching the agreed root.
+	for _, i := range agreeing {
+		c := dl.clients[i]
+		od, err := beacon.DownloadFinalizedData(ctx, c)
+		if err != nil {
+			log.WithError(err).WithField("provider", c.NodeURL()).Warn("Could not retrieve checkpoint origin state and block")
+			continue
+		}
+		if od.BlockRoot() != root {
+			log.WithField("provider", c.NodeURL()).Warnf("Checkpoint origin block root %#x does not match agreed root %#x", od.BlockRoot(), root)
+			continue
+		}
+		if err := d.SaveOrigin(ctx, od.StateBytes(), od.BlockBytes()); err != nil {
+			return err
+		}
+		saveDepositSnapshot(ctx, d, c, od.State())
+		return nil
+	}
+	return fmt.Errorf("could not retrieve checkpoint origin state and block matching root %#x from any agreeing provider", root)
+}
+
+// rootVote is the finalized block root reported by a single checkpoint provider.
+type rootVote struct {
+	root [32]byte
+	err  error
+}
+
+func (dl *QuorumAPIInitializer) finalizedRootVotes(ctx context.Context) []rootVote {
+	votes := make([]rootVote, len(dl.clients))
+	var wg sync.WaitGroup
+	for i, c := range dl.clients {
+		wg.Add(1)
+		go func(i int, c *beacon.Client) {
+			defer wg.Done()
+			r, err := c.GetBlockRoot(ctx, beacon.IdFinalized)
+			if err != nil {
+				log.WithError(err).WithField("provider", c.NodeURL()).Warn("Could not retrieve finalized block root")
+			}
+			votes[i] = rootVote{root: r, err: err}
+		}(i, c)
+	}
+	wg.Wait()
+	return votes
+}
+
+// tallyFinalizedRoots returns the finalized block root reported by at least quorum providers, along with the
+// indices of the providers which reported it. Providers which failed to respond do not count towards any root.
+// An error is returned if no root reaches the quorum, or if more than one root does.
+func tallyFinalizedRoots(votes []rootVote, quorum int) ([32]byte, []int, error) {
+	byRoot := make(map[[32]byte][]int)
+	for i, v := range votes {
+		if v.err != nil {
+			continue
+		}
+		byRoot[v.root] = append(byRoot[v.root], i)
+	}
+	var agreed [32]byte
+	var agreeing []int
+	for r, idx := range byRoot {
+		if len(idx) < quorum {
+			continue
+		}
+		if agreeing != nil {
+			return [32]byte{}, nil, errors.Wrapf(errNoQuorum, "both %#x and %#x reached a quorum of %d", agreed, r, quorum)
+		}
+		agreed, agreeing = r, idx
+	}
+	if agreeing == nil {
+		return [32]byte{}, nil, errors.Wrapf(errNoQuorum, "%d distinct roots reported, quorum of %d required", len(byRoot), quorum)
+	}
+	return agreed, agreeing, nil
+}
+
+var _ Initializer = &QuorumAPIInitializer{}
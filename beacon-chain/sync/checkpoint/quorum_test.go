@@ -0,0 +1,84 @@
+package checkpoint
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+)
+
+func TestNewQuorumAPIInitializer(t *testing.T) {
+	hosts := []string{"http://localhost:3500", "http://localhost:3501", "http://localhost:3502"}
+	qi, err := NewQuorumAPIInitializer(hosts, 0)
+	require.NoError(t, err)
+	require.Equal(t, 2, qi.quorum)
+	require.Equal(t, len(hosts), len(qi.clients))
+
+	qi, err = NewQuorumAPIInitializer(hosts, 3)
+	require.NoError(t, err)
+	require.Equal(t, 3, qi.quorum)
+
+	_, err = NewQuorumAPIInitializer(hosts, 4)
+	require.ErrorContains(t, "must be between 1 and the number of providers", err)
+	_, err = NewQuorumAPIInitializer(nil, 0)
+	require.ErrorContains(t, "at least one checkpoint provider is required", err)
+}
+
+func TestTallyFinalizedRoots(t *testing.T) {
+	a := [32]byte{'a'}
+	b := [32]byte{'b'}
+	unavailable := errors.New("unavailable")
+	tests := []struct {
+		name     string
+		votes    []rootVote
+		quorum   int
+		root     [32]byte
+		agreeing []int
+		err      string
+	}{
+		{
+			name:     "all agree",
+			votes:    []rootVote{{root: a}, {root: a}, {root: a}},
+			quorum:   2,
+			root:     a,
+			agreeing: []int{0, 1, 2},
+		},
+		{
+			name:     "single lagging provider is outvoted",
+			votes:    []rootVote{{root: a}, {root: b}, {root: a}},
+			quorum:   2,
+			root:     a,
+			agreeing: []int{0, 2},
+		},
+		{
+			name:   "single provider cannot choose the root",
+			votes:  []rootVote{{root: b}, {err: unavailable}, {err: unavailable}},
+			quorum: 2,
+			err:    "did not reach quorum",
+		},
+		{
+			name:   "failed providers do not count",
+			votes:  []rootVote{{root: a}, {root: a, err: unavailable}, {root: b}},
+			quorum: 2,
+			err:    "did not reach quorum",
+		},
+		{
+			name:   "two roots reaching quorum is ambiguous",
+			votes:  []rootVote{{root: a}, {root: a}, {root: b}, {root: b}},
+			quorum: 2,
+			err:    "did not reach quorum",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root, agreeing, err := tallyFinalizedRoots(tt.votes, tt.quorum)
+			if tt.err != "" {
+				require.ErrorContains(t, tt.err, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.root, root)
+			require.DeepEqual(t, tt.agreeing, agreeing)
+		})
+	}
+}
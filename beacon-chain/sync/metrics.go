@@ -82,6 +82,12 @@ var (
 			Buckets: []float64{5, 10, 50, 100, 150, 250, 500, 1000, 2000},
 		},
 	)
+	rpcBlocksByRangeHistoricalRequests = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "rpc_blocks_by_range_historical_requests",
+			Help: "The number of block range requests for finalized history currently being served",
+		},
+	)
 	arrivalBlockPropagationHistogram = promauto.NewHistogram(
 		prometheus.HistogramOpts{
 			Name:    "block_arrival_latency_milliseconds",
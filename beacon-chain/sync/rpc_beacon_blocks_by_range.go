@@ -2,10 +2,12 @@ package sync
 
 import (
 	"context"
+	"encoding/binary"
 	"time"
 
 	libp2pcore "github.com/libp2p/go-libp2p/core"
 	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/db/filters"
 	p2ptypes "github.com/prysmaticlabs/prysm/v3/beacon-chain/p2p/types"
 	"github.com/prysmaticlabs/prysm/v3/cmd/beacon-chain/flags"
@@ -13,8 +15,10 @@ import (
 	"github.com/prysmaticlabs/prysm/v3/consensus-types/blocks"
 	"github.com/prysmaticlabs/prysm/v3/consensus-types/interfaces"
 	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v3/monitoring/tracing"
 	pb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/time/slots"
 	"go.opencensus.io/trace"
 )
 
@@ -57,6 +61,18 @@ func (s *Service) beaconBlocksByRangeRPCHandler(ctx context.Context, msg interfa
 	// The final requested slot from remote peer.
 	endReqSlot := startSlot.Add(m.Step * (m.Count - 1))
 
+	// Requests for finalized history are served under a separate concurrency limit, so
+	// that peers syncing from far behind cannot starve requests close to the head.
+	if s.isHistoricalRangeRequest(endReqSlot) {
+		release, err := s.acquireHistoricalRangeSlot(ctx)
+		if err != nil {
+			s.writeErrorResponseToStream(responseCodeServerError, p2ptypes.ErrResourceUnavailable.Error(), stream)
+			tracing.AnnotateError(span, err)
+			return err
+		}
+		defer release()
+	}
+
 	blockLimiter, err := s.rateLimiter.topicCollector(string(stream.Protocol()))
 	if err != nil {
 		return err
@@ -120,84 +136,185 @@ func (s *Service) beaconBlocksByRangeRPCHandler(ctx context.Context, msg interfa
 	return nil
 }
 
+// blindedBlockBatchSize is the maximum number of blinded blocks held in memory while streaming a range, so
+// that their execution payloads are reconstructed in a single request to the execution client.
+const blindedBlockBatchSize = 64
+
+// writeBlockRangeToStream streams the canonical blocks in the given range to the remote peer. Blocks
+// are written one at a time in the SSZ form they are stored in the database, so that at most a single
+// full block per stream is held in memory. Blinded blocks are buffered up to blindedBlockBatchSize and
+// their payloads reconstructed in batches, the buffer being written before any following block to keep
+// the blocks in order. Writing to the stream blocks while the remote peer does not read from it, which
+// applies backpressure to the handler until the write deadline expires.
 func (s *Service) writeBlockRangeToStream(ctx context.Context, startSlot, endSlot types.Slot, step uint64,
 	prevRoot *[32]byte, stream libp2pcore.Stream) error {
 	ctx, span := trace.StartSpan(ctx, "sync.WriteBlockRangeToStream")
 	defer span.End()
 
 	filter := filters.NewFilter().SetStartSlot(startSlot).SetEndSlot(endSlot).SetSlotStep(step)
-	blks, roots, err := s.cfg.beaconDB.Blocks(ctx, filter)
+	roots, err := s.cfg.beaconDB.BlockRoots(ctx, filter)
 	if err != nil {
-		log.WithError(err).Debug("Could not retrieve blocks")
+		log.WithError(err).Debug("Could not retrieve block roots")
 		s.writeErrorResponseToStream(responseCodeServerError, p2ptypes.ErrGeneric.Error(), stream)
 		tracing.AnnotateError(span, err)
 		return err
 	}
 	// handle genesis case
 	if startSlot == 0 {
-		genBlock, genRoot, err := s.retrieveGenesisBlock(ctx)
+		genRoot, err := s.cfg.beaconDB.GenesisBlockRoot(ctx)
 		if err != nil {
-			log.WithError(err).Debug("Could not retrieve genesis block")
+			log.WithError(err).Debug("Could not retrieve genesis block root")
 			s.writeErrorResponseToStream(responseCodeServerError, p2ptypes.ErrGeneric.Error(), stream)
 			tracing.AnnotateError(span, err)
 			return err
 		}
-		blks = append([]interfaces.SignedBeaconBlock{genBlock}, blks...)
 		roots = append([][32]byte{genRoot}, roots...)
 	}
-	// Filter and sort our retrieved blocks, so that
-	// we only return valid sets of blocks.
-	blks, roots, err = s.dedupBlocksAndRoots(blks, roots)
-	if err != nil {
-		s.writeErrorResponseToStream(responseCodeServerError, p2ptypes.ErrGeneric.Error(), stream)
-		tracing.AnnotateError(span, err)
-		return err
-	}
-	blks, roots = s.sortBlocksAndRoots(blks, roots)
-
-	blks, err = s.filterBlocks(ctx, blks, roots, prevRoot, step, startSlot)
-	if err != nil && err != p2ptypes.ErrInvalidParent {
-		s.writeErrorResponseToStream(responseCodeServerError, p2ptypes.ErrGeneric.Error(), stream)
-		tracing.AnnotateError(span, err)
-		return err
-	}
 	start := time.Now()
-	// If the blocks are blinded, we reconstruct the full block via the execution client.
-	blindedExists := false
-	blindedIndex := 0
-	for i, b := range blks {
-		// Since the blocks are sorted in ascending order, we assume that the following
-		// blocks from the first blinded block are also ascending.
-		if b.IsBlinded() {
-			blindedExists = true
-			blindedIndex = i
-			break
+	blinded := make([]interfaces.SignedBeaconBlock, 0, blindedBlockBatchSize)
+	flushBlinded := func() error {
+		if err := s.writeBlindedBlocksToStream(ctx, blinded, stream); err != nil {
+			tracing.AnnotateError(span, err)
+			return err
 		}
+		blinded = blinded[:0]
+		return nil
 	}
-	if blindedExists {
-		reconstructedBlks, err := s.cfg.executionPayloadReconstructor.ReconstructFullBellatrixBlockBatch(ctx, blks[blindedIndex:])
+	// Roots are returned in ascending slot order, so we only need to
+	// skip duplicates in order to return a valid set of blocks.
+	seen := make(map[[32]byte]bool, len(roots))
+	for _, root := range roots {
+		if seen[root] {
+			continue
+		}
+		seen[root] = true
+		blk, err := s.cfg.beaconDB.BlockSSZ(ctx, root)
 		if err != nil {
-			log.WithError(err).Error("Could not reconstruct full bellatrix block batch from blinded bodies")
+			log.WithError(err).Debug("Could not retrieve block")
 			s.writeErrorResponseToStream(responseCodeServerError, p2ptypes.ErrGeneric.Error(), stream)
+			tracing.AnnotateError(span, err)
 			return err
 		}
-		copy(blks[blindedIndex:], reconstructedBlks)
-	}
-
-	for _, b := range blks {
-		if err := blocks.BeaconBlockIsNil(b); err != nil {
+		if blk == nil {
 			continue
 		}
-		if chunkErr := s.chunkBlockWriter(stream, b); chunkErr != nil {
-			log.WithError(chunkErr).Debug("Could not send a chunked response")
+		slot, parentRoot, err := encodedBlockSlotAndParent(blk.SSZ)
+		if err != nil {
 			s.writeErrorResponseToStream(responseCodeServerError, p2ptypes.ErrGeneric.Error(), stream)
-			tracing.AnnotateError(span, chunkErr)
-			return chunkErr
+			tracing.AnnotateError(span, err)
+			return err
+		}
+		ok, err := s.filterBlock(ctx, root, slot, parentRoot, prevRoot, step, startSlot)
+		if errors.Is(err, p2ptypes.ErrInvalidParent) {
+			// Return error in the event we have an invalid parent, after the valid blocks preceding it.
+			if flushErr := flushBlinded(); flushErr != nil {
+				return flushErr
+			}
+			rpcBlocksByRangeResponseLatency.Observe(float64(time.Since(start).Milliseconds()))
+			return err
+		}
+		if err != nil {
+			s.writeErrorResponseToStream(responseCodeServerError, p2ptypes.ErrGeneric.Error(), stream)
+			tracing.AnnotateError(span, err)
+			return err
+		}
+		if !ok {
+			continue
+		}
+		if blk.Blinded {
+			wsb, err := unmarshalBlindedBlock(blk)
+			if err != nil {
+				s.writeErrorResponseToStream(responseCodeServerError, p2ptypes.ErrGeneric.Error(), stream)
+				tracing.AnnotateError(span, err)
+				return err
+			}
+			blinded = append(blinded, wsb)
+			if len(blinded) == blindedBlockBatchSize {
+				if err := flushBlinded(); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+		if err := flushBlinded(); err != nil {
+			return err
+		}
+		if err := s.chunkEncodedBlockWriter(stream, blk); err != nil {
+			log.WithError(err).Debug("Could not send a chunked response")
+			s.writeErrorResponseToStream(responseCodeServerError, p2ptypes.ErrGeneric.Error(), stream)
+			tracing.AnnotateError(span, err)
+			return err
 		}
 	}
+	if err := flushBlinded(); err != nil {
+		return err
+	}
 	rpcBlocksByRangeResponseLatency.Observe(float64(time.Since(start).Milliseconds()))
-	// Return error in the event we have an invalid parent.
-	return err
+	return nil
+}
+
+// writeBlindedBlocksToStream reconstructs the full execution payloads of the blinded blocks in a single batch
+// via the execution client, and writes the full blocks to the stream.
+func (s *Service) writeBlindedBlocksToStream(ctx context.Context, blks []interfaces.SignedBeaconBlock, stream libp2pcore.Stream) error {
+	if len(blks) == 0 {
+		return nil
+	}
+	fullBlks, err := s.cfg.executionPayloadReconstructor.ReconstructFullBellatrixBlockBatch(ctx, blks)
+	if err != nil {
+		log.WithError(err).Error("Could not reconstruct full bellatrix block batch from blinded bodies")
+		s.writeErrorResponseToStream(responseCodeServerError, p2ptypes.ErrGeneric.Error(), stream)
+		return err
+	}
+	for _, b := range fullBlks {
+		if err := s.chunkBlockWriter(stream, b); err != nil {
+			log.WithError(err).Debug("Could not send a chunked response")
+			s.writeErrorResponseToStream(responseCodeServerError, p2ptypes.ErrGeneric.Error(), stream)
+			return err
+		}
+	}
+	return nil
+}
+
+// unmarshalBlindedBlock unmarshals a block which was stored blinded, its full execution payload has to be
+// reconstructed via the execution client before it is sent to peers.
+func unmarshalBlindedBlock(blk *db.EncodedBlock) (interfaces.SignedBeaconBlock, error) {
+	blindedBlk := &pb.SignedBlindedBeaconBlockBellatrix{}
+	if err := blindedBlk.UnmarshalSSZ(blk.SSZ); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal blinded block")
+	}
+	return blocks.NewSignedBeaconBlock(blindedBlk)
+}
+
+// isHistoricalRangeRequest returns true if the requested range ends before the finalized
+// checkpoint, meaning it is served entirely from finalized history.
+func (s *Service) isHistoricalRangeRequest(endSlot types.Slot) bool {
+	cp := s.cfg.chain.FinalizedCheckpt()
+	if cp == nil {
+		return false
+	}
+	finalizedSlot, err := slots.EpochStart(cp.Epoch)
+	if err != nil {
+		return false
+	}
+	return endSlot < finalizedSlot
+}
+
+// acquireHistoricalRangeSlot waits until a historical range request may be served, returning
+// a function which must be called once the request is done.
+func (s *Service) acquireHistoricalRangeSlot(ctx context.Context) (func(), error) {
+	if s.historicalRangeLimiter == nil {
+		return func() {}, nil
+	}
+	select {
+	case s.historicalRangeLimiter <- struct{}{}:
+	case <-ctx.Done():
+		return nil, errors.Wrap(ctx.Err(), "could not acquire historical block range slot")
+	}
+	rpcBlocksByRangeHistoricalRequests.Inc()
+	return func() {
+		rpcBlocksByRangeHistoricalRequests.Dec()
+		<-s.historicalRangeLimiter
+	}, nil
 }
 
 func (s *Service) validateRangeRequest(r *pb.BeaconBlocksByRangeRequest) error {
@@ -231,59 +348,55 @@ func (s *Service) validateRangeRequest(r *pb.BeaconBlocksByRangeRequest) error {
 	return nil
 }
 
-// filters all the provided blocks to ensure they are canonical
-// and are strictly linear.
-func (s *Service) filterBlocks(ctx context.Context, blks []interfaces.SignedBeaconBlock, roots [][32]byte, prevRoot *[32]byte,
-	step uint64, startSlot types.Slot) ([]interfaces.SignedBeaconBlock, error) {
-	if len(blks) != len(roots) {
-		return nil, errors.New("input blks and roots are diff lengths")
+// filterBlock determines whether the given block should be returned in a range response, ensuring
+// that returned blocks are canonical and strictly linear. The previous root is updated with the
+// root of every block which is accepted.
+func (s *Service) filterBlock(ctx context.Context, root [32]byte, slot types.Slot, parentRoot [32]byte, prevRoot *[32]byte,
+	step uint64, startSlot types.Slot) (bool, error) {
+	isCanonical, err := s.cfg.chain.IsCanonical(ctx, root)
+	if err != nil {
+		return false, err
+	}
+	parentValid := *prevRoot != [32]byte{}
+	isLinear := *prevRoot == parentRoot
+	isSingular := step == 1
+	slotDiff, err := slot.SafeSubSlot(startSlot)
+	if err != nil {
+		return false, err
+	}
+	slotDiff, err = slotDiff.SafeMod(step)
+	if err != nil {
+		return false, err
 	}
+	isRequestedSlotStep := slotDiff == 0
+	if !isRequestedSlotStep || !isCanonical {
+		return false, nil
+	}
+	// Exit early if our valid block is non linear.
+	if parentValid && isSingular && !isLinear {
+		return false, p2ptypes.ErrInvalidParent
+	}
+	// Set the previous root as the
+	// newly added block's root
+	*prevRoot = root
+	return true, nil
+}
 
-	newBlks := make([]interfaces.SignedBeaconBlock, 0, len(blks))
-	for i, b := range blks {
-		isCanonical, err := s.cfg.chain.IsCanonical(ctx, roots[i])
-		if err != nil {
-			return nil, err
-		}
-		parentValid := *prevRoot != [32]byte{}
-		isLinear := *prevRoot == b.Block().ParentRoot()
-		isSingular := step == 1
-		slotDiff, err := b.Block().Slot().SafeSubSlot(startSlot)
-		if err != nil {
-			return nil, err
-		}
-		slotDiff, err = slotDiff.SafeMod(step)
-		if err != nil {
-			return nil, err
-		}
-		isRequestedSlotStep := slotDiff == 0
-		if isRequestedSlotStep && isCanonical {
-			// Exit early if our valid block is non linear.
-			if parentValid && isSingular && !isLinear {
-				return newBlks, p2ptypes.ErrInvalidParent
-			}
-			newBlks = append(newBlks, blks[i])
-			// Set the previous root as the
-			// newly added block's root
-			currRoot := roots[i]
-			*prevRoot = currRoot
-		}
+// encodedBlockSlotAndParent reads the slot and parent root of a signed beacon block from its SSZ
+// encoding. All forks share the same layout for these fields: the signed block starts with the
+// offset of its message, which in turn starts with the slot, proposer index and parent root.
+func encodedBlockSlotAndParent(enc []byte) (types.Slot, [32]byte, error) {
+	if len(enc) < 4 {
+		return 0, [32]byte{}, errors.New("encoded block is too short")
+	}
+	offset := uint64(binary.LittleEndian.Uint32(enc[:4]))
+	if uint64(len(enc)) < offset+48 {
+		return 0, [32]byte{}, errors.New("encoded block is too short")
 	}
-	return newBlks, nil
+	slot := types.Slot(binary.LittleEndian.Uint64(enc[offset : offset+8]))
+	return slot, bytesutil.ToBytes32(enc[offset+16 : offset+48]), nil
 }
 
 func (s *Service) writeErrorResponseToStream(responseCode byte, reason string, stream libp2pcore.Stream) {
 	writeErrorResponseToStream(responseCode, reason, stream, s.cfg.p2p)
 }
-
-func (s *Service) retrieveGenesisBlock(ctx context.Context) (interfaces.SignedBeaconBlock, [32]byte, error) {
-	genBlock, err := s.cfg.beaconDB.GenesisBlock(ctx)
-	if err != nil {
-		return nil, [32]byte{}, err
-	}
-	genRoot, err := genBlock.Block().HashTreeRoot()
-	if err != nil {
-		return nil, [32]byte{}, err
-	}
-	return genBlock, genRoot, nil
-}
@@ -220,16 +220,19 @@ func TestRPCBeaconBlocksByRange_ReconstructsPayloads(t *testing.T) {
 	require.NoError(t, err)
 
 	genRoot := [32]byte{}
-	// Populate the database with blocks that would match the request.
+	prevRoot := [32]byte{}
+	// Populate the database with a chain of blocks that would match the request.
 	for i := req.StartSlot; i < req.StartSlot.Add(req.Step*req.Count); i += types.Slot(req.Step) {
 		blk := util.NewBlindedBeaconBlockBellatrix()
 		blk.Block.Slot = i
+		blk.Block.ParentRoot = bytesutil.SafeCopyBytes(prevRoot[:])
 		blk.Block.Body.ExecutionPayloadHeader = header
+		rt, err := blk.Block.HashTreeRoot()
+		require.NoError(t, err)
 		if i == 0 {
-			rt, err := blk.Block.HashTreeRoot()
-			require.NoError(t, err)
 			genRoot = rt
 		}
+		prevRoot = rt
 		util.SaveBlock(t, context.Background(), d, blk)
 	}
 	require.NoError(t, d.SaveGenesisBlockRoot(context.Background(), genRoot))
@@ -251,7 +254,7 @@ func TestRPCBeaconBlocksByRange_ReconstructsPayloads(t *testing.T) {
 	wg.Add(1)
 
 	// Use a new request to test this out
-	newReq := &ethpb.BeaconBlocksByRangeRequest{StartSlot: 0, Step: 1, Count: 1}
+	newReq := &ethpb.BeaconBlocksByRangeRequest{StartSlot: 0, Step: 1, Count: 3}
 
 	p2.BHost.SetStreamHandler(pcl, func(stream network.Stream) {
 		defer wg.Done()
@@ -259,15 +262,15 @@ func TestRPCBeaconBlocksByRange_ReconstructsPayloads(t *testing.T) {
 			expectSuccess(t, stream)
 			res := util.NewBeaconBlockBellatrix()
 			assert.NoError(t, r.cfg.p2p.Encoding().DecodeWithMaxLength(stream, res))
-			if res.Block.Slot.SubSlot(newReq.StartSlot).Mod(newReq.Step) != 0 {
-				t.Errorf("Received unexpected block slot %d", res.Block.Slot)
-			}
-			// Expect EOF
-			b := make([]byte, 1)
-			_, err := stream.Read(b)
-			require.ErrorContains(t, io.EOF.Error(), err)
+			assert.Equal(t, i, res.Block.Slot)
 		}
-		require.Equal(t, uint64(1), mockEngine.NumReconstructedPayloads)
+		// Expect EOF
+		b := make([]byte, 1)
+		_, err := stream.Read(b)
+		require.ErrorContains(t, io.EOF.Error(), err)
+		require.Equal(t, uint64(3), mockEngine.NumReconstructedPayloads)
+		// The payloads of the range are reconstructed in a single batch.
+		require.Equal(t, uint64(1), mockEngine.NumReconstructedBatches)
 	})
 
 	stream1, err := p1.BHost.NewStream(context.Background(), p2.BHost.ID(), pcl)
@@ -1103,11 +1106,76 @@ func TestRPCBeaconBlocksByRange_FilterBlocks_PreviousRoot(t *testing.T) {
 
 	initialRoot := [32]byte{}
 	ptrRt := &initialRoot
-	newBlks, err := r.filterBlocks(context.Background(), blks, roots, ptrRt, req.Step, req.StartSlot)
-	require.NoError(t, err)
+	newBlks := make([]interfaces.SignedBeaconBlock, 0, len(blks))
+	for i, b := range blks {
+		ok, err := r.filterBlock(context.Background(), roots[i], b.Block().Slot(), b.Block().ParentRoot(), ptrRt, req.Step, req.StartSlot)
+		require.NoError(t, err)
+		if ok {
+			newBlks = append(newBlks, b)
+		}
+	}
 	require.Equal(t, len(blks), len(newBlks))
 
 	// pointer should reference a new root.
 	require.NotEqual(t, *ptrRt, [32]byte{})
 
 }
+
+func TestRPCBeaconBlocksByRange_EncodedBlockSlotAndParent(t *testing.T) {
+	parentRoot := bytesutil.PadTo([]byte("parent"), fieldparams.RootLength)
+	phase0 := util.NewBeaconBlock()
+	phase0.Block.Slot = 10
+	phase0.Block.ParentRoot = parentRoot
+	altair := util.NewBeaconBlockAltair()
+	altair.Block.Slot = 20
+	altair.Block.ParentRoot = parentRoot
+	bellatrix := util.NewBeaconBlockBellatrix()
+	bellatrix.Block.Slot = 30
+	bellatrix.Block.ParentRoot = parentRoot
+	blinded := util.NewBlindedBeaconBlockBellatrix()
+	blinded.Block.Slot = 40
+	blinded.Block.ParentRoot = parentRoot
+
+	for _, blk := range []interface{}{phase0, altair, bellatrix, blinded} {
+		wsb, err := blocks.NewSignedBeaconBlock(blk)
+		require.NoError(t, err)
+		enc, err := wsb.MarshalSSZ()
+		require.NoError(t, err)
+		slot, root, err := encodedBlockSlotAndParent(enc)
+		require.NoError(t, err)
+		assert.Equal(t, wsb.Block().Slot(), slot)
+		assert.Equal(t, bytesutil.ToBytes32(parentRoot), root)
+	}
+
+	_, _, err := encodedBlockSlotAndParent([]byte{1, 2})
+	require.ErrorContains(t, "encoded block is too short", err)
+	_, _, err = encodedBlockSlotAndParent([]byte{100, 0, 0, 0, 1})
+	require.ErrorContains(t, "encoded block is too short", err)
+}
+
+func TestRPCBeaconBlocksByRange_HistoricalRangeLimit(t *testing.T) {
+	r := &Service{
+		cfg: &config{chain: &chainMock.ChainService{
+			FinalizedCheckPoint: &ethpb.Checkpoint{Epoch: 10},
+		}},
+		historicalRangeLimiter: make(chan struct{}, 1),
+	}
+	finalizedSlot, err := slots.EpochStart(10)
+	require.NoError(t, err)
+	assert.Equal(t, true, r.isHistoricalRangeRequest(finalizedSlot-1))
+	assert.Equal(t, false, r.isHistoricalRangeRequest(finalizedSlot))
+
+	release, err := r.acquireHistoricalRangeSlot(context.Background())
+	require.NoError(t, err)
+
+	// The limiter is full, so a second request waits until its context expires.
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = r.acquireHistoricalRangeSlot(ctx)
+	require.ErrorContains(t, "could not acquire historical block range slot", err)
+
+	release()
+	release, err = r.acquireHistoricalRangeSlot(context.Background())
+	require.NoError(t, err)
+	release()
+}
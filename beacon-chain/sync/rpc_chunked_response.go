@@ -3,8 +3,10 @@ package sync
 import (
 	libp2pcore "github.com/libp2p/go-libp2p/core"
 	"github.com/pkg/errors"
+	ssz "github.com/prysmaticlabs/fastssz"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/blockchain"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/signing"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/p2p/encoder"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/p2p/types"
@@ -23,15 +25,36 @@ func (s *Service) chunkBlockWriter(stream libp2pcore.Stream, blk interfaces.Sign
 	return WriteBlockChunk(stream, s.cfg.chain, s.cfg.p2p.Encoding(), blk)
 }
 
+// chunkEncodedBlockWriter writes a block that is already SSZ encoded, as read from the database,
+// as a chunked response to the given network stream.
+func (s *Service) chunkEncodedBlockWriter(stream libp2pcore.Stream, blk *db.EncodedBlock) error {
+	SetStreamWriteDeadline(stream, defaultWriteDuration)
+	return WriteEncodedBlockChunk(stream, s.cfg.chain, s.cfg.p2p.Encoding(), blk)
+}
+
 // WriteBlockChunk writes block chunk object to stream.
 // response_chunk  ::= <result> | <context-bytes> | <encoding-dependent-header> | <encoded-payload>
 func WriteBlockChunk(stream libp2pcore.Stream, chain blockchain.ChainInfoFetcher, encoding encoder.NetworkEncoding, blk interfaces.SignedBeaconBlock) error {
+	return writeBlockChunk(stream, chain, encoding, blk.Version(), blk)
+}
+
+// WriteEncodedBlockChunk writes an SSZ encoded block chunk to stream without unmarshaling the block.
+// Blinded blocks cannot be written this way, as peers expect the full execution payload.
+// response_chunk  ::= <result> | <context-bytes> | <encoding-dependent-header> | <encoded-payload>
+func WriteEncodedBlockChunk(stream libp2pcore.Stream, chain blockchain.ChainInfoFetcher, encoding encoder.NetworkEncoding, blk *db.EncodedBlock) error {
+	if blk.Blinded {
+		return errors.New("cannot write blinded block encoding to stream")
+	}
+	return writeBlockChunk(stream, chain, encoding, blk.Version, sszBytes(blk.SSZ))
+}
+
+func writeBlockChunk(stream libp2pcore.Stream, chain blockchain.ChainInfoFetcher, encoding encoder.NetworkEncoding, v int, msg ssz.Marshaler) error {
 	if _, err := stream.Write([]byte{responseCodeSuccess}); err != nil {
 		return err
 	}
 	var obtainedCtx []byte
 
-	switch blk.Version() {
+	switch v {
 	case version.Phase0:
 		valRoot := chain.GenesisValidatorsRoot()
 		digest, err := forks.ForkDigestFromEpoch(params.BeaconConfig().GenesisEpoch, valRoot[:])
@@ -58,10 +81,29 @@ func WriteBlockChunk(stream libp2pcore.Stream, chain blockchain.ChainInfoFetcher
 	if err := writeContextToStream(obtainedCtx, stream, chain); err != nil {
 		return err
 	}
-	_, err := encoding.EncodeWithMaxLength(stream, blk)
+	_, err := encoding.EncodeWithMaxLength(stream, msg)
 	return err
 }
 
+// sszBytes is an already SSZ encoded message, which lets it be handed
+// to a network encoder without being marshaled again.
+type sszBytes []byte
+
+// MarshalSSZ returns the underlying encoding.
+func (b sszBytes) MarshalSSZ() ([]byte, error) {
+	return b, nil
+}
+
+// MarshalSSZTo appends the underlying encoding to dst.
+func (b sszBytes) MarshalSSZTo(dst []byte) ([]byte, error) {
+	return append(dst, b...), nil
+}
+
+// SizeSSZ returns the length of the underlying encoding.
+func (b sszBytes) SizeSSZ() int {
+	return len(b)
+}
+
 // ReadChunkedBlock handles each response chunk that is sent by the
 // peer and converts it into a beacon block.
 func ReadChunkedBlock(stream libp2pcore.Stream, chain blockchain.ForkFetcher, p2p p2p.EncodingProvider, isFirstChunk bool) (interfaces.SignedBeaconBlock, error) {
@@ -10,11 +10,11 @@ import (
 )
 
 var (
-	// MevRelayEndpoint provides an HTTP access endpoint to a MEV builder network.
-	MevRelayEndpoint = &cli.StringFlag{
-		Name:  "http-mev-relay",
-		Usage: "A MEV builder relay string http endpoint, this wil be used to interact MEV builder network using API defined in: https://ethereum.github.io/builder-specs/#/Builder",
-		Value: "",
+	// MevRelayEndpoint provides HTTP access endpoints to MEV builder networks.
+	MevRelayEndpoint = &cli.StringSliceFlag{
+		Name: "http-mev-relay",
+		Usage: "A MEV builder relay string http endpoint, this wil be used to interact MEV builder network using API defined in: https://ethereum.github.io/builder-specs/#/Builder. " +
+			"Multiple relays can be given by repeating the flag or separating endpoints with commas, the highest valid bid among them is used",
 	}
 	MaxBuilderConsecutiveMissedSlots = &cli.IntFlag{
 		Name:  "max-builder-consecutive-missed-slots",
@@ -26,12 +26,65 @@ var (
 		Usage: "Number of total skip slot to fallback from using relay/builder to local execution engine for block construction in last epoch rolling window",
 		Value: 8,
 	}
+	MaxBuilderEpochsSinceFinality = &cli.Uint64Flag{
+		Name:  "max-builder-epochs-since-finality",
+		Usage: "Number of epochs since the finalized checkpoint to fallback from using relay/builder to local execution engine for block construction",
+		Value: 4,
+	}
+	MaxBuilderReorgDepth = &cli.Uint64Flag{
+		Name:  "max-builder-reorg-depth",
+		Usage: "Depth in slots of a chain reorg in the last epoch to fallback from using relay/builder to local execution engine for block construction",
+		Value: 2,
+	}
+	MaxBuilderRelayFailureRate = &cli.Uint64Flag{
+		Name:  "max-builder-relay-failure-rate",
+		Usage: "Percentage of recent failed relay calls to fallback from using relay/builder to local execution engine for block construction",
+		Value: 50,
+	}
+	// LocalBlockValueBoost is the percentage the local block value is boosted by before comparing it with the builder bid.
+	LocalBlockValueBoost = &cli.Uint64Flag{
+		Name: "local-block-value-boost",
+		Usage: "A percentage added to the value of the locally built execution payload before comparing it with the builder bid, " +
+			"the builder bid is only used when it is worth more. It has no effect when the execution client does not report block values",
+		Value: 0,
+	}
+	// MinBuilderBid is the minimum value of a builder bid for it to be used.
+	MinBuilderBid = &cli.Uint64Flag{
+		Name:  "min-builder-bid",
+		Usage: "The minimum value in gwei of a builder bid, lower bids are skipped in favor of the locally built execution payload",
+		Value: 0,
+	}
+	// ReorgHeadWeightThreshold is the committee weight percentage below which a late head block is reorged.
+	ReorgHeadWeightThreshold = &cli.Uint64Flag{
+		Name:  "reorg-head-weight-threshold",
+		Usage: "Percentage of the committee weight below which a late head block is reorged by the next proposer",
+		Value: 20,
+	}
+	// ReorgParentWeightThreshold is the committee weight percentage the parent of a reorged late head block must exceed.
+	ReorgParentWeightThreshold = &cli.Uint64Flag{
+		Name:  "reorg-parent-weight-threshold",
+		Usage: "Percentage of the committee weight the parent of a late head block must exceed for the next proposer to reorg the head",
+		Value: 160,
+	}
+	// ReorgMaxEpochsSinceFinalization is the number of epochs since finalization after which late head blocks are not reorged.
+	ReorgMaxEpochsSinceFinalization = &cli.Uint64Flag{
+		Name:  "reorg-max-epochs-since-finalization",
+		Usage: "Number of epochs since the finalized checkpoint after which late head blocks are no longer reorged by the next proposer",
+		Value: 2,
+	}
 	// ExecutionEngineEndpoint provides an HTTP access endpoint to connect to an execution client on the execution layer
 	ExecutionEngineEndpoint = &cli.StringFlag{
 		Name:  "execution-endpoint",
 		Usage: "An execution client http endpoint. Can contain auth header as well in the format",
 		Value: "http://localhost:8551",
 	}
+	// FallbackExecutionEngineEndpoints provides ordered HTTP access endpoints to execution clients the node fails over to.
+	FallbackExecutionEngineEndpoints = &cli.StringSliceFlag{
+		Name: "fallback-execution-endpoint",
+		Usage: "An execution client http endpoint to fail over to when the execution endpoint is unavailable, syncing or not " +
+			"responding to engine API calls. Multiple endpoints can be given by repeating the flag, and are tried in order. " +
+			"The same JWT secret is used to authenticate with all of them",
+	}
 	// ExecutionEngineHeaders defines a list of HTTP headers to send with all execution client requests.
 	ExecutionEngineHeaders = &cli.StringFlag{
 		Name: "execution-headers",
@@ -167,6 +220,14 @@ var (
 		Usage: "The factor by which block batch limit may increase on burst.",
 		Value: 2,
 	}
+	// HistoricalBlockRangeRequestLimit specifies the number of concurrent block range requests
+	// for finalized history that the node will serve.
+	HistoricalBlockRangeRequestLimit = &cli.IntFlag{
+		Name: "historical-block-range-request-limit",
+		Usage: "The maximum number of block range requests for slots before the finalized checkpoint that " +
+			"are served concurrently. Further requests wait for a free slot. A value of 0 removes the limit.",
+		Value: 8,
+	}
 	// EnableDebugRPCEndpoints as /v1/beacon/state.
 	EnableDebugRPCEndpoints = &cli.BoolFlag{
 		Name:  "enable-debug-rpc-endpoints",
@@ -13,6 +13,8 @@ type GlobalFlags struct {
 	MinimumPeersPerSubnet      int
 	BlockBatchLimit            int
 	BlockBatchLimitBurstFactor int
+
+	HistoricalBlockRangeRequestLimit int
 }
 
 var globalConfig *GlobalFlags
@@ -40,6 +42,7 @@ func ConfigureGlobalFlags(ctx *cli.Context) {
 	}
 	cfg.BlockBatchLimit = ctx.Int(BlockBatchLimit.Name)
 	cfg.BlockBatchLimitBurstFactor = ctx.Int(BlockBatchLimitBurstFactor.Name)
+	cfg.HistoricalBlockRangeRequestLimit = ctx.Int(HistoricalBlockRangeRequestLimit.Name)
 	cfg.MinimumPeersPerSubnet = ctx.Int(MinPeersPerSubnet.Name)
 	configureMinimumPeers(ctx, cfg)
 
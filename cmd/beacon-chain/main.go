@@ -38,6 +38,7 @@ import (
 var appFlags = []cli.Flag{
 	flags.DepositContractFlag,
 	flags.ExecutionEngineEndpoint,
+	flags.FallbackExecutionEngineEndpoints,
 	flags.ExecutionEngineHeaders,
 	flags.HTTPWeb3ProviderFlag,
 	flags.ExecutionJWTSecretFlag,
@@ -55,6 +56,7 @@ var appFlags = []cli.Flag{
 	flags.SetGCPercent,
 	flags.BlockBatchLimit,
 	flags.BlockBatchLimitBurstFactor,
+	flags.HistoricalBlockRangeRequestLimit,
 	flags.InteropMockEth1DataVotesFlag,
 	flags.InteropGenesisStateFlag,
 	flags.InteropNumValidatorsFlag,
@@ -75,6 +77,14 @@ var appFlags = []cli.Flag{
 	flags.MevRelayEndpoint,
 	flags.MaxBuilderEpochMissedSlots,
 	flags.MaxBuilderConsecutiveMissedSlots,
+	flags.MaxBuilderEpochsSinceFinality,
+	flags.MaxBuilderReorgDepth,
+	flags.MaxBuilderRelayFailureRate,
+	flags.LocalBlockValueBoost,
+	flags.MinBuilderBid,
+	flags.ReorgHeadWeightThreshold,
+	flags.ReorgParentWeightThreshold,
+	flags.ReorgMaxEpochsSinceFinalization,
 	flags.EngineEndpointTimeoutSeconds,
 	cmd.BackupWebhookOutputDir,
 	cmd.MinimalConfigFlag,
@@ -128,6 +138,7 @@ var appFlags = []cli.Flag{
 	checkpoint.BlockPath,
 	checkpoint.StatePath,
 	checkpoint.RemoteURL,
+	checkpoint.Quorum,
 	genesis.StatePath,
 	genesis.BeaconAPIURL,
 }
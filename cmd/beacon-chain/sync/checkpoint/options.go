@@ -22,12 +22,19 @@ var (
 		Usage: "Rather than syncing from genesis, you can start processing from a ssz-serialized BeaconState+Block." +
 			" This flag allows you to specify a local file containing the checkpoint Block to load.",
 	}
-	RemoteURL = &cli.StringFlag{
+	RemoteURL = &cli.StringSliceFlag{
 		Name: "checkpoint-sync-url",
 		Usage: "URL of a synced beacon node to trust in obtaining checkpoint sync data. " +
+			"This flag can be repeated to use several providers, see --checkpoint-sync-quorum. " +
 			"As an additional safety measure, it is strongly recommended to only use this option in conjunction with " +
 			"--weak-subjectivity-checkpoint flag",
 	}
+	// Quorum is the number of checkpoint providers which must agree on the finalized block root.
+	Quorum = &cli.IntFlag{
+		Name: "checkpoint-sync-quorum",
+		Usage: "The number of --checkpoint-sync-url providers which must report the same finalized block root " +
+			"before the origin state is downloaded. Defaults to a majority of the providers.",
+	}
 )
 
 // BeaconNodeOptions is responsible for determining if the checkpoint sync options have been used, and if so,
@@ -36,11 +43,22 @@ var (
 func BeaconNodeOptions(c *cli.Context) (node.Option, error) {
 	blockPath := c.Path(BlockPath.Name)
 	statePath := c.Path(StatePath.Name)
-	remoteURL := c.String(RemoteURL.Name)
-	if remoteURL != "" {
+	remoteURLs := c.StringSlice(RemoteURL.Name)
+	quorum := c.Int(Quorum.Name)
+	if len(remoteURLs) > 1 || quorum > 1 {
+		return func(node *node.BeaconNode) error {
+			var err error
+			node.CheckpointInitializer, err = checkpoint.NewQuorumAPIInitializer(remoteURLs, quorum)
+			if err != nil {
+				return errors.Wrap(err, "error while constructing beacon node api clients for checkpoint sync")
+			}
+			return nil
+		}, nil
+	}
+	if len(remoteURLs) == 1 {
 		return func(node *node.BeaconNode) error {
 			var err error
-			node.CheckpointInitializer, err = checkpoint.NewAPIInitializer(remoteURL)
+			node.CheckpointInitializer, err = checkpoint.NewAPIInitializer(remoteURLs[0])
 			if err != nil {
 				return errors.Wrap(err, "error while constructing beacon node api client for checkpoint sync")
 			}
@@ -107,6 +107,7 @@ var appHelpFlagGroups = []flagGroup{
 			flags.GRPCGatewayPort,
 			flags.GPRCGatewayCorsDomain,
 			flags.ExecutionEngineEndpoint,
+			flags.FallbackExecutionEngineEndpoints,
 			flags.ExecutionEngineHeaders,
 			flags.HTTPWeb3ProviderFlag,
 			flags.ExecutionJWTSecretFlag,
@@ -114,6 +115,7 @@ var appHelpFlagGroups = []flagGroup{
 			flags.SlotsPerArchivedPoint,
 			flags.BlockBatchLimit,
 			flags.BlockBatchLimitBurstFactor,
+			flags.HistoricalBlockRangeRequestLimit,
 			flags.EnableDebugRPCEndpoints,
 			flags.SubscribeToAllSubnets,
 			flags.HistoricalSlasherNode,
@@ -125,10 +127,19 @@ var appHelpFlagGroups = []flagGroup{
 			flags.MevRelayEndpoint,
 			flags.MaxBuilderEpochMissedSlots,
 			flags.MaxBuilderConsecutiveMissedSlots,
+			flags.MaxBuilderEpochsSinceFinality,
+			flags.MaxBuilderReorgDepth,
+			flags.MaxBuilderRelayFailureRate,
+			flags.LocalBlockValueBoost,
+			flags.MinBuilderBid,
+			flags.ReorgHeadWeightThreshold,
+			flags.ReorgParentWeightThreshold,
+			flags.ReorgMaxEpochsSinceFinalization,
 			flags.EngineEndpointTimeoutSeconds,
 			checkpoint.BlockPath,
 			checkpoint.StatePath,
 			checkpoint.RemoteURL,
+			checkpoint.Quorum,
 			genesis.StatePath,
 			genesis.BeaconAPIURL,
 		},
@@ -0,0 +1,187 @@
+package attpacking
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/transition"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/rpc/prysm/v1alpha1/validator"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v3/cmd/prysmctl/beacondb"
+	"github.com/prysmaticlabs/prysm/v3/consensus-types/interfaces"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/runtime/version"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+var benchmarkFlags = struct {
+	DataDir   string
+	StartSlot uint64
+	EndSlot   uint64
+	Lookahead uint64
+}{}
+
+var benchmarkCmd = &cli.Command{
+	Name:  "benchmark",
+	Usage: "Replay the blocks of a beacon node database, and compare the proposer reward earned by the attestations of each block with the reward of the attestations this node would pack from the same candidates. The beacon node must be stopped to open its database.",
+	Action: func(cliCtx *cli.Context) error {
+		return cliActionBenchmark(cliCtx.Context)
+	},
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:        "datadir",
+			Usage:       "data directory of the beacon node, containing the beacon chain database",
+			Destination: &benchmarkFlags.DataDir,
+			Required:    true,
+		},
+		&cli.Uint64Flag{
+			Name:        "start-slot",
+			Usage:       "first slot to replay, blocks before the altair fork are skipped",
+			Destination: &benchmarkFlags.StartSlot,
+		},
+		&cli.Uint64Flag{
+			Name:        "end-slot",
+			Usage:       "last slot to replay. default: the slot of the head block",
+			Destination: &benchmarkFlags.EndSlot,
+		},
+		&cli.Uint64Flag{
+			Name:        "lookahead",
+			Usage:       "number of slots following a block whose blocks' attestations are candidates too, approximating the attestation pool of its proposer",
+			Value:       2,
+			Destination: &benchmarkFlags.Lookahead,
+		},
+	},
+}
+
+func cliActionBenchmark(ctx context.Context) error {
+	f := benchmarkFlags
+	d, err := beacondb.Open(ctx, f.DataDir)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := d.Close(); err != nil {
+			log.WithError(err).Error("Could not close beacon chain database")
+		}
+	}()
+
+	start := types.Slot(f.StartSlot)
+	end := types.Slot(f.EndSlot)
+	if f.EndSlot == 0 {
+		end = types.Slot(^uint64(0))
+	}
+	if end < start {
+		return fmt.Errorf("end slot %d is before start slot %d", end, start)
+	}
+	lookahead := types.Slot(f.Lookahead)
+	loadEnd := end.Add(uint64(lookahead))
+	if loadEnd < end {
+		loadEnd = types.Slot(^uint64(0))
+	}
+	st, blks, err := beacondb.LoadStateAndBlocks(ctx, d, start, loadEnd)
+	if err != nil {
+		return err
+	}
+	results, err := benchmark(ctx, st, blks, start, end, lookahead)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("====Attestation Packing Report====")
+	fmt.Println()
+	fmt.Print(report(results))
+	return nil
+}
+
+// blockResult compares the attestations included in a block with the attestations packed from the same
+// candidates. Rewards are the proposer rewards earned by the attestations, in Gwei.
+type blockResult struct {
+	slot           types.Slot
+	candidates     int
+	includedCount  int
+	includedReward uint64
+	packedCount    int
+	packedReward   uint64
+	packingTime    time.Duration
+}
+
+// benchmark replays the blocks, in ascending slot order, on top of the state the first of them is applied to.
+// For each block between the start and end slots, the attestations it includes and the attestations of the
+// blocks of the lookahead slots following it are packed on the state the block is applied to, and the reward
+// of the packed attestations is compared with the reward of the attestations the block includes.
+func benchmark(
+	ctx context.Context,
+	st state.BeaconState,
+	blks []interfaces.SignedBeaconBlock,
+	start, end, lookahead types.Slot,
+) ([]*blockResult, error) {
+	var results []*blockResult
+	for i, b := range blks {
+		slot := b.Block().Slot()
+		if slot > end {
+			break
+		}
+		if slot <= st.Slot() {
+			return nil, fmt.Errorf("block at slot %d does not follow state at slot %d", slot, st.Slot())
+		}
+		var err error
+		st, err = transition.ProcessSlots(ctx, st, slot)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not process slots up to %d", slot)
+		}
+		if slot >= start && st.Version() >= version.Altair {
+			res, err := benchmarkBlock(ctx, st, blks[i:], lookahead)
+			if err != nil {
+				return nil, errors.Wrapf(err, "could not benchmark block at slot %d", slot)
+			}
+			results = append(results, res)
+		}
+		_, st, err = transition.ProcessBlockNoVerifyAnySig(ctx, st, b)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not process block at slot %d", slot)
+		}
+	}
+	return results, nil
+}
+
+// benchmarkBlock packs the attestations of the first block and of the blocks of the lookahead slots following
+// it, on the state the first block is applied to.
+func benchmarkBlock(ctx context.Context, st state.BeaconState, blks []interfaces.SignedBeaconBlock, lookahead types.Slot) (*blockResult, error) {
+	slot := blks[0].Block().Slot()
+	included := blks[0].Block().Body().Attestations()
+	var candidates []*ethpb.Attestation
+	for _, b := range blks {
+		if b.Block().Slot() > slot.Add(uint64(lookahead)) {
+			break
+		}
+		candidates = append(candidates, b.Block().Body().Attestations()...)
+	}
+
+	includedReward, err := validator.AttestationsReward(ctx, st, included)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute reward of included attestations")
+	}
+	packingStart := time.Now()
+	packed, err := validator.PackAttestations(ctx, st, candidates)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not pack attestations")
+	}
+	packingTime := time.Since(packingStart)
+	packedReward, err := validator.AttestationsReward(ctx, st, packed)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute reward of packed attestations")
+	}
+	return &blockResult{
+		slot:           slot,
+		candidates:     len(candidates),
+		includedCount:  len(included),
+		includedReward: includedReward,
+		packedCount:    len(packed),
+		packedReward:   packedReward,
+		packingTime:    packingTime,
+	}, nil
+}
@@ -0,0 +1,104 @@
+package attpacking
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/altair"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/transition"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/state"
+	consensusblocks "github.com/prysmaticlabs/prysm/v3/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v3/consensus-types/interfaces"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+	"github.com/prysmaticlabs/prysm/v3/testing/util"
+)
+
+func applyBlock(t *testing.T, st state.BeaconState, b *ethpb.SignedBeaconBlockAltair) (state.BeaconState, interfaces.SignedBeaconBlock) {
+	wb, err := consensusblocks.NewSignedBeaconBlock(b)
+	require.NoError(t, err)
+	st, err = transition.ProcessSlots(context.Background(), st.Copy(), b.Block.Slot)
+	require.NoError(t, err)
+	_, st, err = transition.ProcessBlockNoVerifyAnySig(context.Background(), st, wb)
+	require.NoError(t, err)
+	return st, wb
+}
+
+func TestBenchmark(t *testing.T) {
+	genesis, privs := util.DeterministicGenesisStateAltair(t, 64)
+	syncCommittee, err := altair.NextSyncCommittee(context.Background(), genesis)
+	require.NoError(t, err)
+	require.NoError(t, genesis.SetCurrentSyncCommittee(syncCommittee))
+	noAtts := util.DefaultBlockGenConfig()
+	noAtts.NumAttestations = 0
+
+	b1, err := util.GenerateFullBlockAltair(genesis, privs, noAtts, 1)
+	require.NoError(t, err)
+	st1, wb1 := applyBlock(t, genesis, b1)
+	// The attestation of slot 1 is left out of the block of slot 2 and included in the block of slot 3.
+	withAtt, err := util.GenerateFullBlockAltair(st1, privs, &util.BlockGenConfig{NumAttestations: 1}, 2)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(withAtt.Block.Body.Attestations))
+	att := withAtt.Block.Body.Attestations[0]
+	b2, err := util.GenerateFullBlockAltair(st1, privs, noAtts, 2)
+	require.NoError(t, err)
+	st2, wb2 := applyBlock(t, st1, b2)
+	b3, err := util.GenerateFullBlockAltair(st2, privs, noAtts, 3)
+	require.NoError(t, err)
+	b3.Block.Body.Attestations = []*ethpb.Attestation{att}
+	_, wb3 := applyBlock(t, st2, b3)
+	blks := []interfaces.SignedBeaconBlock{wb1, wb2, wb3}
+
+	t.Run("lookahead", func(t *testing.T) {
+		results, err := benchmark(context.Background(), genesis.Copy(), blks, 0, types.Slot(^uint64(0)), 1)
+		require.NoError(t, err)
+		require.Equal(t, 3, len(results))
+		assert.Equal(t, types.Slot(1), results[0].slot)
+		assert.Equal(t, uint64(0), results[0].packedReward)
+
+		assert.Equal(t, 1, results[1].candidates)
+		assert.Equal(t, 0, results[1].includedCount)
+		assert.Equal(t, uint64(0), results[1].includedReward)
+		assert.Equal(t, 1, results[1].packedCount)
+
+		assert.Equal(t, 1, results[2].includedCount)
+		assert.NotEqual(t, uint64(0), results[2].includedReward)
+		assert.Equal(t, results[2].includedReward, results[2].packedReward)
+		// Included one slot late, the attestation no longer earns the timely head reward.
+		assert.Equal(t, true, results[1].packedReward > results[2].includedReward)
+	})
+	t.Run("range without lookahead", func(t *testing.T) {
+		results, err := benchmark(context.Background(), genesis.Copy(), blks, 2, 2, 0)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(results))
+		assert.Equal(t, types.Slot(2), results[0].slot)
+		assert.Equal(t, 0, results[0].candidates)
+		assert.Equal(t, uint64(0), results[0].packedReward)
+	})
+	t.Run("block before state", func(t *testing.T) {
+		_, err := benchmark(context.Background(), st2, blks, 0, 3, 0)
+		assert.ErrorContains(t, "does not follow state at slot 2", err)
+	})
+}
+
+func TestReport(t *testing.T) {
+	assert.Equal(t, "No altair blocks in range\n", report(nil))
+
+	out := report([]*blockResult{
+		{slot: 10, candidates: 5, includedCount: 2, includedReward: 100, packedCount: 2, packedReward: 150, packingTime: 2 * time.Millisecond},
+		{slot: 11, candidates: 3, includedCount: 1, includedReward: 100, packedCount: 1, packedReward: 50, packingTime: time.Millisecond},
+		{slot: 12},
+	})
+	lines := strings.Split(out, "\n")
+	assert.Equal(t, "Slot  Candidates  Included  Included reward  Packed  Packed reward  Difference  Packing time", lines[0])
+	assert.Equal(t, "10    5           2         100              2       150            +50.00%     2ms", lines[1])
+	assert.Equal(t, "11    3           1         100              1       50             -50.00%     1ms", lines[2])
+	assert.Equal(t, "12    0           0         0                0       0              0.00%       0s", lines[3])
+	assert.Equal(t, true, strings.Contains(out, "Blocks: 3, packed reward higher in 1, lower in 1\n"))
+	assert.Equal(t, true, strings.Contains(out, "Total packed reward:   200 Gwei (+0.00%)\n"))
+	assert.Equal(t, true, strings.Contains(out, "Max packing time:      2ms\n"))
+}
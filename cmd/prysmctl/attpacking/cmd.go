@@ -0,0 +1,13 @@
+package attpacking
+
+import "github.com/urfave/cli/v2"
+
+var Commands = []*cli.Command{
+	{
+		Name:  "attestation-packing",
+		Usage: "commands for debugging the packing of attestations in blocks",
+		Subcommands: []*cli.Command{
+			benchmarkCmd,
+		},
+	},
+}
@@ -0,0 +1,58 @@
+package attpacking
+
+import (
+	"bytes"
+	"fmt"
+	"text/tabwriter"
+	"time"
+)
+
+// report renders a line per block comparing the included and packed attestations, followed by a summary.
+func report(results []*blockResult) string {
+	var buf bytes.Buffer
+	if len(results) == 0 {
+		fmt.Fprintln(&buf, "No altair blocks in range")
+		return buf.String()
+	}
+	w := tabwriter.NewWriter(&buf, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "Slot\tCandidates\tIncluded\tIncluded reward\tPacked\tPacked reward\tDifference\tPacking time")
+	var includedTotal, packedTotal uint64
+	var better, worse int
+	var maxPackingTime time.Duration
+	for _, r := range results {
+		includedTotal += r.includedReward
+		packedTotal += r.packedReward
+		switch {
+		case r.packedReward > r.includedReward:
+			better++
+		case r.packedReward < r.includedReward:
+			worse++
+		}
+		if r.packingTime > maxPackingTime {
+			maxPackingTime = r.packingTime
+		}
+		fmt.Fprintf(w, "%d\t%d\t%d\t%d\t%d\t%d\t%s\t%s\n",
+			r.slot, r.candidates, r.includedCount, r.includedReward, r.packedCount, r.packedReward,
+			rewardDifference(r.includedReward, r.packedReward), r.packingTime.Round(time.Microsecond))
+	}
+	if err := w.Flush(); err != nil {
+		return err.Error()
+	}
+	fmt.Fprintln(&buf)
+	fmt.Fprintf(&buf, "Blocks: %d, packed reward higher in %d, lower in %d\n", len(results), better, worse)
+	fmt.Fprintf(&buf, "Total included reward: %d Gwei\n", includedTotal)
+	fmt.Fprintf(&buf, "Total packed reward:   %d Gwei (%s)\n", packedTotal, rewardDifference(includedTotal, packedTotal))
+	fmt.Fprintf(&buf, "Max packing time:      %s\n", maxPackingTime.Round(time.Microsecond))
+	return buf.String()
+}
+
+// rewardDifference formats the difference of the packed reward relative to the included reward.
+func rewardDifference(included, packed uint64) string {
+	if included == 0 {
+		if packed == 0 {
+			return "0.00%"
+		}
+		return "+inf"
+	}
+	return fmt.Sprintf("%+.2f%%", (float64(packed)-float64(included))/float64(included)*100)
+}
@@ -0,0 +1,66 @@
+// Package beacondb provides access to the database of a stopped beacon node, for the commands analyzing the
+// chain it contains.
+package beacondb
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/db/iface"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v3/consensus-types/interfaces"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v3/io/file"
+)
+
+// Open opens the beacon chain database in the data directory of a beacon node. The beacon node must be stopped.
+func Open(ctx context.Context, datadir string) (*kv.Store, error) {
+	dbDir := filepath.Join(datadir, kv.BeaconNodeDbDirName)
+	if !file.FileExists(kv.KVStoreDatafilePath(dbDir)) {
+		return nil, fmt.Errorf("no beacon chain database found in %s", dbDir)
+	}
+	d, err := kv.NewKVStore(ctx, dbDir)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not open beacon chain database")
+	}
+	return d, nil
+}
+
+// LoadStateAndBlocks walks the canonical chain back from the head block, and returns the canonical blocks up to the
+// end slot which follow the latest saved state preceding the start slot, along with this state.
+func LoadStateAndBlocks(ctx context.Context, d iface.HeadAccessDatabase, start, end types.Slot) (state.BeaconState, []interfaces.SignedBeaconBlock, error) {
+	blk, err := d.HeadBlock(ctx)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not get head block")
+	}
+	var blks []interfaces.SignedBeaconBlock
+	for blk != nil && !blk.IsNil() {
+		root, err := blk.Block().HashTreeRoot()
+		if err != nil {
+			return nil, nil, err
+		}
+		slot := blk.Block().Slot()
+		if (slot < start || slot == 0) && d.HasState(ctx, root) {
+			st, err := d.State(ctx, root)
+			if err != nil {
+				return nil, nil, errors.Wrapf(err, "could not get state of block %#x", root)
+			}
+			// The blocks were collected from the head, they are replayed from the state onwards.
+			for i, j := 0, len(blks)-1; i < j; i, j = i+1, j-1 {
+				blks[i], blks[j] = blks[j], blks[i]
+			}
+			return st.Copy(), blks, nil
+		}
+		if slot <= end {
+			blks = append(blks, blk)
+		}
+		blk, err = d.Block(ctx, blk.Block().ParentRoot())
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "could not get parent of block at slot %d", slot)
+		}
+	}
+	return nil, nil, fmt.Errorf("could not find a saved state preceding slot %d, the database may not contain the blocks of this range", start)
+}
@@ -0,0 +1,163 @@
+package eth1voting
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/cache/depositcache"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/db/iface"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/rpc/prysm/v1alpha1/validator"
+	"github.com/prysmaticlabs/prysm/v3/cmd/prysmctl/beacondb"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/time/slots"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+var analyzeFlags = struct {
+	DataDir           string
+	StartSlot         uint64
+	EndSlot           uint64
+	ExecutionEndpoint string
+}{}
+
+var analyzeCmd = &cli.Command{
+	Name:  "analyze",
+	Usage: "Report the eth1 data votes of each voting period from the blocks of a beacon node database, and what this node would vote for. The beacon node must be stopped to open its database.",
+	Action: func(cliCtx *cli.Context) error {
+		return cliActionAnalyze(cliCtx.Context)
+	},
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:        "datadir",
+			Usage:       "data directory of the beacon node, containing the beacon chain database",
+			Destination: &analyzeFlags.DataDir,
+			Required:    true,
+		},
+		&cli.Uint64Flag{
+			Name:        "start-slot",
+			Usage:       "first slot to analyze, the whole voting period including the slot is reported",
+			Destination: &analyzeFlags.StartSlot,
+		},
+		&cli.Uint64Flag{
+			Name:        "end-slot",
+			Usage:       "last slot to analyze. default: the slot of the head block",
+			Destination: &analyzeFlags.EndSlot,
+		},
+		&cli.StringFlag{
+			Name:        "execution-endpoint",
+			Usage:       "http endpoint of an execution client JSON-RPC API, used to compute the vote of this node in each period",
+			Destination: &analyzeFlags.ExecutionEndpoint,
+		},
+	},
+}
+
+func cliActionAnalyze(ctx context.Context) error {
+	f := analyzeFlags
+	d, err := beacondb.Open(ctx, f.DataDir)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := d.Close(); err != nil {
+			log.WithError(err).Error("Could not close beacon chain database")
+		}
+	}()
+
+	start := votingPeriodStart(types.Slot(f.StartSlot))
+	end := types.Slot(f.EndSlot)
+	if f.EndSlot == 0 {
+		end = types.Slot(^uint64(0))
+	}
+	if end < start {
+		return fmt.Errorf("end slot %d is before start slot %d", end, start)
+	}
+	st, blks, err := beacondb.LoadStateAndBlocks(ctx, d, start, end)
+	if err != nil {
+		return err
+	}
+	genesisTime := st.GenesisTime()
+	periods, err := tallyVotes(ctx, st, blks, start)
+	if err != nil {
+		return err
+	}
+	if f.ExecutionEndpoint != "" {
+		if err := computeOwnVotes(ctx, d, f.ExecutionEndpoint, genesisTime, periods); err != nil {
+			return err
+		}
+	}
+
+	fmt.Println("====Eth1Data Voting Report====")
+	fmt.Println()
+	for _, p := range periods {
+		fmt.Println(p)
+	}
+	return nil
+}
+
+// computeOwnVotes computes the vote of this node at the start of each voting period, from the deposits saved in
+// the database and the execution client at the endpoint.
+func computeOwnVotes(ctx context.Context, d iface.ReadOnlyDatabase, endpoint string, genesisTime uint64, periods []*votingPeriod) error {
+	fetcher, err := newBlockFetcher(ctx, endpoint)
+	if err != nil {
+		return err
+	}
+	deposits, chainStartEth1Data, err := loadDeposits(ctx, d)
+	if err != nil {
+		return err
+	}
+	for _, p := range periods {
+		p.ownVote, p.ownVoteErr = validator.Eth1DataMajorityVote(
+			ctx,
+			fetcher,
+			deposits,
+			slots.VotingPeriodStartTime(genesisTime, p.startSlot),
+			p.startEth1Data,
+			chainStartEth1Data,
+		)
+	}
+	return nil
+}
+
+// loadDeposits builds the deposit cache of the beacon node from the execution chain data saved in the database.
+func loadDeposits(ctx context.Context, d iface.ReadOnlyDatabase) (*depositcache.DepositCache, *ethpb.Eth1Data, error) {
+	chainData, err := d.ExecutionChainData(ctx)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not get execution chain data")
+	}
+	if chainData == nil {
+		return nil, nil, errors.New("no execution chain data found in database")
+	}
+	cache, err := depositcache.New()
+	if err != nil {
+		return nil, nil, err
+	}
+	ctrs := chainData.DepositContainers
+	// The deposits of a node started from a deposit snapshot start after the snapshot.
+	if len(ctrs) == 0 || ctrs[0].Index > 0 {
+		snapshot, err := d.DepositSnapshot(ctx)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "could not get deposit snapshot")
+		}
+		if snapshot != nil {
+			if err := cache.InsertDepositSnapshot(ctx, snapshot); err != nil {
+				return nil, nil, errors.Wrap(err, "could not insert deposit snapshot")
+			}
+			filtered := make([]*ethpb.DepositContainer, 0, len(ctrs))
+			for _, c := range ctrs {
+				if uint64(c.Index) >= snapshot.DepositCount {
+					filtered = append(filtered, c)
+				}
+			}
+			ctrs = filtered
+		}
+	}
+	cache.InsertDepositContainers(ctx, ctrs)
+	var chainStartEth1Data *ethpb.Eth1Data
+	if chainData.ChainstartData != nil {
+		chainStartEth1Data = chainData.ChainstartData.Eth1Data
+	}
+	return cache, chainStartEth1Data, nil
+}
@@ -0,0 +1,13 @@
+package eth1voting
+
+import "github.com/urfave/cli/v2"
+
+var Commands = []*cli.Command{
+	{
+		Name:  "eth1-voting",
+		Usage: "commands for debugging eth1 data voting",
+		Subcommands: []*cli.Command{
+			analyzeCmd,
+		},
+	},
+}
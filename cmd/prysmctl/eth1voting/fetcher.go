@@ -0,0 +1,89 @@
+package eth1voting
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/execution"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/execution/types"
+)
+
+var _ execution.POWBlockFetcher = (*blockFetcher)(nil)
+
+// blockFetcher retrieves execution blocks from the JSON-RPC endpoint of an execution client, so that the votes
+// of this node can be computed without running the execution service of a beacon node.
+type blockFetcher struct {
+	client *ethclient.Client
+}
+
+func newBlockFetcher(ctx context.Context, endpoint string) (*blockFetcher, error) {
+	client, err := ethclient.DialContext(ctx, endpoint)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not dial execution endpoint %s", endpoint)
+	}
+	return &blockFetcher{client: client}, nil
+}
+
+// BlockTimeByHeight returns the timestamp of the execution block at the height.
+func (f *blockFetcher) BlockTimeByHeight(ctx context.Context, height *big.Int) (uint64, error) {
+	h, err := f.client.HeaderByNumber(ctx, height)
+	if err != nil {
+		return 0, err
+	}
+	return h.Time, nil
+}
+
+// BlockByTimestamp returns the latest execution block with a timestamp not after the time.
+func (f *blockFetcher) BlockByTimestamp(ctx context.Context, time uint64) (*types.HeaderInfo, error) {
+	latest, err := f.client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get latest execution block")
+	}
+	if latest.Time <= time {
+		return types.HeaderToHeaderInfo(latest)
+	}
+	// Search for the first block after the time, the block preceding it is the one we are looking for.
+	low, high := uint64(0), latest.Number.Uint64()
+	for low < high {
+		mid := low + (high-low)/2
+		t, err := f.BlockTimeByHeight(ctx, new(big.Int).SetUint64(mid))
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not get execution block %d", mid)
+		}
+		if t <= time {
+			low = mid + 1
+		} else {
+			high = mid
+		}
+	}
+	if low == 0 {
+		return nil, fmt.Errorf("no execution block before timestamp %d", time)
+	}
+	h, err := f.client.HeaderByNumber(ctx, new(big.Int).SetUint64(low-1))
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get execution block %d", low-1)
+	}
+	return types.HeaderToHeaderInfo(h)
+}
+
+// BlockHashByHeight returns the hash of the execution block at the height.
+func (f *blockFetcher) BlockHashByHeight(ctx context.Context, height *big.Int) (common.Hash, error) {
+	h, err := f.client.HeaderByNumber(ctx, height)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	return h.Hash(), nil
+}
+
+// BlockExists returns whether the execution block with the hash exists, and its height.
+func (f *blockFetcher) BlockExists(ctx context.Context, hash common.Hash) (bool, *big.Int, error) {
+	h, err := f.client.HeaderByHash(ctx, hash)
+	if err != nil {
+		return false, big.NewInt(0), err
+	}
+	return true, h.Number, nil
+}
@@ -0,0 +1,175 @@
+package eth1voting
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"text/tabwriter"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/epoch"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v3/config/params"
+	"github.com/prysmaticlabs/prysm/v3/consensus-types/interfaces"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+)
+
+// vote is the tally of a distinct eth1 data voted for in a voting period.
+type vote struct {
+	data         *ethpb.Eth1Data
+	count        uint64
+	majority     bool
+	majoritySlot types.Slot
+}
+
+// votingPeriod is the tally of the eth1 data votes included in the blocks of an eth1 voting period.
+type votingPeriod struct {
+	startSlot     types.Slot
+	startEth1Data *ethpb.Eth1Data // The eth1 data of the state at the start of the period.
+	endEth1Data   *ethpb.Eth1Data // The eth1 data of the state after the last block of the period.
+	votes         []*vote         // Distinct votes in the order they were first cast.
+	total         uint64
+	ownVote       *ethpb.Eth1Data // The vote of this node at the start of the period.
+	ownVoteErr    error
+}
+
+func slotsPerVotingPeriod() types.Slot {
+	return params.BeaconConfig().SlotsPerEpoch.Mul(uint64(params.BeaconConfig().EpochsPerEth1VotingPeriod))
+}
+
+// votingPeriodStart returns the first slot of the eth1 voting period of the slot.
+func votingPeriodStart(slot types.Slot) types.Slot {
+	return slot - slot.ModSlot(slotsPerVotingPeriod())
+}
+
+// insert counts the vote cast in the block at the slot, recording the slot at which it gathers a majority.
+func (p *votingPeriod) insert(slot types.Slot, data *ethpb.Eth1Data) {
+	p.total++
+	var v *vote
+	for _, existing := range p.votes {
+		if blocks.AreEth1DataEqual(existing.data, data) {
+			v = existing
+			break
+		}
+	}
+	if v == nil {
+		v = &vote{data: ethpb.CopyETH1Data(data)}
+		p.votes = append(p.votes, v)
+	}
+	v.count++
+	if !v.majority && v.count*2 > uint64(slotsPerVotingPeriod()) {
+		v.majority = true
+		v.majoritySlot = slot
+	}
+}
+
+// tallyVotes replays the eth1 data votes of the blocks, in ascending slot order, on top of the state the first of
+// them is applied to, using the same processing as the state transition. The votes of the blocks from the voting
+// period of start onwards are tallied per voting period.
+func tallyVotes(ctx context.Context, st state.BeaconState, blks []interfaces.SignedBeaconBlock, start types.Slot) ([]*votingPeriod, error) {
+	start = votingPeriodStart(start)
+	var periods []*votingPeriod
+	var current *votingPeriod
+	for _, b := range blks {
+		slot := b.Block().Slot()
+		if slot <= st.Slot() {
+			return nil, fmt.Errorf("block at slot %d does not follow state at slot %d", slot, st.Slot())
+		}
+		if err := resetEth1DataVotes(st, slot); err != nil {
+			return nil, err
+		}
+		if err := st.SetSlot(slot); err != nil {
+			return nil, err
+		}
+		data := b.Block().Body().Eth1Data()
+		if slot >= start {
+			if current == nil || current.startSlot != votingPeriodStart(slot) {
+				current = &votingPeriod{startSlot: votingPeriodStart(slot), startEth1Data: st.Eth1Data()}
+				periods = append(periods, current)
+			}
+			current.insert(slot, data)
+		}
+		var err error
+		st, err = blocks.ProcessEth1DataInBlock(ctx, st, data)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not process eth1 data of block at slot %d", slot)
+		}
+		if current != nil {
+			current.endEth1Data = st.Eth1Data()
+		}
+	}
+	return periods, nil
+}
+
+// resetEth1DataVotes applies the eth1 data votes reset of the epoch processing of every voting period ending
+// between the state slot and the slot.
+func resetEth1DataVotes(st state.BeaconState, slot types.Slot) error {
+	for {
+		periodEnd := votingPeriodStart(st.Slot()) + slotsPerVotingPeriod() - 1
+		if periodEnd >= slot {
+			return nil
+		}
+		if err := st.SetSlot(periodEnd); err != nil {
+			return err
+		}
+		if _, err := epoch.ProcessEth1DataReset(st); err != nil {
+			return err
+		}
+		if err := st.SetSlot(periodEnd + 1); err != nil {
+			return err
+		}
+	}
+}
+
+// String renders the report of the voting period.
+func (p *votingPeriod) String() string {
+	var buf bytes.Buffer
+	periodSlots := slotsPerVotingPeriod()
+	fmt.Fprintf(&buf, "Voting period %d (slots %d-%d): %d votes for %d distinct eth1 data\n",
+		p.startSlot/periodSlots, p.startSlot, p.startSlot+periodSlots-1, p.total, len(p.votes))
+	fmt.Fprintf(&buf, "  State eth1 data at start: %s\n", formatEth1Data(p.startEth1Data))
+	fmt.Fprintf(&buf, "  State eth1 data at end:   %s\n", formatEth1Data(p.endEth1Data))
+	w := tabwriter.NewWriter(&buf, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "  Votes\tShare\tMajority at slot\tEth1 data")
+	for _, v := range p.votes {
+		majority := "-"
+		if v.majority {
+			majority = fmt.Sprintf("%d", v.majoritySlot)
+		}
+		share := float64(v.count) / float64(p.total) * 100
+		fmt.Fprintf(w, "  %d\t%.2f%%\t%s\t%s\n", v.count, share, majority, formatEth1Data(v.data))
+	}
+	if err := w.Flush(); err != nil {
+		return err.Error()
+	}
+	switch {
+	case p.ownVoteErr != nil:
+		fmt.Fprintf(&buf, "  Own vote: unavailable: %v\n", p.ownVoteErr)
+	case p.ownVote != nil:
+		fmt.Fprintf(&buf, "  Own vote: %s (%s)\n", formatEth1Data(p.ownVote), p.ownVoteSupport())
+	}
+	return buf.String()
+}
+
+// ownVoteSupport describes how the vote of this node compares to the votes of the period.
+func (p *votingPeriod) ownVoteSupport() string {
+	for _, v := range p.votes {
+		if !blocks.AreEth1DataEqual(v.data, p.ownVote) {
+			continue
+		}
+		if v.majority {
+			return fmt.Sprintf("agrees with the majority of %d votes", v.count)
+		}
+		return fmt.Sprintf("agrees with %d votes, no majority", v.count)
+	}
+	return "not voted for by any block"
+}
+
+func formatEth1Data(data *ethpb.Eth1Data) string {
+	if data == nil {
+		return "none"
+	}
+	return fmt.Sprintf("deposit_count=%d deposit_root=%#x block_hash=%#x", data.DepositCount, data.DepositRoot, data.BlockHash)
+}
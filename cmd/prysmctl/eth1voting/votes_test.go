@@ -0,0 +1,137 @@
+package eth1voting
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v3/config/params"
+	consensusblocks "github.com/prysmaticlabs/prysm/v3/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v3/consensus-types/interfaces"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+	"github.com/prysmaticlabs/prysm/v3/testing/util"
+)
+
+func testEth1Data(b byte, count uint64) *ethpb.Eth1Data {
+	return &ethpb.Eth1Data{
+		DepositRoot:  bytesutil.PadTo([]byte{b}, 32),
+		DepositCount: count,
+		BlockHash:    bytesutil.PadTo([]byte{b}, 32),
+	}
+}
+
+func testBlock(t *testing.T, slot types.Slot, data *ethpb.Eth1Data) interfaces.SignedBeaconBlock {
+	b := util.NewBeaconBlock()
+	b.Block.Slot = slot
+	b.Block.Body.Eth1Data = data
+	wb, err := consensusblocks.NewSignedBeaconBlock(b)
+	require.NoError(t, err)
+	return wb
+}
+
+func TestTallyVotes(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	c := params.BeaconConfig()
+	c.EpochsPerEth1VotingPeriod = 1
+	params.OverrideBeaconConfig(c)
+
+	a, b, d := testEth1Data('a', 1), testEth1Data('b', 2), testEth1Data('d', 3)
+	// The first period gives a majority to b at its 17th vote, the second period only has votes for d.
+	var blks []interfaces.SignedBeaconBlock
+	for slot := types.Slot(1); slot < 32; slot++ {
+		data := b
+		if slot > 20 {
+			data = d
+		}
+		blks = append(blks, testBlock(t, slot, data))
+	}
+	for slot := types.Slot(32); slot < 40; slot++ {
+		if slot == 35 {
+			continue
+		}
+		blks = append(blks, testBlock(t, slot, d))
+	}
+
+	t.Run("all periods", func(t *testing.T) {
+		st, err := util.NewBeaconState(func(s *ethpb.BeaconState) error {
+			s.Eth1Data = a
+			return nil
+		})
+		require.NoError(t, err)
+		periods, err := tallyVotes(context.Background(), st, blks, 0)
+		require.NoError(t, err)
+		require.Equal(t, 2, len(periods))
+
+		first := periods[0]
+		assert.Equal(t, types.Slot(0), first.startSlot)
+		assert.DeepEqual(t, a, first.startEth1Data)
+		assert.DeepEqual(t, b, first.endEth1Data)
+		assert.Equal(t, uint64(31), first.total)
+		require.Equal(t, 2, len(first.votes))
+		assert.Equal(t, uint64(20), first.votes[0].count)
+		assert.Equal(t, true, first.votes[0].majority)
+		assert.Equal(t, types.Slot(17), first.votes[0].majoritySlot)
+		assert.Equal(t, uint64(11), first.votes[1].count)
+		assert.Equal(t, false, first.votes[1].majority)
+
+		// The votes of the first period were reset.
+		second := periods[1]
+		assert.Equal(t, types.Slot(32), second.startSlot)
+		assert.DeepEqual(t, b, second.startEth1Data)
+		assert.DeepEqual(t, b, second.endEth1Data)
+		require.Equal(t, 1, len(second.votes))
+		assert.Equal(t, uint64(7), second.votes[0].count)
+		assert.Equal(t, false, second.votes[0].majority)
+	})
+	t.Run("start in later period", func(t *testing.T) {
+		st, err := util.NewBeaconState(func(s *ethpb.BeaconState) error {
+			s.Eth1Data = a
+			return nil
+		})
+		require.NoError(t, err)
+		periods, err := tallyVotes(context.Background(), st, blks, 36)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(periods))
+		assert.Equal(t, types.Slot(32), periods[0].startSlot)
+		assert.DeepEqual(t, b, periods[0].startEth1Data)
+		assert.Equal(t, uint64(7), periods[0].total)
+	})
+	t.Run("block not following state", func(t *testing.T) {
+		st, err := util.NewBeaconState()
+		require.NoError(t, err)
+		require.NoError(t, st.SetSlot(5))
+		_, err = tallyVotes(context.Background(), st, blks, 0)
+		require.ErrorContains(t, "does not follow state", err)
+	})
+}
+
+func TestVotingPeriod_String(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	c := params.BeaconConfig()
+	c.EpochsPerEth1VotingPeriod = 1
+	params.OverrideBeaconConfig(c)
+
+	a, b := testEth1Data('a', 1), testEth1Data('b', 2)
+	p := &votingPeriod{startSlot: 64, startEth1Data: a}
+	for slot := types.Slot(64); slot < 81; slot++ {
+		p.insert(slot, b)
+	}
+	p.insert(81, a)
+	p.endEth1Data = b
+
+	p.ownVote = b
+	report := p.String()
+	assert.Equal(t, true, strings.Contains(report, "Voting period 2 (slots 64-95): 18 votes for 2 distinct eth1 data"))
+	assert.Equal(t, true, strings.Contains(report, "80"))
+	assert.Equal(t, true, strings.Contains(report, "94.44%"))
+	assert.Equal(t, true, strings.Contains(report, "agrees with the majority of 17 votes"))
+
+	p.ownVote = a
+	assert.Equal(t, true, strings.Contains(p.String(), "agrees with 1 votes, no majority"))
+	p.ownVote = testEth1Data('c', 3)
+	assert.Equal(t, true, strings.Contains(p.String(), "not voted for by any block"))
+}
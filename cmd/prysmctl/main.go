@@ -3,8 +3,10 @@ package main
 import (
 	"os"
 
+	"github.com/prysmaticlabs/prysm/v3/cmd/prysmctl/attpacking"
 	"github.com/prysmaticlabs/prysm/v3/cmd/prysmctl/checkpointsync"
 	"github.com/prysmaticlabs/prysm/v3/cmd/prysmctl/deprecated"
+	"github.com/prysmaticlabs/prysm/v3/cmd/prysmctl/eth1voting"
 	"github.com/prysmaticlabs/prysm/v3/cmd/prysmctl/p2p"
 	"github.com/prysmaticlabs/prysm/v3/cmd/prysmctl/signing"
 	"github.com/prysmaticlabs/prysm/v3/cmd/prysmctl/testnet"
@@ -35,4 +37,6 @@ func init() {
 	prysmctlCommands = append(prysmctlCommands, testnet.Commands...)
 	prysmctlCommands = append(prysmctlCommands, weaksubjectivity.Commands...)
 	prysmctlCommands = append(prysmctlCommands, signing.Commands...)
+	prysmctlCommands = append(prysmctlCommands, eth1voting.Commands...)
+	prysmctlCommands = append(prysmctlCommands, attpacking.Commands...)
 }
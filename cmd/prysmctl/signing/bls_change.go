@@ -0,0 +1,204 @@
+package signing
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/signing"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/rpc/apimiddleware"
+	"github.com/prysmaticlabs/prysm/v3/cmd/validator/flags"
+	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v3/config/params"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v3/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v3/io/file"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/validator/keymanager/derived"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+// blsToExecutionChangeFilePrefix is the file name prefix of signed BLS to execution changes written to disk.
+const blsToExecutionChangeFilePrefix = "bls-to-execution-change-"
+
+var blsChangeFlags = struct {
+	AccountStartIndex  uint64
+	ValidatorIndices   string
+	ToExecutionAddress string
+}{}
+
+var blsToExecutionChangeCmd = &cli.Command{
+	Name: "bls-to-execution-change",
+	Description: "Signs BLS to execution changes for validators with BLS withdrawal credentials, using the withdrawal " +
+		"keys derived from a mnemonic. The changes are signed offline and written to --output-dir, to be broadcast later",
+	Action: cliActionBLSToExecutionChange,
+	Flags: []cli.Flag{
+		flags.MnemonicFileFlag,
+		flags.Mnemonic25thWordFileFlag,
+		flags.GenesisValidatorsRootFlag,
+		flags.SignedMessagesOutputDirFlag,
+		&cli.Uint64Flag{
+			Name:        "account-start-index",
+			Usage:       "Index of the account of the mnemonic the withdrawal key of the first validator is derived from",
+			Destination: &blsChangeFlags.AccountStartIndex,
+		},
+		&cli.StringFlag{
+			Name: "validator-indices",
+			Usage: "Comma-separated list of the indices of the validators to change, in the order of their accounts " +
+				"starting at --account-start-index",
+			Destination: &blsChangeFlags.ValidatorIndices,
+			Required:    true,
+		},
+		&cli.StringFlag{
+			Name:        "to-execution-address",
+			Usage:       "Execution address the withdrawals of the validators are sent to",
+			Destination: &blsChangeFlags.ToExecutionAddress,
+			Required:    true,
+		},
+	},
+}
+
+func cliActionBLSToExecutionChange(cliCtx *cli.Context) error {
+	f := blsChangeFlags
+	mnemonic, err := readTrimmedFile(cliCtx.String(flags.MnemonicFileFlag.Name))
+	if err != nil {
+		return errors.Wrap(err, "could not read mnemonic file")
+	}
+	var mnemonicPassphrase string
+	if cliCtx.IsSet(flags.Mnemonic25thWordFileFlag.Name) {
+		mnemonicPassphrase, err = readTrimmedFile(cliCtx.String(flags.Mnemonic25thWordFileFlag.Name))
+		if err != nil {
+			return errors.Wrap(err, "could not read mnemonic 25th word file")
+		}
+	}
+	indices, err := parseValidatorIndices(f.ValidatorIndices)
+	if err != nil {
+		return err
+	}
+	address, err := hexutil.Decode(f.ToExecutionAddress)
+	if err != nil || len(address) != common.AddressLength {
+		return errors.Errorf("invalid execution address %s", f.ToExecutionAddress)
+	}
+	genesisValidatorsRoot, err := hexutil.Decode(cliCtx.String(flags.GenesisValidatorsRootFlag.Name))
+	if err != nil {
+		return errors.Wrapf(err, "could not decode --%s", flags.GenesisValidatorsRootFlag.Name)
+	}
+	domain, err := blsToExecutionChangeDomain(genesisValidatorsRoot)
+	if err != nil {
+		return err
+	}
+	keys, err := derived.WithdrawalKeysFromMnemonic(mnemonic, mnemonicPassphrase, int(f.AccountStartIndex), len(indices))
+	if err != nil {
+		return errors.Wrap(err, "could not derive withdrawal keys")
+	}
+	changes, err := signBLSToExecutionChanges(keys, indices, address, domain)
+	if err != nil {
+		return err
+	}
+	outputDir := cliCtx.String(flags.SignedMessagesOutputDirFlag.Name)
+	for _, change := range changes {
+		path, err := writeBLSToExecutionChange(outputDir, change)
+		if err != nil {
+			return err
+		}
+		log.WithField("validatorIndex", change.Message.ValidatorIndex).WithField("path", path).Info("Wrote signed BLS to execution change")
+	}
+	log.Infof("Signed %d BLS to execution changes, use prysmctl sign broadcast to submit them", len(changes))
+	return nil
+}
+
+func readTrimmedFile(path string) (string, error) {
+	b, err := os.ReadFile(path) // #nosec G304
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(b)), nil
+}
+
+func parseValidatorIndices(s string) ([]types.ValidatorIndex, error) {
+	var indices []types.ValidatorIndex
+	for _, part := range strings.Split(s, ",") {
+		index, err := strconv.ParseUint(strings.TrimSpace(part), 10, 64)
+		if err != nil {
+			return nil, errors.Errorf("invalid validator index %s", part)
+		}
+		indices = append(indices, types.ValidatorIndex(index))
+	}
+	return indices, nil
+}
+
+// Computes the signature domain of BLS to execution changes from the genesis validators root of the
+// network. BLS to execution changes are always signed with the genesis fork version, so that they
+// remain valid across forks.
+func blsToExecutionChangeDomain(genesisValidatorsRoot []byte) ([]byte, error) {
+	if len(genesisValidatorsRoot) != fieldparams.RootLength {
+		return nil, errors.New("genesis validators root must be 32 bytes long")
+	}
+	return signing.ComputeDomain(
+		params.BeaconConfig().DomainBLSToExecutionChange,
+		params.BeaconConfig().GenesisForkVersion,
+		genesisValidatorsRoot,
+	)
+}
+
+// Signs a BLS to execution change for every validator index with the withdrawal key at the same position.
+func signBLSToExecutionChanges(
+	keys []bls.SecretKey, indices []types.ValidatorIndex, address, domain []byte,
+) ([]*ethpb.SignedBLSToExecutionChange, error) {
+	if len(keys) != len(indices) {
+		return nil, errors.New("number of withdrawal keys and validator indices differ")
+	}
+	changes := make([]*ethpb.SignedBLSToExecutionChange, len(indices))
+	for i, index := range indices {
+		message := &ethpb.BLSToExecutionChange{
+			ValidatorIndex:     index,
+			FromBlsPubkey:      keys[i].PublicKey().Marshal(),
+			ToExecutionAddress: address,
+		}
+		root, err := signing.ComputeSigningRoot(message, domain)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not compute signing root of validator %d", index)
+		}
+		changes[i] = &ethpb.SignedBLSToExecutionChange{
+			Message:   message,
+			Signature: keys[i].Sign(root[:]).Marshal(),
+		}
+	}
+	return changes, nil
+}
+
+// Writes a signed BLS to execution change to the directory in the JSON format of the beacon node API.
+func writeBLSToExecutionChange(dir string, change *ethpb.SignedBLSToExecutionChange) (string, error) {
+	b, err := json.MarshalIndent(&apimiddleware.SignedBLSToExecutionChangeJson{
+		Message: &apimiddleware.BLSToExecutionChangeJson{
+			ValidatorIndex:     strconv.FormatUint(uint64(change.Message.ValidatorIndex), 10),
+			FromBLSPubkey:      hexutil.Encode(change.Message.FromBlsPubkey),
+			ToExecutionAddress: hexutil.Encode(change.Message.ToExecutionAddress),
+		},
+		Signature: hexutil.Encode(change.Signature),
+	}, "", "  ")
+	if err != nil {
+		return "", errors.Wrap(err, "could not marshal BLS to execution change")
+	}
+	exists, err := file.HasDir(dir)
+	if err != nil {
+		return "", errors.Wrap(err, "could not check output directory")
+	}
+	if !exists {
+		if err := file.MkdirAll(dir); err != nil {
+			return "", errors.Wrap(err, "could not create output directory")
+		}
+	}
+	path := filepath.Join(dir, fmt.Sprintf("%s%d.json", blsToExecutionChangeFilePrefix, change.Message.ValidatorIndex))
+	if err := file.WriteFile(path, b); err != nil {
+		return "", errors.Wrap(err, "could not write BLS to execution change")
+	}
+	return path, nil
+}
@@ -0,0 +1,100 @@
+package signing
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/v3/api/client/beacon"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/signing"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/rpc/apimiddleware"
+	"github.com/prysmaticlabs/prysm/v3/config/params"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+	"github.com/prysmaticlabs/prysm/v3/validator/keymanager/derived"
+	constant "github.com/prysmaticlabs/prysm/v3/validator/testing"
+)
+
+func TestSignBLSToExecutionChanges(t *testing.T) {
+	keys, err := derived.WithdrawalKeysFromMnemonic(constant.TestMnemonic, "", 0, 2)
+	require.NoError(t, err)
+	domain, err := blsToExecutionChangeDomain(make([]byte, 32))
+	require.NoError(t, err)
+	address, err := hexutil.Decode("0x50155530FCE8a85ec7055A5F8b2bE214B3DaeFd3")
+	require.NoError(t, err)
+
+	indices := []types.ValidatorIndex{7, 9}
+	changes, err := signBLSToExecutionChanges(keys, indices, address, domain)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(changes))
+	for i, change := range changes {
+		assert.Equal(t, indices[i], change.Message.ValidatorIndex)
+		assert.DeepEqual(t, keys[i].PublicKey().Marshal(), change.Message.FromBlsPubkey)
+		require.NoError(t, signing.VerifySigningRoot(change.Message, change.Message.FromBlsPubkey, change.Signature, domain))
+	}
+
+	_, err = signBLSToExecutionChanges(keys, indices[:1], address, domain)
+	assert.ErrorContains(t, "number of withdrawal keys and validator indices differ", err)
+}
+
+func TestBLSToExecutionChangeDomain(t *testing.T) {
+	root := bytesutil.PadTo([]byte("genesis validators root"), 32)
+	domain, err := blsToExecutionChangeDomain(root)
+	require.NoError(t, err)
+	cfg := params.BeaconConfig()
+	want, err := signing.ComputeDomain(cfg.DomainBLSToExecutionChange, cfg.GenesisForkVersion, root)
+	require.NoError(t, err)
+	assert.DeepEqual(t, want, domain)
+
+	_, err = blsToExecutionChangeDomain(root[:31])
+	assert.ErrorContains(t, "genesis validators root must be 32 bytes long", err)
+}
+
+func TestBroadcast(t *testing.T) {
+	keys, err := derived.WithdrawalKeysFromMnemonic(constant.TestMnemonic, "", 0, 1)
+	require.NoError(t, err)
+	domain, err := blsToExecutionChangeDomain(make([]byte, 32))
+	require.NoError(t, err)
+	changes, err := signBLSToExecutionChanges(keys, []types.ValidatorIndex{3}, make([]byte, 20), domain)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	_, err = writeBLSToExecutionChange(dir, changes[0])
+	require.NoError(t, err)
+	exit := &apimiddleware.SignedVoluntaryExitJson{
+		Exit:      &apimiddleware.VoluntaryExitJson{Epoch: "1", ValidatorIndex: "4"},
+		Signature: "0x01",
+	}
+	b, err := json.Marshal(exit)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "voluntary-exit-4.json"), b, 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "unrelated.json"), []byte("{}"), 0600))
+
+	msgs, err := readSignedMessages([]string{dir})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(msgs.exits))
+	require.Equal(t, 1, len(msgs.blsChanges))
+	assert.Equal(t, "3", msgs.blsChanges[0].Message.ValidatorIndex)
+	assert.Equal(t, hexutil.Encode(changes[0].Signature), msgs.blsChanges[0].Signature)
+
+	var submitted []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		submitted = append(submitted, r.URL.Path)
+		if r.URL.Path == "/eth/v1/beacon/pool/bls_to_execution_changes" {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+	client, err := beacon.NewClient(srv.URL)
+	require.NoError(t, err)
+	err = broadcast(context.Background(), client, msgs)
+	assert.ErrorContains(t, "could not broadcast 1 signed messages", err)
+	assert.DeepEqual(t, []string{"/eth/v1/beacon/pool/voluntary_exits", "/eth/v1/beacon/pool/bls_to_execution_changes"}, submitted)
+}
@@ -0,0 +1,161 @@
+package signing
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v3/api/client/beacon"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/rpc/apimiddleware"
+	"github.com/prysmaticlabs/prysm/v3/validator/accounts"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+var broadcastFlags = struct {
+	BeaconNodeHost string
+	Timeout        time.Duration
+}{}
+
+var broadcastCmd = &cli.Command{
+	Name:      "broadcast",
+	Usage:     "Submits signed messages saved by the sign commands to the network through the beacon node",
+	ArgsUsage: "<file or directory>...",
+	Description: "Submits the signed voluntary exits and BLS to execution changes in the given files, or in the " +
+		"files of the given directories, to the beacon node. Message types are recognized by their file names",
+	Action: cliActionBroadcast,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:        "beacon-node-host",
+			Usage:       "host:port for beacon node connection",
+			Destination: &broadcastFlags.BeaconNodeHost,
+			Value:       "localhost:3500",
+		},
+		&cli.DurationFlag{
+			Name:        "http-timeout",
+			Usage:       "timeout for http requests made to beacon-node-url (uses duration format, ex: 2m31s). default: 2m",
+			Destination: &broadcastFlags.Timeout,
+			Value:       time.Minute * 2,
+		},
+	},
+}
+
+// signedMessages are the signed messages read from disk to broadcast.
+type signedMessages struct {
+	exits      []*apimiddleware.SignedVoluntaryExitJson
+	blsChanges []*apimiddleware.SignedBLSToExecutionChangeJson
+}
+
+func cliActionBroadcast(cliCtx *cli.Context) error {
+	if cliCtx.NArg() == 0 {
+		return errors.New("no files or directories of signed messages given")
+	}
+	msgs, err := readSignedMessages(cliCtx.Args().Slice())
+	if err != nil {
+		return err
+	}
+	if len(msgs.exits) == 0 && len(msgs.blsChanges) == 0 {
+		return errors.New("no signed messages found")
+	}
+	client, err := beacon.NewClient(broadcastFlags.BeaconNodeHost, beacon.WithTimeout(broadcastFlags.Timeout))
+	if err != nil {
+		return err
+	}
+	return broadcast(cliCtx.Context, client, msgs)
+}
+
+// Reads the signed messages of the given files, and of the files in the given directories.
+func readSignedMessages(paths []string) (*signedMessages, error) {
+	msgs := &signedMessages{}
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, err
+		}
+		files := []string{path}
+		if info.IsDir() {
+			entries, err := os.ReadDir(path)
+			if err != nil {
+				return nil, errors.Wrapf(err, "could not read directory %s", path)
+			}
+			files = nil
+			for _, entry := range entries {
+				if !entry.IsDir() && isSignedMessageFile(entry.Name()) {
+					files = append(files, filepath.Join(path, entry.Name()))
+				}
+			}
+		}
+		for _, f := range files {
+			if err := msgs.read(f); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return msgs, nil
+}
+
+func isSignedMessageFile(name string) bool {
+	return filepath.Ext(name) == ".json" &&
+		(strings.HasPrefix(name, accounts.VoluntaryExitFilePrefix) || strings.HasPrefix(name, blsToExecutionChangeFilePrefix))
+}
+
+func (m *signedMessages) read(path string) error {
+	b, err := os.ReadFile(path) // #nosec G304
+	if err != nil {
+		return errors.Wrapf(err, "could not read %s", path)
+	}
+	name := filepath.Base(path)
+	switch {
+	case strings.HasPrefix(name, accounts.VoluntaryExitFilePrefix):
+		exit := &apimiddleware.SignedVoluntaryExitJson{}
+		if err := json.Unmarshal(b, exit); err != nil {
+			return errors.Wrapf(err, "could not unmarshal voluntary exit %s", path)
+		}
+		if exit.Exit == nil {
+			return errors.Errorf("voluntary exit %s has no message", path)
+		}
+		m.exits = append(m.exits, exit)
+	case strings.HasPrefix(name, blsToExecutionChangeFilePrefix):
+		change := &apimiddleware.SignedBLSToExecutionChangeJson{}
+		if err := json.Unmarshal(b, change); err != nil {
+			return errors.Wrapf(err, "could not unmarshal BLS to execution change %s", path)
+		}
+		if change.Message == nil {
+			return errors.Errorf("BLS to execution change %s has no message", path)
+		}
+		m.blsChanges = append(m.blsChanges, change)
+	default:
+		return errors.Errorf("unknown type of signed message %s", path)
+	}
+	return nil
+}
+
+// Submits every voluntary exit separately, so that one rejected exit does not prevent the others from
+// being broadcast, and all BLS to execution changes at once.
+func broadcast(ctx context.Context, client *beacon.Client, msgs *signedMessages) error {
+	var failed int
+	for _, exit := range msgs.exits {
+		if err := client.SubmitVoluntaryExit(ctx, exit); err != nil {
+			log.WithError(err).WithField("validatorIndex", exit.Exit.ValidatorIndex).Error("Could not broadcast voluntary exit")
+			failed++
+			continue
+		}
+		log.WithField("validatorIndex", exit.Exit.ValidatorIndex).Info("Broadcast voluntary exit")
+	}
+	if len(msgs.blsChanges) > 0 {
+		if err := client.SubmitBLSToExecutionChanges(ctx, msgs.blsChanges); err != nil {
+			log.WithError(err).Error("Could not broadcast BLS to execution changes")
+			failed += len(msgs.blsChanges)
+		} else {
+			log.Infof("Broadcast %d BLS to execution changes", len(msgs.blsChanges))
+		}
+	}
+	if failed > 0 {
+		return errors.Errorf("could not broadcast %d signed messages", failed)
+	}
+	return nil
+}
@@ -15,11 +15,13 @@ import (
 var Commands = []*cli.Command{
 	{
 		Name:  "sign",
-		Usage: "signs a message and broadcasts it to the network through the beacon node",
+		Usage: "signs a message and broadcasts it to the network through the beacon node, or saves it to broadcast later",
 		Subcommands: []*cli.Command{
 			{
-				Name:        "voluntary-exit",
-				Description: "Performs a voluntary exit on selected accounts",
+				Name: "voluntary-exit",
+				Description: "Performs a voluntary exit on selected accounts. With --exit-list-file, signs the voluntary " +
+					"exits of the listed validators without user interaction and saves them to --output-dir, offline " +
+					"when --fork-version, --genesis-validators-root and --exit-epoch are given",
 				Flags: cmd.WrapFlags([]cli.Flag{
 					flags.WalletDirFlag,
 					flags.WalletPasswordFileFlag,
@@ -36,6 +38,11 @@ var Commands = []*cli.Command{
 					flags.GrpcRetriesFlag,
 					flags.GrpcRetryDelayFlag,
 					flags.ExitAllFlag,
+					flags.VoluntaryExitListFileFlag,
+					flags.SignedMessagesOutputDirFlag,
+					flags.ForkVersionFlag,
+					flags.GenesisValidatorsRootFlag,
+					flags.ExitEpochFlag,
 					features.Mainnet,
 					features.PraterTestnet,
 					features.RopstenTestnet,
@@ -52,12 +59,20 @@ var Commands = []*cli.Command{
 					return features.ConfigureValidator(cliCtx)
 				},
 				Action: func(cliCtx *cli.Context) error {
+					if cliCtx.IsSet(flags.VoluntaryExitListFileFlag.Name) {
+						if err := accounts.AccountsExitBatch(cliCtx); err != nil {
+							log.WithError(err).Fatal("Could not sign voluntary exits")
+						}
+						return nil
+					}
 					if err := accounts.AccountsExit(cliCtx, os.Stdin); err != nil {
 						log.WithError(err).Fatal("Could not perform voluntary exit")
 					}
 					return nil
 				},
 			},
+			blsToExecutionChangeCmd,
+			broadcastCmd,
 		},
 	},
 }
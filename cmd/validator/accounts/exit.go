@@ -4,6 +4,7 @@ import (
 	"io"
 	"strings"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/pkg/errors"
 	grpcutil "github.com/prysmaticlabs/prysm/v3/api/grpc"
@@ -21,9 +22,6 @@ import (
 )
 
 func AccountsExit(c *cli.Context, r io.Reader) error {
-	var w *wallet.Wallet
-	var km keymanager.IKeymanager
-	var err error
 	dialOpts := client.ConstructDialOptions(
 		c.Int(cmd.GrpcMaxCallRecvMsgSizeFlag.Name),
 		c.String(flags.CertFlag.Name),
@@ -32,8 +30,49 @@ func AccountsExit(c *cli.Context, r io.Reader) error {
 	)
 	grpcHeaders := strings.Split(c.String(flags.GrpcHeadersFlag.Name), ",")
 	beaconRPCProvider := c.String(flags.BeaconRPCProviderFlag.Name)
+	w, km, err := exitKeymanager(c, dialOpts, grpcHeaders, beaconRPCProvider)
+	if err != nil {
+		return err
+	}
+
+	opts := []accounts.Option{
+		accounts.WithWallet(w),
+		accounts.WithKeymanager(km),
+		accounts.WithGRPCDialOpts(dialOpts),
+		accounts.WithBeaconRPCProvider(beaconRPCProvider),
+		accounts.WithGRPCHeaders(grpcHeaders),
+	}
+	// Get full set of public keys from the keymanager.
+	validatingPublicKeys, err := km.FetchValidatingPublicKeys(c.Context)
+	if err != nil {
+		return err
+	}
+	if len(validatingPublicKeys) == 0 {
+		return errors.New("wallet is empty, no accounts to delete")
+	}
+	// Filter keys either from CLI flag or from interactive session.
+	rawPubKey, formattedPubKeys, err := accounts.FilterExitAccountsFromUserInput(c, r, validatingPublicKeys)
+	if err != nil {
+		return errors.Wrap(err, "could not filter public keys for deletion")
+	}
+	opts = append(opts, accounts.WithRawPubKeys(rawPubKey))
+	opts = append(opts, accounts.WithFormattedPubKeys(formattedPubKeys))
+	acc, err := accounts.NewCLIManager(opts...)
+	if err != nil {
+		return err
+	}
+	return acc.Exit(c.Context)
+}
+
+// Initializes the keymanager of the validators to exit, from the interop keys, a web3signer or a wallet.
+func exitKeymanager(
+	c *cli.Context, dialOpts []grpc.DialOption, grpcHeaders []string, beaconRPCProvider string,
+) (*wallet.Wallet, keymanager.IKeymanager, error) {
+	var w *wallet.Wallet
+	var km keymanager.IKeymanager
+	var err error
 	if !c.IsSet(flags.Web3SignerURLFlag.Name) && !c.IsSet(flags.WalletDirFlag.Name) && !c.IsSet(flags.InteropNumValidators.Name) {
-		return errors.Errorf("No validators found, please provide a prysm wallet directory via flag --%s "+
+		return nil, nil, errors.Errorf("No validators found, please provide a prysm wallet directory via flag --%s "+
 			"or a web3signer location with corresponding public keys via flags --%s and --%s ",
 			flags.WalletDirFlag.Name,
 			flags.Web3SignerURLFlag.Name,
@@ -43,64 +82,57 @@ func AccountsExit(c *cli.Context, r io.Reader) error {
 	if c.IsSet(flags.InteropNumValidators.Name) {
 		km, err = local.NewInteropKeymanager(c.Context, c.Uint64(flags.InteropStartIndex.Name), c.Uint64(flags.InteropNumValidators.Name))
 		if err != nil {
-			return errors.Wrap(err, "could not generate interop keys for key manager")
+			return nil, nil, errors.Wrap(err, "could not generate interop keys for key manager")
 		}
 		w = &wallet.Wallet{}
 	} else if c.IsSet(flags.Web3SignerURLFlag.Name) {
-		ctx := grpcutil.AppendHeaders(c.Context, grpcHeaders)
-		conn, err := grpc.DialContext(ctx, beaconRPCProvider, dialOpts...)
-		if err != nil {
-			return errors.Wrapf(err, "could not dial endpoint %s", beaconRPCProvider)
-		}
-		nodeClient := ethpb.NewNodeClient(conn)
-		resp, err := nodeClient.GetGenesis(c.Context, &empty.Empty{})
+		genesisValidatorsRoot, err := exitGenesisValidatorsRoot(c, dialOpts, grpcHeaders, beaconRPCProvider)
 		if err != nil {
-			return errors.Wrapf(err, "failed to get genesis info")
-		}
-		if err := conn.Close(); err != nil {
-			log.WithError(err).Error("Failed to close connection")
+			return nil, nil, err
 		}
 		config, err := node.Web3SignerConfig(c)
 		if err != nil {
-			return errors.Wrapf(err, "could not configure web3signer")
+			return nil, nil, errors.Wrapf(err, "could not configure web3signer")
 		}
-		config.GenesisValidatorsRoot = resp.GenesisValidatorsRoot
+		config.GenesisValidatorsRoot = genesisValidatorsRoot
 		w, km, err = walletWithWeb3SignerKeymanager(c, config)
 		if err != nil {
-			return err
+			return nil, nil, err
 		}
 	} else {
 		w, km, err = walletWithKeymanager(c)
 		if err != nil {
-			return err
+			return nil, nil, err
 		}
 	}
+	return w, km, nil
+}
 
-	opts := []accounts.Option{
-		accounts.WithWallet(w),
-		accounts.WithKeymanager(km),
-		accounts.WithGRPCDialOpts(dialOpts),
-		accounts.WithBeaconRPCProvider(beaconRPCProvider),
-		accounts.WithGRPCHeaders(grpcHeaders),
-	}
-	// Get full set of public keys from the keymanager.
-	validatingPublicKeys, err := km.FetchValidatingPublicKeys(c.Context)
-	if err != nil {
-		return err
-	}
-	if len(validatingPublicKeys) == 0 {
-		return errors.New("wallet is empty, no accounts to delete")
+// Returns the genesis validators root from its flag, or else from the beacon node.
+func exitGenesisValidatorsRoot(
+	c *cli.Context, dialOpts []grpc.DialOption, grpcHeaders []string, beaconRPCProvider string,
+) ([]byte, error) {
+	if c.IsSet(flags.GenesisValidatorsRootFlag.Name) {
+		genesisValidatorsRoot, err := hexutil.Decode(c.String(flags.GenesisValidatorsRootFlag.Name))
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not decode --%s", flags.GenesisValidatorsRootFlag.Name)
+		}
+		return genesisValidatorsRoot, nil
 	}
-	// Filter keys either from CLI flag or from interactive session.
-	rawPubKey, formattedPubKeys, err := accounts.FilterExitAccountsFromUserInput(c, r, validatingPublicKeys)
+	ctx := grpcutil.AppendHeaders(c.Context, grpcHeaders)
+	conn, err := grpc.DialContext(ctx, beaconRPCProvider, dialOpts...)
 	if err != nil {
-		return errors.Wrap(err, "could not filter public keys for deletion")
+		return nil, errors.Wrapf(err, "could not dial endpoint %s", beaconRPCProvider)
 	}
-	opts = append(opts, accounts.WithRawPubKeys(rawPubKey))
-	opts = append(opts, accounts.WithFormattedPubKeys(formattedPubKeys))
-	acc, err := accounts.NewCLIManager(opts...)
+	defer func() {
+		if err := conn.Close(); err != nil {
+			log.WithError(err).Error("Failed to close connection")
+		}
+	}()
+	nodeClient := ethpb.NewNodeClient(conn)
+	resp, err := nodeClient.GetGenesis(c.Context, &empty.Empty{})
 	if err != nil {
-		return err
+		return nil, errors.Wrapf(err, "failed to get genesis info")
 	}
-	return acc.Exit(c.Context)
+	return resp.GenesisValidatorsRoot, nil
 }
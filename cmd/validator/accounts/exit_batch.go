@@ -0,0 +1,234 @@
+package accounts
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/pkg/errors"
+	grpcutil "github.com/prysmaticlabs/prysm/v3/api/grpc"
+	"github.com/prysmaticlabs/prysm/v3/cmd"
+	"github.com/prysmaticlabs/prysm/v3/cmd/validator/flags"
+	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v3/config/params"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/time/slots"
+	"github.com/prysmaticlabs/prysm/v3/validator/accounts"
+	"github.com/prysmaticlabs/prysm/v3/validator/client"
+	"github.com/urfave/cli/v2"
+	"google.golang.org/grpc"
+)
+
+// AccountsExitBatch signs voluntary exits for the validators listed in the exit list file without
+// user interaction, and writes them to the output directory to be broadcast later. When the fork
+// version, genesis validators root and exit epoch are all given, the exits are signed offline.
+// Otherwise, missing validator indices and public keys and the signature domain are requested from
+// the beacon node.
+func AccountsExitBatch(c *cli.Context) error {
+	f, err := os.Open(c.String(flags.VoluntaryExitListFileFlag.Name)) // #nosec G304
+	if err != nil {
+		return errors.Wrap(err, "could not open exit list file")
+	}
+	targets, err := accounts.ParseExitList(f)
+	if closeErr := f.Close(); closeErr != nil {
+		log.WithError(closeErr).Error("Could not close exit list file")
+	}
+	if err != nil {
+		return err
+	}
+	if len(targets) == 0 {
+		return errors.New("exit list file is empty")
+	}
+
+	offlineFlags := []string{flags.ForkVersionFlag.Name, flags.GenesisValidatorsRootFlag.Name, flags.ExitEpochFlag.Name}
+	var numOfflineFlags int
+	for _, name := range offlineFlags {
+		if c.IsSet(name) {
+			numOfflineFlags++
+		}
+	}
+	offline := numOfflineFlags == len(offlineFlags)
+	if numOfflineFlags > 0 && !offline {
+		return errors.Errorf("flags --%s are all required to sign voluntary exits offline", strings.Join(offlineFlags, ", --"))
+	}
+
+	dialOpts := client.ConstructDialOptions(
+		c.Int(cmd.GrpcMaxCallRecvMsgSizeFlag.Name),
+		c.String(flags.CertFlag.Name),
+		c.Uint(flags.GrpcRetriesFlag.Name),
+		c.Duration(flags.GrpcRetryDelayFlag.Name),
+	)
+	grpcHeaders := strings.Split(c.String(flags.GrpcHeadersFlag.Name), ",")
+	beaconRPCProvider := c.String(flags.BeaconRPCProviderFlag.Name)
+	_, km, err := exitKeymanager(c, dialOpts, grpcHeaders, beaconRPCProvider)
+	if err != nil {
+		return err
+	}
+	validatingPublicKeys, err := km.FetchValidatingPublicKeys(c.Context)
+	if err != nil {
+		return err
+	}
+	if len(validatingPublicKeys) == 0 {
+		return errors.New("wallet is empty, no accounts to exit")
+	}
+
+	var exits []*ethpb.VoluntaryExit
+	var pubKeys [][]byte
+	var domain []byte
+	if offline {
+		exits, pubKeys, domain, err = offlineExits(c, targets, validatingPublicKeys)
+	} else {
+		exits, pubKeys, domain, err = onlineExits(c, targets, validatingPublicKeys, dialOpts, grpcHeaders, beaconRPCProvider)
+	}
+	if err != nil {
+		return err
+	}
+
+	outputDir := c.String(flags.SignedMessagesOutputDirFlag.Name)
+	for i, exit := range exits {
+		signedExit, err := accounts.SignVoluntaryExit(c.Context, km.Sign, pubKeys[i], exit, domain)
+		if err != nil {
+			return errors.Wrapf(err, "could not sign voluntary exit of validator %d", exit.ValidatorIndex)
+		}
+		path, err := accounts.WriteSignedVoluntaryExit(outputDir, signedExit)
+		if err != nil {
+			return err
+		}
+		log.WithField("validatorIndex", exit.ValidatorIndex).WithField("path", path).Info("Wrote signed voluntary exit")
+	}
+	log.Infof("Signed %d voluntary exits, use prysmctl sign broadcast to submit them", len(exits))
+	return nil
+}
+
+// Builds the voluntary exits of the listed validators and their signature domain from the flags only.
+func offlineExits(
+	c *cli.Context, targets []*accounts.ExitTarget, validatingPublicKeys [][fieldparams.BLSPubkeyLength]byte,
+) ([]*ethpb.VoluntaryExit, [][]byte, []byte, error) {
+	forkVersion, err := hexutil.Decode(c.String(flags.ForkVersionFlag.Name))
+	if err != nil {
+		return nil, nil, nil, errors.Wrapf(err, "could not decode --%s", flags.ForkVersionFlag.Name)
+	}
+	genesisValidatorsRoot, err := hexutil.Decode(c.String(flags.GenesisValidatorsRootFlag.Name))
+	if err != nil {
+		return nil, nil, nil, errors.Wrapf(err, "could not decode --%s", flags.GenesisValidatorsRootFlag.Name)
+	}
+	domain, err := accounts.VoluntaryExitDomain(forkVersion, genesisValidatorsRoot)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	epoch := types.Epoch(c.Uint64(flags.ExitEpochFlag.Name))
+	exits := make([]*ethpb.VoluntaryExit, len(targets))
+	pubKeys := make([][]byte, len(targets))
+	for i, target := range targets {
+		if !target.HasIndex || target.PubKey == nil {
+			return nil, nil, nil, errors.New("signing voluntary exits offline requires both the validator index and the public key of every listed validator")
+		}
+		if !hasPublicKey(validatingPublicKeys, target.PubKey) {
+			return nil, nil, nil, errors.Errorf("public key %#x is not in the wallet", target.PubKey)
+		}
+		exits[i] = &ethpb.VoluntaryExit{Epoch: epoch, ValidatorIndex: target.Index}
+		pubKeys[i] = target.PubKey
+	}
+	return exits, pubKeys, domain, nil
+}
+
+// Builds the voluntary exits of the listed validators, requesting missing validator indices and public keys,
+// the current epoch and the signature domain from the beacon node.
+func onlineExits(
+	c *cli.Context,
+	targets []*accounts.ExitTarget,
+	validatingPublicKeys [][fieldparams.BLSPubkeyLength]byte,
+	dialOpts []grpc.DialOption,
+	grpcHeaders []string,
+	beaconRPCProvider string,
+) ([]*ethpb.VoluntaryExit, [][]byte, []byte, error) {
+	ctx := grpcutil.AppendHeaders(c.Context, grpcHeaders)
+	conn, err := grpc.DialContext(ctx, beaconRPCProvider, dialOpts...)
+	if err != nil {
+		return nil, nil, nil, errors.Wrapf(err, "could not dial endpoint %s", beaconRPCProvider)
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			log.WithError(err).Error("Failed to close connection")
+		}
+	}()
+	validatorClient := ethpb.NewBeaconNodeValidatorClient(conn)
+	nodeClient := ethpb.NewNodeClient(conn)
+
+	epoch := types.Epoch(c.Uint64(flags.ExitEpochFlag.Name))
+	if !c.IsSet(flags.ExitEpochFlag.Name) {
+		genesis, err := nodeClient.GetGenesis(ctx, &empty.Empty{})
+		if err != nil {
+			return nil, nil, nil, errors.Wrap(err, "gRPC call to get genesis time failed")
+		}
+		epoch = slots.ToEpoch(slots.CurrentSlot(uint64(genesis.GenesisTime.AsTime().Unix())))
+	}
+	domain, err := validatorClient.DomainData(ctx, &ethpb.DomainRequest{
+		Epoch:  epoch,
+		Domain: params.BeaconConfig().DomainVoluntaryExit[:],
+	})
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(err, "gRPC call to get domain data failed")
+	}
+
+	exits := make([]*ethpb.VoluntaryExit, len(targets))
+	pubKeys := make([][]byte, len(targets))
+	var indexedKeys map[types.ValidatorIndex][]byte
+	for i, target := range targets {
+		pubKey := target.PubKey
+		index := target.Index
+		switch {
+		case pubKey != nil && !target.HasIndex:
+			resp, err := validatorClient.ValidatorIndex(ctx, &ethpb.ValidatorIndexRequest{PublicKey: pubKey})
+			if err != nil {
+				return nil, nil, nil, errors.Wrapf(err, "could not get validator index of public key %#x", pubKey)
+			}
+			index = resp.Index
+		case pubKey == nil:
+			if indexedKeys == nil {
+				indexedKeys = validatorIndices(ctx, validatorClient, validatingPublicKeys)
+			}
+			var ok bool
+			if pubKey, ok = indexedKeys[index]; !ok {
+				return nil, nil, nil, errors.Errorf("validator %d is not in the wallet", index)
+			}
+		}
+		if !hasPublicKey(validatingPublicKeys, pubKey) {
+			return nil, nil, nil, errors.Errorf("public key %#x is not in the wallet", pubKey)
+		}
+		exits[i] = &ethpb.VoluntaryExit{Epoch: epoch, ValidatorIndex: index}
+		pubKeys[i] = pubKey
+	}
+	return exits, pubKeys, domain.SignatureDomain, nil
+}
+
+// Returns the public keys of the wallet by validator index. Keys without a validator index are skipped.
+func validatorIndices(
+	ctx context.Context, validatorClient ethpb.BeaconNodeValidatorClient, validatingPublicKeys [][fieldparams.BLSPubkeyLength]byte,
+) map[types.ValidatorIndex][]byte {
+	indexedKeys := make(map[types.ValidatorIndex][]byte, len(validatingPublicKeys))
+	for _, key := range validatingPublicKeys {
+		pubKey := bytesutil.SafeCopyBytes(key[:])
+		resp, err := validatorClient.ValidatorIndex(ctx, &ethpb.ValidatorIndexRequest{PublicKey: pubKey})
+		if err != nil {
+			log.WithError(err).Debugf("Could not get validator index of public key %#x", pubKey)
+			continue
+		}
+		indexedKeys[resp.Index] = pubKey
+	}
+	return indexedKeys
+}
+
+func hasPublicKey(validatingPublicKeys [][fieldparams.BLSPubkeyLength]byte, pubKey []byte) bool {
+	for _, key := range validatingPublicKeys {
+		if bytes.Equal(key[:], pubKey) {
+			return true
+		}
+	}
+	return false
+}
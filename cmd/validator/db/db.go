@@ -31,6 +31,22 @@ var Commands = &cli.Command{
 				return nil
 			},
 		},
+		{
+			Name: "compact",
+			Description: `rewrites the slashing protection history of the database so that it keeps only ` +
+				`the highest signed source and target epochs and the last signed proposal of each key, and ` +
+				`switches the database to the watermark-only storage mode. The full history cannot be recovered afterwards`,
+			Flags: cmd.WrapFlags([]cli.Flag{
+				cmd.DataDirFlag,
+			}),
+			Before: tos.VerifyTosAcceptedOrPrompt,
+			Action: func(cliCtx *cli.Context) error {
+				if err := validatordb.Compact(cliCtx); err != nil {
+					log.WithError(err).Fatal("Could not compact database")
+				}
+				return nil
+			},
+		},
 		{
 			Name:     "migrate",
 			Category: "db",
@@ -0,0 +1,8 @@
+//go:build !debug
+
+package flags
+
+import "github.com/urfave/cli/v2"
+
+// DebugFlags are the flags only registered in builds with the debug tag, such as the end to end tests.
+var DebugFlags []cli.Flag
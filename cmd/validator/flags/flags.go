@@ -31,9 +31,15 @@ var (
 	// BeaconRPCProviderFlag defines a beacon node RPC endpoint.
 	BeaconRPCProviderFlag = &cli.StringFlag{
 		Name:  "beacon-rpc-provider",
-		Usage: "Beacon node RPC provider endpoint",
+		Usage: "Beacon node RPC provider endpoint. Multiple comma-separated endpoints may be given, in which case duties fail over to the healthiest beacon node",
 		Value: "127.0.0.1:4000",
 	}
+	// BeaconRPCBroadcastFlag enables submitting signed messages to every beacon node RPC endpoint.
+	BeaconRPCBroadcastFlag = &cli.BoolFlag{
+		Name: "beacon-rpc-broadcast",
+		Usage: "Submits signed attestations, aggregates, sync committee messages and blocks to every beacon node " +
+			"given by --beacon-rpc-provider at once. Duties and data are still fetched from the primary beacon node.",
+	}
 	// BeaconRPCGatewayProviderFlag defines a beacon node JSON-RPC endpoint.
 	BeaconRPCGatewayProviderFlag = &cli.StringFlag{
 		Name:  "beacon-rpc-gateway-provider",
@@ -81,8 +87,9 @@ var (
 	}
 	// GraffitiFlag defines the graffiti value included in proposed blocks
 	GraffitiFlag = &cli.StringFlag{
-		Name:  "graffiti",
-		Usage: "String to include in proposed blocks",
+		Name: "graffiti",
+		Usage: "String to include in proposed blocks. It may be a template with the fields of the proposal, " +
+			"such as \"{{.CL}}/{{.EL}} {{.Index}}\". Graffiti set in the proposer settings takes precedence over this flag",
 	}
 	// GrpcRetriesFlag defines the number of times to retry a failed gRPC request.
 	GrpcRetriesFlag = &cli.UintFlag{
@@ -214,6 +221,34 @@ var (
 		Name:  "exit-all",
 		Usage: "Exit all validators. This will still require the staker to confirm a userprompt for the action",
 	}
+	// VoluntaryExitListFileFlag defines a path to a file listing the validators to exit non-interactively.
+	VoluntaryExitListFileFlag = &cli.StringFlag{
+		Name: "exit-list-file",
+		Usage: "Path to a file listing the validators to exit, one per line, as a validator index, a public key " +
+			"hex string or both separated by a comma. Signed voluntary exits are written to --output-dir " +
+			"instead of being submitted, to be broadcast later",
+	}
+	// SignedMessagesOutputDirFlag defines the directory signed messages are written to.
+	SignedMessagesOutputDirFlag = &cli.StringFlag{
+		Name:  "output-dir",
+		Usage: "Directory to write signed messages to",
+		Value: ".",
+	}
+	// ForkVersionFlag defines the fork version used to sign messages without a beacon node.
+	ForkVersionFlag = &cli.StringFlag{
+		Name:  "fork-version",
+		Usage: "Hex encoded fork version to sign messages with, without connecting to a beacon node",
+	}
+	// GenesisValidatorsRootFlag defines the genesis validators root used to sign messages without a beacon node.
+	GenesisValidatorsRootFlag = &cli.StringFlag{
+		Name:  "genesis-validators-root",
+		Usage: "Hex encoded genesis validators root of the network to sign messages with, without connecting to a beacon node",
+	}
+	// ExitEpochFlag defines the epoch of signed voluntary exits.
+	ExitEpochFlag = &cli.Uint64Flag{
+		Name:  "exit-epoch",
+		Usage: "Epoch of the signed voluntary exits. Defaults to the current epoch when connected to a beacon node",
+	}
 	// BackupPasswordFile for encrypting accounts a user wishes to back up.
 	BackupPasswordFile = &cli.StringFlag{
 		Name:  "backup-password-file",
@@ -238,8 +273,9 @@ var (
 	}
 	// GrpcRemoteAddressFlag defines the host:port address for a remote keymanager to connect to.
 	GrpcRemoteAddressFlag = &cli.StringFlag{
-		Name:  "grpc-remote-address",
-		Usage: "Host:port of a gRPC server for a remote keymanager",
+		Name: "grpc-remote-address",
+		Usage: "Host:port of a gRPC server for a remote keymanager. Multiple comma-separated addresses " +
+			"may be provided, in which case the remote keymanager fails over to the next address when a server is unreachable",
 		Value: "",
 	}
 	// DisableRemoteSignerTlsFlag disables TLS when connecting to a remote signer.
@@ -287,6 +323,21 @@ var (
 		Usage: "comma separated list of public keys OR an external url endpoint for the validator to retrieve public keys from for usage with web3signer",
 	}
 
+	// Web3SignerKeyRefreshIntervalFlag defines how often the public keys are polled from the external url set
+	// with --validators-external-signer-public-keys, so keys added or removed on web3signer are picked up.
+	// example: --validators-external-signer-key-refresh-interval=5m
+	Web3SignerKeyRefreshIntervalFlag = &cli.DurationFlag{
+		Name:  "validators-external-signer-key-refresh-interval",
+		Usage: "Interval at which to poll the external url of --validators-external-signer-public-keys for added or removed public keys. Polling is disabled if unset",
+	}
+
+	// Web3SignerSlashingProtectionFlag delegates slashing protection to web3signer. Signing is refused while web3signer
+	// does not report a healthy slashing protection database, and the local slashing protection history is still checked.
+	Web3SignerSlashingProtectionFlag = &cli.BoolFlag{
+		Name:  "validators-external-signer-slashing-protection",
+		Usage: "Requires web3signer to enforce its own slashing protection, refusing to sign while it is disabled or unhealthy. Local slashing protection is still applied as a second check",
+	}
+
 	// KeymanagerKindFlag defines the kind of keymanager desired by a user during wallet creation.
 	KeymanagerKindFlag = &cli.StringFlag{
 		Name:  "keymanager-kind",
@@ -312,10 +363,44 @@ var (
 		Usage: "Allows users to specify the output directory to export their slashing protection EIP-3076 standard JSON File",
 		Value: "",
 	}
+	// SlashingProtectionExportPublicKeysFlag limits a slashing protection history export to a set of public keys.
+	SlashingProtectionExportPublicKeysFlag = &cli.StringFlag{
+		Name:  "slashing-protection-export-public-keys",
+		Usage: "Comma-separated list of hex-encoded validator public keys to export the slashing protection history of. All keys are exported if unset",
+	}
+	// SlashingProtectionExportStartEpochFlag sets the first epoch of a slashing protection history export.
+	SlashingProtectionExportStartEpochFlag = &cli.Uint64Flag{
+		Name:  "slashing-protection-export-start-epoch",
+		Usage: "Only exports blocks and attestations at or after this epoch, using the target epoch of attestations",
+	}
+	// SlashingProtectionExportEndEpochFlag sets the last epoch of a slashing protection history export.
+	SlashingProtectionExportEndEpochFlag = &cli.Uint64Flag{
+		Name:  "slashing-protection-export-end-epoch",
+		Usage: "Only exports blocks and attestations at or before this epoch, using the target epoch of attestations. No upper bound if unset",
+	}
+	// SlashingProtectionExportMinimalFlag exports only the watermarks of each key.
+	SlashingProtectionExportMinimalFlag = &cli.BoolFlag{
+		Name: "slashing-protection-export-minimal",
+		Usage: "Only exports the lowest and highest signed slots and epochs of each key, without signing roots. " +
+			"Import such a file with --slashing-protection-merge",
+	}
+	// SlashingProtectionMergeFlag merges an imported slashing protection history with the existing one.
+	SlashingProtectionMergeFlag = &cli.BoolFlag{
+		Name: "slashing-protection-merge",
+		Usage: "Merges the imported slashing protection history with the existing one instead of rejecting keys with " +
+			"conflicting history. Conflicting blocks and attestations are kept without signing roots, and the validator " +
+			"refuses to sign at or below the highest imported slot and epochs",
+	}
+	// SlashingProtectionMergeFilesFlag is used to enter the slashing protection JSON files to combine.
+	SlashingProtectionMergeFilesFlag = &cli.StringSliceFlag{
+		Name:  "slashing-protection-merge-files",
+		Usage: "Paths to the EIP-3076 compliant JSON files to combine into a single slashing protection history file",
+	}
 	// GraffitiFileFlag specifies the file path to load graffiti values.
 	GraffitiFileFlag = &cli.StringFlag{
-		Name:  "graffiti-file",
-		Usage: "The path to a YAML file with graffiti values",
+		Name: "graffiti-file",
+		Usage: "The path to a YAML file with graffiti values, which may be templates with the fields of the proposal. " +
+			"Graffiti of a validator in this file takes precedence over the default graffiti of the proposer settings",
 	}
 	// ProposerSettingsFlag defines the path or URL to a file with proposer config.
 	ProposerSettingsFlag = &cli.StringFlag{
@@ -330,6 +415,26 @@ var (
 		Value: "",
 	}
 
+	// ProposerSettingsReloadFlag enables reloading the proposer settings while the validator client is running.
+	ProposerSettingsReloadFlag = &cli.BoolFlag{
+		Name: "proposer-settings-reload",
+		Usage: "Applies changes to the file of --" + ProposerSettingsFlag.Name + " or the URL of --" + ProposerSettingsURLFlag.Name +
+			" without a restart. Changes made through the keymanager API are written back to the proposer settings file",
+	}
+
+	// ProposerSettingsURLPollIntervalFlag defines how often the proposer settings are polled from the URL of --proposer-settings-url.
+	ProposerSettingsURLPollIntervalFlag = &cli.DurationFlag{
+		Name:  "proposer-settings-url-poll-interval",
+		Usage: "Interval at which the URL of --" + ProposerSettingsURLFlag.Name + " is polled for changes when --proposer-settings-reload is set",
+		Value: time.Minute,
+	}
+
+	// ProposerSettingsAuditLogFlag defines the file every change to the proposer settings is appended to.
+	ProposerSettingsAuditLogFlag = &cli.StringFlag{
+		Name:  "proposer-settings-audit-log",
+		Usage: "Appends every change to the proposer settings, from reloads or the keymanager API, to this file as a JSON line when --proposer-settings-reload is set",
+	}
+
 	// SuggestedFeeRecipientFlag defines the address of the fee recipient.
 	SuggestedFeeRecipientFlag = &cli.StringFlag{
 		Name: "suggested-fee-recipient",
@@ -353,6 +458,28 @@ var (
 		Usage: "Sets gas limit for the builder to use for constructing a payload for all the validators",
 		Value: fmt.Sprint(params.BeaconConfig().DefaultBuilderGasLimit),
 	}
+
+	// AttestationMaxBlockWaitFlag defines how long attesters wait for the block of the slot.
+	AttestationMaxBlockWaitFlag = &cli.DurationFlag{
+		Name: "attestation-max-block-wait",
+		Usage: "Maximum time into the slot attesters and sync committee members wait for the block of the slot " +
+			"before signing the head (uses duration format, ex: 3s). Defaults to a third of the slot",
+	}
+
+	// AggregationSpreadFlag defines the window the aggregation and sync contribution submissions are spread over.
+	AggregationSpreadFlag = &cli.DurationFlag{
+		Name: "aggregation-spread",
+		Usage: "Spreads the aggregate and sync committee contribution submissions of the validators over this duration " +
+			"after two thirds of the slot, each validator being assigned a fixed offset in the window (uses duration format, ex: 1s)",
+	}
+
+	// InjectDutyDelayFlag delays every duty of the validator client, for testing only. It is only
+	// registered in builds with the debug tag.
+	InjectDutyDelayFlag = &cli.DurationFlag{
+		Name:   "inject-duty-delay",
+		Usage:  "Testing only: delays every duty of the validator client to simulate a slow or clock-skewed validator client",
+		Hidden: true,
+	}
 )
 
 // DefaultValidatorDir returns OS-specific default validator directory.
@@ -48,6 +48,7 @@ func startNode(ctx *cli.Context) error {
 
 var appFlags = []cli.Flag{
 	flags.BeaconRPCProviderFlag,
+	flags.BeaconRPCBroadcastFlag,
 	flags.BeaconRPCGatewayProviderFlag,
 	flags.CertFlag,
 	flags.GraffitiFlag,
@@ -74,11 +75,18 @@ var appFlags = []cli.Flag{
 	// Consensys' Web3Signer flags
 	flags.Web3SignerURLFlag,
 	flags.Web3SignerPublicValidatorKeysFlag,
+	flags.Web3SignerKeyRefreshIntervalFlag,
+	flags.Web3SignerSlashingProtectionFlag,
 	flags.SuggestedFeeRecipientFlag,
 	flags.ProposerSettingsURLFlag,
+	flags.ProposerSettingsReloadFlag,
+	flags.ProposerSettingsURLPollIntervalFlag,
+	flags.ProposerSettingsAuditLogFlag,
 	flags.ProposerSettingsFlag,
 	flags.EnableBuilderFlag,
 	flags.BuilderGasLimitFlag,
+	flags.AttestationMaxBlockWaitFlag,
+	flags.AggregationSpreadFlag,
 	////////////////////
 	cmd.DisableMonitoringFlag,
 	cmd.MonitoringHostFlag,
@@ -112,6 +120,7 @@ var appFlags = []cli.Flag{
 }
 
 func init() {
+	appFlags = append(appFlags, flags.DebugFlags...)
 	appFlags = cmd.WrapFlags(append(appFlags, features.ValidatorFlags...))
 }
 
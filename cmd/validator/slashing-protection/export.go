@@ -4,10 +4,12 @@ import (
 	"encoding/json"
 	"fmt"
 	"path/filepath"
+	"strings"
 
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v3/cmd"
 	"github.com/prysmaticlabs/prysm/v3/cmd/validator/flags"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v3/io/file"
 	"github.com/prysmaticlabs/prysm/v3/validator/accounts/userprompt"
 	"github.com/prysmaticlabs/prysm/v3/validator/db/kv"
@@ -63,12 +65,19 @@ func exportSlashingProtectionJSON(cliCtx *cli.Context) error {
 			log.WithError(err).Errorf("Could not close validator DB")
 		}
 	}()
-	eipJSON, err := slashingprotection.ExportStandardProtectionJSON(cliCtx.Context, validatorDB)
+	opts, err := exportOptions(cliCtx)
+	if err != nil {
+		return err
+	}
+	eipJSON, err := slashingprotection.ExportStandardProtectionJSONWithOptions(cliCtx.Context, validatorDB, opts)
 	if err != nil {
 		return errors.Wrap(err, "could not export slashing protection history")
 	}
 
 	// Check if JSON data is empty and issue a warning about common problems to the user.
+	if (eipJSON == nil || len(eipJSON.Data) == 0) && len(opts.PublicKeys) > 0 {
+		return errors.New("no slashing protection data was found in your database for the specified public keys")
+	}
 	if eipJSON == nil || len(eipJSON.Data) == 0 {
 		log.Fatal(
 			"No slashing protection data was found in your database. This is likely because an older version of " +
@@ -115,3 +124,22 @@ func exportSlashingProtectionJSON(cliCtx *cli.Context) error {
 	)
 	return nil
 }
+
+// exportOptions parses the public keys, epoch window and format of a slashing protection history export.
+func exportOptions(cliCtx *cli.Context) (*slashingprotection.ExportOptions, error) {
+	opts := &slashingprotection.ExportOptions{
+		StartEpoch: types.Epoch(cliCtx.Uint64(flags.SlashingProtectionExportStartEpochFlag.Name)),
+		EndEpoch:   types.Epoch(cliCtx.Uint64(flags.SlashingProtectionExportEndEpochFlag.Name)),
+		Minimal:    cliCtx.Bool(flags.SlashingProtectionExportMinimalFlag.Name),
+	}
+	if keys := cliCtx.String(flags.SlashingProtectionExportPublicKeysFlag.Name); keys != "" {
+		for _, key := range strings.Split(keys, ",") {
+			pubKey, err := slashingprotection.PubKeyFromHex(strings.TrimSpace(key))
+			if err != nil {
+				return nil, errors.Wrapf(err, "%s is not a valid public key", key)
+			}
+			opts.PublicKeys = append(opts.PublicKeys, pubKey[:])
+		}
+	}
+	return opts, nil
+}
@@ -71,6 +71,13 @@ func importSlashingProtectionJSON(cliCtx *cli.Context) error {
 	}
 	log.Infof("Starting import of slashing protection file %s", protectionFilePath)
 	buf := bytes.NewBuffer(enc)
+	if cliCtx.Bool(flags.SlashingProtectionMergeFlag.Name) {
+		if err := slashingprotection.MergeStandardProtectionJSON(cliCtx.Context, valDB, buf); err != nil {
+			return err
+		}
+		log.Infof("Slashing protection JSON successfully merged into %s", dataDir)
+		return nil
+	}
 	if err := slashingprotection.ImportStandardProtectionJSON(
 		cliCtx.Context, valDB, buf,
 	); err != nil {
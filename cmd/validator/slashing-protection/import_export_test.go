@@ -3,6 +3,7 @@ package historycmd
 import (
 	"encoding/json"
 	"flag"
+	"fmt"
 	"path/filepath"
 	"testing"
 
@@ -29,6 +30,7 @@ func setupCliCtx(
 	set.String(cmd.DataDirFlag.Name, dbPath, "")
 	set.String(flags.SlashingProtectionJSONFileFlag.Name, protectionFilePath, "")
 	set.String(flags.SlashingProtectionExportDirFlag.Name, outputDir, "")
+	set.Bool(flags.SlashingProtectionMergeFlag.Name, false, "")
 	require.NoError(tb, set.Set(flags.SlashingProtectionJSONFileFlag.Name, protectionFilePath))
 	assert.NoError(tb, set.Set(cmd.DataDirFlag.Name, dbPath))
 	assert.NoError(tb, set.Set(flags.SlashingProtectionExportDirFlag.Name, outputDir))
@@ -176,3 +178,44 @@ func TestImportExportSlashingProtectionCli_EmptyData(t *testing.T) {
 		require.DeepEqual(t, make([]*format.SignedAttestation, 0), item.SignedAttestations)
 	}
 }
+
+func TestMergeSlashingProtectionCli(t *testing.T) {
+	outputPath := filepath.Join(t.TempDir(), "slashing-exports")
+	require.NoError(t, file.MkdirAll(outputPath))
+
+	// Each machine has signed with a different set of keys.
+	pubKeys, err := mocks.CreateRandomPubKeys(4)
+	require.NoError(t, err)
+	attestingHistory, proposalHistory := mocks.MockAttestingAndProposalHistories(pubKeys)
+	paths := make([]string, 2)
+	for i := range paths {
+		mockJSON, err := mocks.MockSlashingProtectionJSON(pubKeys[2*i:2*i+2], attestingHistory[2*i:2*i+2], proposalHistory[2*i:2*i+2])
+		require.NoError(t, err)
+		encoded, err := json.Marshal(mockJSON)
+		require.NoError(t, err)
+		paths[i] = filepath.Join(outputPath, fmt.Sprintf("machine_%d.json", i))
+		require.NoError(t, file.WriteFile(paths[i], encoded))
+	}
+
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	mergeFiles := cli.NewStringSlice(paths...)
+	set.Var(mergeFiles, flags.SlashingProtectionMergeFilesFlag.Name, "")
+	set.String(flags.SlashingProtectionExportDirFlag.Name, outputPath, "")
+	require.NoError(t, set.Set(flags.SlashingProtectionExportDirFlag.Name, outputPath))
+	require.NoError(t, mergeSlashingProtectionJSON(cli.NewContext(&app, set, nil)))
+
+	enc, err := file.ReadFileAsBytes(filepath.Join(outputPath, jsonMergeFileName))
+	require.NoError(t, err)
+	merged := &format.EIPSlashingProtectionFormat{}
+	require.NoError(t, json.Unmarshal(enc, merged))
+	require.Equal(t, len(pubKeys), len(merged.Data))
+
+	// The merged file is imported by merging it with the existing history.
+	validatorDB := dbTest.SetupDB(t, pubKeys)
+	dbPath := validatorDB.DatabasePath()
+	require.NoError(t, validatorDB.Close())
+	cliCtx := setupCliCtx(t, dbPath, filepath.Join(outputPath, jsonMergeFileName), outputPath)
+	require.NoError(t, cliCtx.Set(flags.SlashingProtectionMergeFlag.Name, "true"))
+	require.NoError(t, importSlashingProtectionJSON(cliCtx))
+}
@@ -0,0 +1,89 @@
+package historycmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v3/cmd/validator/flags"
+	"github.com/prysmaticlabs/prysm/v3/io/file"
+	"github.com/prysmaticlabs/prysm/v3/validator/accounts/userprompt"
+	slashingprotection "github.com/prysmaticlabs/prysm/v3/validator/slashing-protection-history"
+	"github.com/prysmaticlabs/prysm/v3/validator/slashing-protection-history/format"
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	jsonMergeFileName = "slashing_protection_merged.json"
+)
+
+// Combines several EIP-3076 standard JSON files, such as exports from
+// different machines, into a single file which can be imported with the
+// slashing-protection-history import command.
+//
+// Steps:
+// 1. Read the JSON files given by the CLI context.
+// 2. Merge their slashing protection histories by public key.
+// 3. Format and save the JSON file to a user's specified output directory.
+func mergeSlashingProtectionJSON(cliCtx *cli.Context) error {
+	paths := cliCtx.StringSlice(flags.SlashingProtectionMergeFilesFlag.Name)
+	if len(paths) < 2 {
+		return errors.Errorf(
+			"at least two slashing protection files must be specified with the %s flag",
+			flags.SlashingProtectionMergeFilesFlag.Name,
+		)
+	}
+	files := make([]*format.EIPSlashingProtectionFormat, len(paths))
+	for i, path := range paths {
+		enc, err := file.ReadFileAsBytes(path)
+		if err != nil {
+			return errors.Wrapf(err, "could not read slashing protection file %s", path)
+		}
+		files[i] = &format.EIPSlashingProtectionFormat{}
+		if err := json.NewDecoder(bytes.NewReader(enc)).Decode(files[i]); err != nil {
+			return errors.Wrapf(err, "could not unmarshal slashing protection file %s", path)
+		}
+	}
+	merged, err := slashingprotection.MergeProtectionFiles(files...)
+	if err != nil {
+		return errors.Wrap(err, "could not merge slashing protection files")
+	}
+
+	outputDir, err := userprompt.InputDirectory(
+		cliCtx,
+		"Enter your desired output directory for your merged slashing protection history file",
+		flags.SlashingProtectionExportDirFlag,
+	)
+	if err != nil {
+		return errors.Wrap(err, "could not get output directory")
+	}
+	if outputDir == "" {
+		return errors.New("output directory not specified")
+	}
+	exists, err := file.HasDir(outputDir)
+	if err != nil {
+		return errors.Wrapf(err, "could not check if output directory %s already exists", outputDir)
+	}
+	if !exists {
+		if err := file.MkdirAll(outputDir); err != nil {
+			return errors.Wrapf(err, "could not create output directory %s", outputDir)
+		}
+	}
+	outputFilePath := filepath.Join(outputDir, jsonMergeFileName)
+	encoded, err := json.MarshalIndent(merged, "", "\t")
+	if err != nil {
+		return errors.Wrap(err, "could not JSON marshal slashing protection history")
+	}
+	if err := file.WriteFile(outputFilePath, encoded); err != nil {
+		return errors.Wrapf(err, "could not write file to path %s", outputFilePath)
+	}
+	log.Infof(
+		"Successfully merged %d slashing protection files into %s. You can import this file using Prysm's "+
+			"validator slashing-protection-history import command with the --%s flag",
+		len(paths),
+		outputFilePath,
+		flags.SlashingProtectionMergeFlag.Name,
+	)
+	return nil
+}
@@ -21,6 +21,10 @@ var Commands = &cli.Command{
 			Flags: cmd.WrapFlags([]cli.Flag{
 				cmd.DataDirFlag,
 				flags.SlashingProtectionExportDirFlag,
+				flags.SlashingProtectionExportPublicKeysFlag,
+				flags.SlashingProtectionExportStartEpochFlag,
+				flags.SlashingProtectionExportEndEpochFlag,
+				flags.SlashingProtectionExportMinimalFlag,
 				features.Mainnet,
 				features.PraterTestnet,
 				features.RopstenTestnet,
@@ -49,6 +53,7 @@ var Commands = &cli.Command{
 			Flags: cmd.WrapFlags([]cli.Flag{
 				cmd.DataDirFlag,
 				flags.SlashingProtectionJSONFileFlag,
+				flags.SlashingProtectionMergeFlag,
 				features.Mainnet,
 				features.PraterTestnet,
 				features.RopstenTestnet,
@@ -72,5 +77,26 @@ var Commands = &cli.Command{
 				return nil
 			},
 		},
+		{
+			Name:        "merge",
+			Description: `combines EIP-3076 compliant slashing protection JSON files, such as exports from several machines, into a single file`,
+			Flags: cmd.WrapFlags([]cli.Flag{
+				flags.SlashingProtectionMergeFilesFlag,
+				flags.SlashingProtectionExportDirFlag,
+				cmd.AcceptTosFlag,
+			}),
+			Before: func(cliCtx *cli.Context) error {
+				if err := cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags); err != nil {
+					return err
+				}
+				return tos.VerifyTosAcceptedOrPrompt(cliCtx)
+			},
+			Action: func(cliCtx *cli.Context) error {
+				if err := mergeSlashingProtectionJSON(cliCtx); err != nil {
+					logrus.Fatalf("Could not merge slashing protection files: %v", err)
+				}
+				return nil
+			},
+		},
 	},
 }
@@ -85,6 +85,7 @@ var appHelpFlagGroups = []flagGroup{
 		Name: "validator",
 		Flags: []cli.Flag{
 			flags.BeaconRPCProviderFlag,
+			flags.BeaconRPCBroadcastFlag,
 			flags.BeaconRPCGatewayProviderFlag,
 			flags.CertFlag,
 			flags.EnableWebFlag,
@@ -107,11 +108,18 @@ var appHelpFlagGroups = []flagGroup{
 			flags.GraffitiFileFlag,
 			flags.Web3SignerURLFlag,
 			flags.Web3SignerPublicValidatorKeysFlag,
+			flags.Web3SignerKeyRefreshIntervalFlag,
+			flags.Web3SignerSlashingProtectionFlag,
 			flags.ProposerSettingsFlag,
 			flags.ProposerSettingsURLFlag,
+			flags.ProposerSettingsReloadFlag,
+			flags.ProposerSettingsURLPollIntervalFlag,
+			flags.ProposerSettingsAuditLogFlag,
 			flags.SuggestedFeeRecipientFlag,
 			flags.EnableBuilderFlag,
 			flags.BuilderGasLimitFlag,
+			flags.AttestationMaxBlockWaitFlag,
+			flags.AggregationSpreadFlag,
 		},
 	},
 	{
@@ -128,6 +136,9 @@ var appHelpFlagGroups = []flagGroup{
 }
 
 func init() {
+	if len(flags.DebugFlags) > 0 {
+		appHelpFlagGroups = append(appHelpFlagGroups, flagGroup{Name: "debug", Flags: flags.DebugFlags})
+	}
 	cli.AppHelpTemplate = appHelpTemplate
 
 	type helpData struct {
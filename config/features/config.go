@@ -60,6 +60,8 @@ type Flags struct {
 	EnableSlasher bool // Enable slasher in the beacon node runtime.
 	// EnableSlashingProtectionPruning for the validator client.
 	EnableSlashingProtectionPruning bool
+	// EnableSlashingProtectionWatermarks for the validator client.
+	EnableSlashingProtectionWatermarks bool
 
 	DisablePullTips                   bool // DisablePullTips disables experimental disabling of boundary checks.
 	EnableDefensivePull               bool // EnableDefensivePull enables exerimental back boundary checks.
@@ -286,6 +288,10 @@ func ConfigureValidator(ctx *cli.Context) error {
 		logEnabled(enableSlashingProtectionPruning)
 		cfg.EnableSlashingProtectionPruning = true
 	}
+	if ctx.Bool(enableSlashingProtectionWatermarks.Name) {
+		logEnabled(enableSlashingProtectionWatermarks)
+		cfg.EnableSlashingProtectionWatermarks = true
+	}
 	if ctx.Bool(enableDoppelGangerProtection.Name) {
 		logEnabled(enableDoppelGangerProtection)
 		cfg.EnableDoppelGanger = true
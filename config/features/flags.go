@@ -77,6 +77,12 @@ var (
 		Name:  "enable-slashing-protection-history-pruning",
 		Usage: "Enables the pruning of the validator client's slashing protection database",
 	}
+	enableSlashingProtectionWatermarks = &cli.BoolFlag{
+		Name: "enable-slashing-protection-watermarks-only",
+		Usage: "Keeps only the highest signed source and target epochs and the last signed proposal of each key " +
+			"in the validator client's slashing protection database. (Warning): The full slashing protection " +
+			"history is dropped and the database cannot be switched back",
+	}
 	enableDoppelGangerProtection = &cli.BoolFlag{
 		Name: "enable-doppelganger",
 		Usage: "Enables the validator to perform a doppelganger check. (Warning): This is not " +
@@ -144,6 +150,7 @@ var ValidatorFlags = append(deprecatedFlags, []cli.Flag{
 	dynamicKeyReloadDebounceInterval,
 	attestTimely,
 	enableSlashingProtectionPruning,
+	enableSlashingProtectionWatermarks,
 	enableDoppelGangerProtection,
 }...)
 
@@ -202,8 +202,20 @@ type BeaconChainConfig struct {
 	DefaultBuilderGasLimit           uint64         // DefaultBuilderGasLimit is the default used to set the gaslimit for the Builder APIs, typically at around 30M wei.
 
 	// Mev-boost circuit breaker
-	MaxBuilderConsecutiveMissedSlots types.Slot // MaxBuilderConsecutiveMissedSlots defines the number of consecutive skip slot to fallback from using relay/builder to local execution engine for block construction.
-	MaxBuilderEpochMissedSlots       types.Slot // MaxBuilderEpochMissedSlots is defines the number of total skip slot (per epoch rolling windows) to fallback from using relay/builder to local execution engine for block construction.
+	MaxBuilderConsecutiveMissedSlots types.Slot  // MaxBuilderConsecutiveMissedSlots defines the number of consecutive skip slot to fallback from using relay/builder to local execution engine for block construction.
+	MaxBuilderEpochMissedSlots       types.Slot  // MaxBuilderEpochMissedSlots is defines the number of total skip slot (per epoch rolling windows) to fallback from using relay/builder to local execution engine for block construction.
+	MaxBuilderEpochsSinceFinality    types.Epoch // MaxBuilderEpochsSinceFinality defines the number of epochs since the finalized checkpoint to fallback from using relay/builder to local execution engine for block construction.
+	MaxBuilderReorgDepth             uint64      // MaxBuilderReorgDepth defines the depth of a chain reorg in the last epoch to fallback from using relay/builder to local execution engine for block construction.
+	MaxBuilderRelayFailureRate       uint64      // MaxBuilderRelayFailureRate defines the percentage of recent failed relay calls to fallback from using relay/builder to local execution engine for block construction.
+
+	// Mev-boost bid selection
+	LocalBlockValueBoost uint64 // LocalBlockValueBoost is the percentage added to the value of the local execution payload before comparing it with the builder bid.
+	MinBuilderBid        uint64 // MinBuilderBid is the minimum value in gwei of a builder bid for it to be used over the local execution payload.
+
+	// Late block proposer reorg
+	ReorgHeadWeightThreshold        uint64      // ReorgHeadWeightThreshold defines the percentage of the committee weight below which a late head block is considered weak enough to be reorged by the next proposer.
+	ReorgParentWeightThreshold      uint64      // ReorgParentWeightThreshold defines the percentage of the committee weight the parent of a late head block must exceed for the next proposer to build on it.
+	ReorgMaxEpochsSinceFinalization types.Epoch // ReorgMaxEpochsSinceFinalization defines the number of epochs since the finalized checkpoint after which late head blocks are no longer reorged.
 
 	// Execution engine timeout value
 	ExecutionEngineTimeoutValue uint64 // ExecutionEngineTimeoutValue defines the seconds to wait before timing out engine endpoints with execution payload execution semantics (newPayload, forkchoiceUpdated).
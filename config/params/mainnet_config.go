@@ -255,6 +255,18 @@ var mainnetBeaconConfig = &BeaconChainConfig{
 	// Mevboost circuit breaker
 	MaxBuilderConsecutiveMissedSlots: 3,
 	MaxBuilderEpochMissedSlots:       8,
+	MaxBuilderEpochsSinceFinality:    4,
+	MaxBuilderReorgDepth:             2,
+	MaxBuilderRelayFailureRate:       50,
+
+	// Mevboost bid selection
+	LocalBlockValueBoost: 0,
+	MinBuilderBid:        0,
+
+	// Late block proposer reorg
+	ReorgHeadWeightThreshold:        20,
+	ReorgParentWeightThreshold:      160,
+	ReorgMaxEpochsSinceFinalization: 2,
 
 	// Execution engine timeout value
 	ExecutionEngineTimeoutValue: 8, // 8 seconds default based on: https://github.com/ethereum/execution-apis/blob/main/src/engine/specification.md#core
@@ -4,6 +4,7 @@ import (
 	"strconv"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v3/config/params"
 )
@@ -18,9 +19,13 @@ type ProposerSettingsPayload struct {
 
 // ProposerOptionPayload is the struct representation of the JSON config file set in the validator through the CLI.
 // FeeRecipient is set to an eth address in hex string format with 0x prefix.
+// Graffiti is an optional graffiti template used for the blocks proposed by the validator.
+// Timing optionally shifts the duties of the validator within the slot.
 type ProposerOptionPayload struct {
 	FeeRecipient  string         `json:"fee_recipient" yaml:"fee_recipient"`
 	BuilderConfig *BuilderConfig `json:"builder" yaml:"builder"`
+	Graffiti      string         `json:"graffiti,omitempty" yaml:"graffiti,omitempty"`
+	Timing        *TimingConfig  `json:"timing,omitempty" yaml:"timing,omitempty"`
 }
 
 // BuilderConfig is the struct representation of the JSON config file set in the validator through the CLI.
@@ -31,6 +36,15 @@ type BuilderConfig struct {
 	Relays   []string `json:"relays" yaml:"relays"`
 }
 
+// TimingConfig is the struct representation of the JSON config file set in the validator through the CLI.
+// It shifts the duties of a validator relative to their default time in the slot, to spread the load of a
+// large key set over the slot. AttestationOffsetMs applies to attestations and sync committee messages,
+// and may be negative to sign earlier. ProposalDelayMs delays block proposals from the start of the slot.
+type TimingConfig struct {
+	AttestationOffsetMs int64  `json:"attestation_offset_ms,omitempty" yaml:"attestation_offset_ms,omitempty"`
+	ProposalDelayMs     Uint64 `json:"proposal_delay_ms,omitempty" yaml:"proposal_delay_ms,omitempty"`
+}
+
 type Uint64 uint64
 
 func (u *Uint64) UnmarshalJSON(bs []byte) error {
@@ -73,6 +87,51 @@ type ProposerSettings struct {
 type ProposerOption struct {
 	FeeRecipient  common.Address
 	BuilderConfig *BuilderConfig
+	Graffiti      string
+	Timing        *TimingConfig
+}
+
+// ToPayload converts the proposer settings into the payload format of the proposer settings file,
+// so they can be written back to it.
+func (ps *ProposerSettings) ToPayload() *ProposerSettingsPayload {
+	if ps == nil {
+		return nil
+	}
+	payload := &ProposerSettingsPayload{
+		DefaultConfig: ps.DefaultConfig.ToPayload(),
+	}
+	if ps.ProposeConfig != nil {
+		payload.ProposerConfig = make(map[string]*ProposerOptionPayload, len(ps.ProposeConfig))
+		for pubkey, option := range ps.ProposeConfig {
+			payload.ProposerConfig[hexutil.Encode(pubkey[:])] = option.ToPayload()
+		}
+	}
+	return payload
+}
+
+// ToPayload converts the proposer option into the payload format of the proposer settings file.
+func (po *ProposerOption) ToPayload() *ProposerOptionPayload {
+	if po == nil {
+		return nil
+	}
+	payload := &ProposerOptionPayload{
+		FeeRecipient: po.FeeRecipient.Hex(),
+		Graffiti:     po.Graffiti,
+	}
+	if po.BuilderConfig != nil {
+		payload.BuilderConfig = &BuilderConfig{
+			Enabled:  po.BuilderConfig.Enabled,
+			GasLimit: po.BuilderConfig.GasLimit,
+		}
+		if po.BuilderConfig.Relays != nil {
+			payload.BuilderConfig.Relays = append([]string{}, po.BuilderConfig.Relays...)
+		}
+	}
+	if po.Timing != nil {
+		timing := *po.Timing
+		payload.Timing = &timing
+	}
+	return payload
 }
 
 // DefaultProposerOption returns a Proposer Option with defaults filled
@@ -11,6 +11,7 @@ package queue
 import (
 	"container/heap"
 	"errors"
+	"sort"
 	"sync"
 )
 
@@ -163,6 +164,20 @@ func (pq *PriorityQueue) RetrieveByKey(key string) *Item {
 	return pq.data[item.index]
 }
 
+// Items returns all the items in the queue, ordered by priority with the highest
+// priority first. The queue is not modified.
+func (pq *PriorityQueue) Items() []*Item {
+	pq.lock.RLock()
+	defer pq.lock.RUnlock()
+
+	items := make([]*Item, len(pq.data))
+	copy(items, pq.data)
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].Priority < items[j].Priority
+	})
+	return items
+}
+
 // Len returns the number of items in the queue data structure. Do not use this
 // method directly on the queue, use PriorityQueue.Len() instead.
 func (q queue) Len() int { return len(q) }
@@ -267,3 +267,24 @@ func testValidateInternalData(t *testing.T, pq *PriorityQueue, expectedSize int,
 		}
 	}
 }
+
+func TestPriorityQueue_Items(t *testing.T) {
+	pq := New()
+	require.Equal(t, 0, len(pq.Items()))
+
+	tc := testCases()
+	for _, i := range tc {
+		require.NoError(t, pq.Push(i))
+	}
+
+	items := pq.Items()
+	require.Equal(t, len(tc), len(items))
+	for i := 1; i < len(items); i++ {
+		if items[i-1].Priority > items[i].Priority {
+			t.Fatalf("expected items ordered by priority, got (%d) before (%d)", items[i-1].Priority, items[i].Priority)
+		}
+	}
+
+	// Listing the items does not modify the queue.
+	testValidateInternalData(t, pq, len(tc), false)
+}
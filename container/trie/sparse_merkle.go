@@ -5,6 +5,7 @@ import (
 	"bytes"
 	"encoding/binary"
 	"fmt"
+	"math/bits"
 
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v3/crypto/hash"
@@ -95,6 +96,88 @@ func GenerateTrieFromItems(items [][]byte, depth uint64) (*SparseMerkleTrie, err
 	}, nil
 }
 
+// GenerateTrieFromSubtreeRoots constructs a Merkle trie holding count items from the roots of
+// the largest full subtrees covering them, ordered from left to right, as found in an EIP-4881
+// deposit tree snapshot. The items covered by these subtrees are unknown, so the trie can only
+// be used to compute its root, insert new items and produce proofs for the new items.
+func GenerateTrieFromSubtreeRoots(roots [][]byte, count uint64, depth uint64) (*SparseMerkleTrie, error) {
+	if depth >= 64 {
+		return nil, errors.New("depth exceeds 64") // PowerOf2 would overflow.
+	}
+	if count > math.PowerOf2(depth) {
+		return nil, fmt.Errorf("%d items exceed the capacity of a trie of depth %d", count, depth)
+	}
+	if len(roots) != bits.OnesCount64(count) {
+		return nil, fmt.Errorf("wanted %d subtree roots for %d items but got %d", bits.OnesCount64(count), count, len(roots))
+	}
+	if count == 0 {
+		return NewTrie(depth)
+	}
+	// The subtree roots by layer, the largest subtree being the leftmost one.
+	rootByLayer := make(map[uint64][]byte, len(roots))
+	for i, r := 0, 0; i <= int(depth); i++ {
+		layer := depth - uint64(i)
+		if count&math.PowerOf2(layer) != 0 {
+			root := bytesutil.ToBytes32(roots[r])
+			rootByLayer[layer] = root[:]
+			r++
+		}
+	}
+	// Nodes under the subtree roots are unknown and left as zero hashes, all
+	// other nodes up to the root of the trie are computed.
+	items := make([][]byte, count)
+	for i := range items {
+		items[i] = ZeroHashes[0][:]
+	}
+	if root, ok := rootByLayer[0]; ok {
+		items[count-1] = root
+	}
+	layers := make([][][]byte, depth+1)
+	layers[0] = make([][]byte, count)
+	copy(layers[0], items)
+	for i := uint64(0); i < depth; i++ {
+		if len(layers[i])%2 == 1 {
+			layers[i] = append(layers[i], ZeroHashes[i][:])
+		}
+		updatedValues := make([][]byte, 0, len(layers[i])/2)
+		for j := 0; j < len(layers[i]); j += 2 {
+			parentIdx := uint64(j / 2)
+			if (parentIdx+1)*math.PowerOf2(i+1) <= count {
+				if root, ok := rootByLayer[i+1]; ok && parentIdx == count/math.PowerOf2(i+1)-1 {
+					updatedValues = append(updatedValues, root)
+				} else {
+					updatedValues = append(updatedValues, ZeroHashes[i+1][:])
+				}
+				continue
+			}
+			concat := hash.Hash(append(layers[i][j], layers[i][j+1]...))
+			updatedValues = append(updatedValues, concat[:])
+		}
+		layers[i+1] = updatedValues
+	}
+	return &SparseMerkleTrie{
+		branches:      layers,
+		originalItems: items,
+		depth:         uint(depth),
+	}, nil
+}
+
+// SubtreeRoots returns the roots of the largest full subtrees covering the items of the trie,
+// ordered from left to right. These are the finalized branches of an EIP-4881 deposit tree snapshot.
+func (m *SparseMerkleTrie) SubtreeRoots() [][]byte {
+	count := uint64(m.NumOfItems())
+	roots := make([][]byte, 0, bits.OnesCount64(count))
+	for i := int(m.depth); i >= 0; i-- {
+		layer := uint64(i)
+		if count&math.PowerOf2(layer) == 0 {
+			continue
+		}
+		root := bytesutil.ToBytes32(m.branches[layer][count/math.PowerOf2(layer)-1])
+		roots = append(roots, root[:])
+	}
+	return roots
+}
+
 // Items returns the original items passed in when creating the Merkle trie.
 func (m *SparseMerkleTrie) Items() [][]byte {
 	return m.originalItems
@@ -302,6 +302,58 @@ func TestCopy_OK(t *testing.T) {
 	require.DeepEqual(t, a, b)
 }
 
+func TestGenerateTrieFromSubtreeRoots(t *testing.T) {
+	depth := params.BeaconConfig().DepositContractTreeDepth
+	for _, count := range []int{0, 1, 2, 3, 7, 8, 13, 32, 37} {
+		t.Run(strconv.Itoa(count), func(t *testing.T) {
+			source, err := trie.NewTrie(depth)
+			require.NoError(t, err)
+			for i := 0; i < count; i++ {
+				item := hash.Hash([]byte(strconv.Itoa(i)))
+				require.NoError(t, source.Insert(item[:], i))
+			}
+			roots := source.SubtreeRoots()
+			snapshot, err := trie.GenerateTrieFromSubtreeRoots(roots, uint64(count), depth)
+			require.NoError(t, err)
+			assert.Equal(t, count, snapshot.NumOfItems())
+			assert.DeepEqual(t, roots, snapshot.SubtreeRoots())
+			wanted, err := source.HashTreeRoot()
+			require.NoError(t, err)
+			root, err := snapshot.HashTreeRoot()
+			require.NoError(t, err)
+			assert.Equal(t, wanted, root)
+
+			// Items inserted after the snapshot yield the same roots and proofs.
+			for i := count; i < count+5; i++ {
+				item := hash.Hash([]byte(strconv.Itoa(i)))
+				require.NoError(t, source.Insert(item[:], i))
+				require.NoError(t, snapshot.Insert(item[:], i))
+				wanted, err := source.HashTreeRoot()
+				require.NoError(t, err)
+				root, err := snapshot.HashTreeRoot()
+				require.NoError(t, err)
+				assert.Equal(t, wanted, root)
+				proof, err := snapshot.MerkleProof(i)
+				require.NoError(t, err)
+				wantedProof, err := source.MerkleProof(i)
+				require.NoError(t, err)
+				assert.DeepEqual(t, wantedProof, proof)
+				assert.Equal(t, true, trie.VerifyMerkleProof(root[:], item[:], uint64(i), proof))
+			}
+		})
+	}
+}
+
+func TestGenerateTrieFromSubtreeRoots_Invalid(t *testing.T) {
+	h := hash.Hash([]byte("hi"))
+	_, err := trie.GenerateTrieFromSubtreeRoots([][]byte{h[:]}, 3, 32)
+	require.ErrorContains(t, "wanted 2 subtree roots for 3 items but got 1", err)
+	_, err = trie.GenerateTrieFromSubtreeRoots([][]byte{h[:]}, 8, 2)
+	require.ErrorContains(t, "8 items exceed the capacity of a trie of depth 2", err)
+	_, err = trie.GenerateTrieFromSubtreeRoots([][]byte{h[:]}, 1, 64)
+	require.ErrorContains(t, "depth exceeds 64", err)
+}
+
 func BenchmarkGenerateTrieFromItems(b *testing.B) {
 	items := [][]byte{
 		[]byte("A"),
@@ -0,0 +1,193 @@
+package attestations
+
+import (
+	"container/heap"
+	"context"
+
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+)
+
+// RewardKey identifies a reward which a block can earn at most once: the participation flag
+// of a validator in the target epoch of an attestation.
+type RewardKey struct {
+	Validator types.ValidatorIndex
+	Epoch     types.Epoch
+	Flag      uint8
+}
+
+// Reward is an amount earned by including an attestation in a block.
+type Reward struct {
+	Key    RewardKey
+	Amount uint64
+}
+
+// MaxRewardCover selects at most k of the candidates, each being the rewards earned by including an
+// attestation in a block, so that the total reward of the selection is maximal. A reward shared by several
+// candidates is counted once, the keys of the rewards of a candidate are expected to be distinct.
+//
+// This is a weighted variant of the Maximum Coverage problem. A first selection is made greedily, picking
+// the candidate adding the highest reward at each step, which is within (1-1/e) of the optimum. The
+// selection is then improved by swapping selected and left out candidates, for as long as a swap increases
+// the total reward and the context is not done, so that the time spent can be bounded by a deadline.
+//
+// The indices of the selected candidates are returned ordered by the reward they add, starting with the
+// candidate adding the highest reward, along with the total reward. Candidates adding no reward to the
+// selection are left out.
+func MaxRewardCover(ctx context.Context, candidates [][]Reward, k int) ([]int, uint64) {
+	indices := make([]int, len(candidates))
+	for i := range candidates {
+		indices[i] = i
+	}
+	selected, _ := greedyRewardCover(candidates, indices, k)
+	selected = improveRewardCover(ctx, candidates, selected)
+	return greedyRewardCover(candidates, selected, len(selected))
+}
+
+// greedyRewardCover selects at most k of the candidates at the indices, picking the candidate adding the
+// highest reward at each step. The reward a candidate adds can only decrease as the selection grows, so
+// candidates are kept in a queue by the last reward computed for them, and the reward of the candidate on
+// top of the queue is computed again before it is picked.
+func greedyRewardCover(candidates [][]Reward, indices []int, k int) ([]int, uint64) {
+	covered := make(map[RewardKey]bool)
+	queue := make(rewardQueue, 0, len(indices))
+	for _, idx := range indices {
+		if reward := addedReward(candidates[idx], covered); reward > 0 {
+			queue = append(queue, &rewardQueueItem{index: idx, reward: reward})
+		}
+	}
+	heap.Init(&queue)
+
+	selected := make([]int, 0, k)
+	total := uint64(0)
+	for len(selected) < k && queue.Len() > 0 {
+		top, ok := heap.Pop(&queue).(*rewardQueueItem)
+		if !ok {
+			break
+		}
+		reward := addedReward(candidates[top.index], covered)
+		if reward == 0 {
+			continue
+		}
+		top.reward = reward
+		if queue.Len() > 0 && queue[0].before(top) {
+			heap.Push(&queue, top)
+			continue
+		}
+		selected = append(selected, top.index)
+		total += reward
+		for _, r := range candidates[top.index] {
+			covered[r.Key] = true
+		}
+	}
+	return selected, total
+}
+
+// improveRewardCover swaps selected candidates with left out candidates adding a higher reward in their
+// place, until no swap increases the total reward or the context is done.
+func improveRewardCover(ctx context.Context, candidates [][]Reward, selected []int) []int {
+	coverCount := make(map[RewardKey]int)
+	isSelected := make(map[int]bool, len(selected))
+	for _, idx := range selected {
+		isSelected[idx] = true
+		for _, r := range candidates[idx] {
+			coverCount[r.Key]++
+		}
+	}
+
+	for improved := true; improved; {
+		improved = false
+		for i, idx := range selected {
+			if ctx.Err() != nil {
+				return selected
+			}
+			// The rewards only covered by the candidate are lost when it is swapped out.
+			lostKeys := make(map[RewardKey]bool)
+			lost := uint64(0)
+			for _, r := range candidates[idx] {
+				if coverCount[r.Key] == 1 {
+					lostKeys[r.Key] = true
+					lost += r.Amount
+				}
+			}
+			best, bestReward := -1, lost
+			for c, rewards := range candidates {
+				if isSelected[c] {
+					continue
+				}
+				reward := uint64(0)
+				for _, r := range rewards {
+					if coverCount[r.Key] == 0 || lostKeys[r.Key] {
+						reward += r.Amount
+					}
+				}
+				if reward > bestReward {
+					best, bestReward = c, reward
+				}
+			}
+			if best < 0 {
+				continue
+			}
+			for _, r := range candidates[idx] {
+				coverCount[r.Key]--
+			}
+			for _, r := range candidates[best] {
+				coverCount[r.Key]++
+			}
+			isSelected[idx] = false
+			isSelected[best] = true
+			selected[i] = best
+			improved = true
+		}
+	}
+	return selected
+}
+
+// addedReward returns the reward of the rewards which are not covered yet.
+func addedReward(rewards []Reward, covered map[RewardKey]bool) uint64 {
+	total := uint64(0)
+	for _, r := range rewards {
+		if !covered[r.Key] {
+			total += r.Amount
+		}
+	}
+	return total
+}
+
+type rewardQueueItem struct {
+	index  int
+	reward uint64
+}
+
+// before returns whether the item is picked before the other one, by highest reward then lowest index.
+func (i *rewardQueueItem) before(other *rewardQueueItem) bool {
+	if i.reward == other.reward {
+		return i.index < other.index
+	}
+	return i.reward > other.reward
+}
+
+// rewardQueue is a max-heap of candidates by reward, candidates with equal rewards being ordered by index.
+type rewardQueue []*rewardQueueItem
+
+func (q rewardQueue) Len() int { return len(q) }
+
+func (q rewardQueue) Less(i, j int) bool { return q[i].before(q[j]) }
+
+func (q rewardQueue) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+
+func (q *rewardQueue) Push(x interface{}) {
+	item, ok := x.(*rewardQueueItem)
+	if !ok {
+		return
+	}
+	*q = append(*q, item)
+}
+
+func (q *rewardQueue) Pop() interface{} {
+	old := *q
+	n := len(old)
+	item := old[n-1]
+	old[n-1] = nil
+	*q = old[:n-1]
+	return item
+}
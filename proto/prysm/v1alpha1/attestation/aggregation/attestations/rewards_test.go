@@ -0,0 +1,120 @@
+package attestations
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+)
+
+func testRewards(amount uint64, validators ...types.ValidatorIndex) []Reward {
+	rewards := make([]Reward, len(validators))
+	for i, v := range validators {
+		rewards[i] = Reward{Key: RewardKey{Validator: v}, Amount: amount}
+	}
+	return rewards
+}
+
+func TestMaxRewardCover(t *testing.T) {
+	tests := []struct {
+		name       string
+		candidates [][]Reward
+		k          int
+		want       []int
+		wantReward uint64
+	}{
+		{
+			name:       "no candidates",
+			candidates: nil,
+			k:          2,
+			want:       []int{},
+			wantReward: 0,
+		},
+		{
+			name: "ordered by added reward",
+			candidates: [][]Reward{
+				testRewards(1, 1, 2),
+				testRewards(1, 3, 4, 5),
+				testRewards(1, 6),
+			},
+			k:          3,
+			want:       []int{1, 0, 2},
+			wantReward: 6,
+		},
+		{
+			name: "shared rewards counted once",
+			candidates: [][]Reward{
+				testRewards(1, 1, 2, 3),
+				testRewards(1, 1, 2),
+				testRewards(1, 4),
+			},
+			k:          2,
+			want:       []int{0, 2},
+			wantReward: 4,
+		},
+		{
+			name: "amounts rather than count",
+			candidates: [][]Reward{
+				testRewards(1, 1, 2, 3),
+				testRewards(5, 4),
+			},
+			k:          1,
+			want:       []int{1},
+			wantReward: 5,
+		},
+		{
+			name: "no reward added",
+			candidates: [][]Reward{
+				testRewards(1, 1, 2),
+				testRewards(1, 1),
+				{},
+			},
+			k:          3,
+			want:       []int{0},
+			wantReward: 2,
+		},
+		{
+			name: "swap improves greedy selection",
+			candidates: [][]Reward{
+				testRewards(1, 1, 2, 3),
+				testRewards(1, 4, 5, 6),
+				testRewards(1, 1, 2, 4, 5),
+			},
+			k:          2,
+			want:       []int{0, 1},
+			wantReward: 6,
+		},
+		{
+			name: "same validator in different epochs",
+			candidates: [][]Reward{
+				{{Key: RewardKey{Validator: 1, Epoch: 1}, Amount: 1}},
+				{{Key: RewardKey{Validator: 1, Epoch: 2}, Amount: 1}},
+			},
+			k:          2,
+			want:       []int{0, 1},
+			wantReward: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, reward := MaxRewardCover(context.Background(), tt.candidates, tt.k)
+			assert.DeepEqual(t, tt.want, got)
+			assert.Equal(t, tt.wantReward, reward)
+		})
+	}
+}
+
+func TestMaxRewardCover_ContextDone(t *testing.T) {
+	candidates := [][]Reward{
+		testRewards(1, 1, 2, 3),
+		testRewards(1, 4, 5, 6),
+		testRewards(1, 1, 2, 4, 5),
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	// Only the greedy selection is made.
+	got, reward := MaxRewardCover(ctx, candidates, 2)
+	assert.DeepEqual(t, []int{2, 0}, got)
+	assert.Equal(t, uint64(5), reward)
+}
@@ -27,6 +27,7 @@ type componentHandler struct {
 	bootnode                 e2etypes.ComponentRunner
 	eth1Miner                e2etypes.ComponentRunner
 	eth1Proxy                e2etypes.MultipleComponentRunners
+	builders                 e2etypes.MultipleComponentRunners
 	eth1Nodes                e2etypes.MultipleComponentRunners
 	beaconNodes              e2etypes.MultipleComponentRunners
 	validatorNodes           e2etypes.MultipleComponentRunners
@@ -146,11 +147,28 @@ func (c *componentHandler) setup() {
 	})
 	c.eth1Proxy = proxies
 
+	// Builders
+	beaconNodeDeps := []e2etypes.ComponentRunner{eth1Nodes, proxies, bootNode}
+	if config.UseBuilder {
+		builders := eth1.NewBuilderSet(config.BuilderFaults)
+		g.Go(func() error {
+			if err := helpers.ComponentsStarted(ctx, []e2etypes.ComponentRunner{proxies}); err != nil {
+				return errors.Wrap(err, "builders require proxies to run")
+			}
+			if err := builders.Start(ctx); err != nil {
+				return errors.Wrap(err, "failed to start builders")
+			}
+			return nil
+		})
+		c.builders = builders
+		beaconNodeDeps = append(beaconNodeDeps, builders)
+	}
+
 	// Beacon nodes.
 	beaconNodes := components.NewBeaconNodes(config)
 	g.Go(func() error {
-		if err := helpers.ComponentsStarted(ctx, []e2etypes.ComponentRunner{eth1Nodes, proxies, bootNode}); err != nil {
-			return errors.Wrap(err, "beacon nodes require proxies, execution and boot node to run")
+		if err := helpers.ComponentsStarted(ctx, beaconNodeDeps); err != nil {
+			return errors.Wrap(err, "beacon nodes require proxies, builders, execution and boot node to run")
 		}
 		beaconNodes.SetENR(bootNode.ENR())
 		if err := beaconNodes.Start(ctx); err != nil {
@@ -213,6 +231,9 @@ func (c *componentHandler) required() []e2etypes.ComponentRunner {
 	requiredComponents := []e2etypes.ComponentRunner{
 		c.tracingSink, c.eth1Nodes, c.bootnode, c.beaconNodes, c.validatorNodes, c.eth1Proxy,
 	}
+	if c.cfg.UseBuilder {
+		requiredComponents = append(requiredComponents, c.builders)
+	}
 	if multiClientActive {
 		requiredComponents = append(requiredComponents, []e2etypes.ComponentRunner{c.keygen, c.lighthouseBeaconNodes, c.lighthouseValidatorNodes}...)
 	}
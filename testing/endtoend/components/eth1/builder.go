@@ -0,0 +1,201 @@
+package eth1
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"strconv"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v3/testing/endtoend/helpers"
+	e2e "github.com/prysmaticlabs/prysm/v3/testing/endtoend/params"
+	e2etypes "github.com/prysmaticlabs/prysm/v3/testing/endtoend/types"
+	"github.com/prysmaticlabs/prysm/v3/testing/middleware/builder"
+	log "github.com/sirupsen/logrus"
+)
+
+// BuilderSet represents a set of local block builder relays, one per beacon node.
+type BuilderSet struct {
+	e2etypes.ComponentRunner
+	started  chan struct{}
+	faults   []builder.Fault
+	builders []e2etypes.ComponentRunner
+}
+
+// NewBuilderSet creates and returns a set of builders cycling through the provided faults.
+func NewBuilderSet(faults []builder.Fault) *BuilderSet {
+	return &BuilderSet{
+		started: make(chan struct{}, 1),
+		faults:  faults,
+	}
+}
+
+// Start starts all the builders in set.
+func (s *BuilderSet) Start(ctx context.Context) error {
+	nodes := make([]e2etypes.ComponentRunner, e2e.TestParams.BeaconNodeCount)
+	for i := 0; i < e2e.TestParams.BeaconNodeCount; i++ {
+		nodes[i] = NewBuilder(i, s.faults)
+	}
+	s.builders = nodes
+
+	// Wait for all nodes to finish their job (blocking).
+	// Once nodes are ready passed in handler function will be called.
+	return helpers.WaitOnNodes(ctx, nodes, func() {
+		// All nodes started, close channel, so that all services waiting on a set, can proceed.
+		close(s.started)
+	})
+}
+
+// Started checks whether builder set is started and all builders are ready to be queried.
+func (s *BuilderSet) Started() <-chan struct{} {
+	return s.started
+}
+
+// Pause pauses the component and its underlying process.
+func (s *BuilderSet) Pause() error {
+	for _, n := range s.builders {
+		if err := n.Pause(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Resume resumes the component and its underlying process.
+func (s *BuilderSet) Resume() error {
+	for _, n := range s.builders {
+		if err := n.Resume(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Stop stops the component and its underlying process.
+func (s *BuilderSet) Stop() error {
+	for _, n := range s.builders {
+		if err := n.Stop(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PauseAtIndex pauses the component and its underlying process at the desired index.
+func (s *BuilderSet) PauseAtIndex(i int) error {
+	if i >= len(s.builders) {
+		return errors.Errorf("provided index exceeds slice size: %d >= %d", i, len(s.builders))
+	}
+	return s.builders[i].Pause()
+}
+
+// ResumeAtIndex resumes the component and its underlying process at the desired index.
+func (s *BuilderSet) ResumeAtIndex(i int) error {
+	if i >= len(s.builders) {
+		return errors.Errorf("provided index exceeds slice size: %d >= %d", i, len(s.builders))
+	}
+	return s.builders[i].Resume()
+}
+
+// StopAtIndex stops the component and its underlying process at the desired index.
+func (s *BuilderSet) StopAtIndex(i int) error {
+	if i >= len(s.builders) {
+		return errors.Errorf("provided index exceeds slice size: %d >= %d", i, len(s.builders))
+	}
+	return s.builders[i].Stop()
+}
+
+// ComponentAtIndex returns the component at the provided index.
+func (s *BuilderSet) ComponentAtIndex(i int) (e2etypes.ComponentRunner, error) {
+	if i >= len(s.builders) {
+		return nil, errors.Errorf("provided index exceeds slice size: %d >= %d", i, len(s.builders))
+	}
+	return s.builders[i], nil
+}
+
+// Builder represents a local block builder relay in front of the engine-api proxy of a beacon node.
+type Builder struct {
+	e2etypes.ComponentRunner
+	started chan struct{}
+	index   int
+	faults  []builder.Fault
+	builder *builder.Builder
+	cancel  func()
+}
+
+// NewBuilder creates and returns a builder cycling through the provided faults.
+func NewBuilder(index int, faults []builder.Fault) *Builder {
+	return &Builder{
+		started: make(chan struct{}, 1),
+		index:   index,
+		faults:  faults,
+	}
+}
+
+// Start runs a builder.
+func (node *Builder) Start(ctx context.Context) error {
+	f, err := os.Create(path.Join(e2e.TestParams.LogPath, "builder_"+strconv.Itoa(node.index)+".log"))
+	if err != nil {
+		return err
+	}
+	jwtPath := path.Join(e2e.TestParams.TestPath, "eth1data/"+strconv.Itoa(node.index)+"/")
+	if node.index == 0 {
+		jwtPath = path.Join(e2e.TestParams.TestPath, "eth1data/miner/")
+	}
+	jwtPath = path.Join(jwtPath, "geth/jwtsecret")
+	secret, err := parseJWTSecretFromFile(jwtPath)
+	if err != nil {
+		return err
+	}
+	opts := []builder.Option{
+		builder.WithDestinationAddress(fmt.Sprintf("http://127.0.0.1:%d", e2e.TestParams.Ports.Eth1ProxyPort+node.index)),
+		builder.WithPort(e2e.TestParams.Ports.BuilderPort + node.index),
+		builder.WithLogger(log.New()),
+		builder.WithLogFile(f),
+		builder.WithJwtSecret(string(secret)),
+	}
+	b, err := builder.New(opts...)
+	if err != nil {
+		return err
+	}
+	// Cycle through the faults, so that each of them is exhibited every few slots. The last slot of every
+	// cycle has no fault, so that the builder also serves valid bids and payloads.
+	for i, fault := range node.faults {
+		i, period := types.Slot(i), types.Slot(len(node.faults)+1)
+		b.AddFault(fault, func(slot types.Slot) bool { return slot%period == i })
+	}
+	log.Infof("Starting builder %d with port: %d and file %s", node.index, e2e.TestParams.Ports.BuilderPort+node.index, f.Name())
+
+	// Set cancel into context.
+	ctx, cancel := context.WithCancel(ctx)
+	node.cancel = cancel
+	node.builder = b
+	// Mark node as ready.
+	close(node.started)
+	return b.Start(ctx)
+}
+
+// Started checks whether the builder is started and ready to be queried.
+func (node *Builder) Started() <-chan struct{} {
+	return node.started
+}
+
+// Pause pauses the component and its underlying process.
+func (node *Builder) Pause() error {
+	// no-op
+	return nil
+}
+
+// Resume resumes the component and its underlying process.
+func (node *Builder) Resume() error {
+	// no-op
+	return nil
+}
+
+// Stop kills the component and its underlying process.
+func (node *Builder) Stop() error {
+	node.cancel()
+	return nil
+}
@@ -0,0 +1,109 @@
+package evaluators
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strconv"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v3/testing/endtoend/helpers"
+	e2e "github.com/prysmaticlabs/prysm/v3/testing/endtoend/params"
+	"github.com/prysmaticlabs/prysm/v3/testing/endtoend/policies"
+	e2etypes "github.com/prysmaticlabs/prysm/v3/testing/endtoend/types"
+	"google.golang.org/grpc"
+)
+
+// builderReadyEpoch is the first epoch proposers can use the builder, since the builder is only used once
+// a post-merge checkpoint is finalized.
+var builderReadyEpoch = types.Epoch(helpers.BellatrixE2EForkEpoch + 3)
+
+const (
+	blockSourceTopic          = `proposer_block_source_count{source="%s"}`
+	builderPayloadMissTopic   = `builder_get_payload_miss_count`
+	withheldPayloadTopicRegex = `relay_failures_total\{method="submit_blinded_block",relay="[^"]*"\}`
+)
+
+// BuilderIsActive checks that proposers used blocks from the builder.
+var BuilderIsActive = e2etypes.Evaluator{
+	Name:       "builder_is_active_%d",
+	Policy:     policies.AfterNthEpoch(builderReadyEpoch),
+	Evaluation: builderIsActive,
+}
+
+// BuilderFaultsFallBackToLocal checks that proposers rejected the bids of a faulty builder and fell back to
+// blocks built by their execution client, and that payloads withheld by the builder were detected.
+var BuilderFaultsFallBackToLocal = e2etypes.Evaluator{
+	Name:       "builder_faults_fall_back_to_local_%d",
+	Policy:     policies.AfterNthEpoch(builderReadyEpoch),
+	Evaluation: builderFaultsFallBackToLocal,
+}
+
+func builderIsActive(conns ...*grpc.ClientConn) error {
+	builderBlocks, err := metricSum(len(conns), regexp.QuoteMeta(fmt.Sprintf(blockSourceTopic, "builder")))
+	if err != nil {
+		return err
+	}
+	if builderBlocks == 0 {
+		return errors.New("no block was proposed with a builder payload")
+	}
+	return nil
+}
+
+func builderFaultsFallBackToLocal(conns ...*grpc.ClientConn) error {
+	misses, err := metricSum(len(conns), regexp.QuoteMeta(builderPayloadMissTopic))
+	if err != nil {
+		return err
+	}
+	if misses == 0 {
+		return errors.New("no faulty builder bid was rejected")
+	}
+	localBlocks, err := metricSum(len(conns), regexp.QuoteMeta(fmt.Sprintf(blockSourceTopic, "local")))
+	if err != nil {
+		return err
+	}
+	if localBlocks == 0 {
+		return errors.New("no block was proposed with a local payload after a builder failure")
+	}
+	withheld, err := metricSum(len(conns), withheldPayloadTopicRegex)
+	if err != nil {
+		return err
+	}
+	if withheld == 0 {
+		return errors.New("no payload withheld by the builder was detected")
+	}
+	return nil
+}
+
+// Sums the values of the metrics matching the pattern over the beacon nodes.
+func metricSum(nodeCount int, pattern string) (int, error) {
+	regexExp, err := regexp.Compile(`(?m)^` + pattern + ` (\S+)$`)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not create regex expression")
+	}
+	var total float64
+	for i := 0; i < nodeCount; i++ {
+		response, err := http.Get(fmt.Sprintf("http://localhost:%d/metrics", e2e.TestParams.Ports.PrysmBeaconNodeMetricsPort+i))
+		if err != nil {
+			return 0, errors.Wrap(err, "could not get metrics")
+		}
+		dataInBytes, err := io.ReadAll(response.Body)
+		if err != nil {
+			return 0, err
+		}
+		if err = response.Body.Close(); err != nil {
+			return 0, err
+		}
+		// Metrics are not exported until they are first incremented.
+		for _, match := range regexExp.FindAllStringSubmatch(string(dataInBytes), -1) {
+			value, err := strconv.ParseFloat(match[1], 64)
+			if err != nil {
+				return 0, errors.Wrapf(err, "could not parse %s for float", match[1])
+			}
+			total += value
+		}
+	}
+	return int(total), nil
+}
@@ -2,8 +2,11 @@ package endtoend
 
 import (
 	"testing"
+	"time"
 
+	ev "github.com/prysmaticlabs/prysm/v3/testing/endtoend/evaluators"
 	"github.com/prysmaticlabs/prysm/v3/testing/endtoend/types"
+	"github.com/prysmaticlabs/prysm/v3/testing/middleware/builder"
 )
 
 func TestEndToEnd_MultiScenarioRun(t *testing.T) {
@@ -18,6 +21,10 @@ func TestEndToEnd_MinimalConfig_Web3Signer(t *testing.T) {
 	e2eMinimal(t, types.WithRemoteSigner()).run()
 }
 
+func TestEndToEnd_MinimalConfig_SlowValidators(t *testing.T) {
+	e2eMinimal(t, types.WithValidatorDutyDelay(time.Second)).run()
+}
+
 func TestEndToEnd_ScenarioRun_EEOffline(t *testing.T) {
 	t.Skip("TODO(#10242) Prysm is current unable to handle an offline e2e")
 	runner := e2eMinimal(t)
@@ -26,3 +33,15 @@ func TestEndToEnd_ScenarioRun_EEOffline(t *testing.T) {
 	runner.config.EvalInterceptor = runner.eeOffline
 	runner.scenarioRunner()
 }
+
+func TestEndToEnd_MinimalConfig_WithBuilder(t *testing.T) {
+	runner := e2eMinimal(t, types.WithEpochs(14), types.WithBuilder())
+	runner.config.Evaluators = append(runner.config.Evaluators, ev.BuilderIsActive)
+	runner.run()
+}
+
+func TestEndToEnd_MinimalConfig_WithFaultyBuilder(t *testing.T) {
+	runner := e2eMinimal(t, types.WithEpochs(14), types.WithBuilder(builder.LateBid, builder.InvalidSignature, builder.WithheldPayload, builder.WrongForkBid))
+	runner.config.Evaluators = append(runner.config.Evaluators, ev.BuilderFaultsFallBackToLocal)
+	runner.run()
+}
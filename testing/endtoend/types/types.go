@@ -4,8 +4,13 @@ package types
 
 import (
 	"context"
+	"fmt"
+	"time"
 
+	beaconflags "github.com/prysmaticlabs/prysm/v3/cmd/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/v3/cmd/validator/flags"
 	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v3/testing/middleware/builder"
 	"google.golang.org/grpc"
 )
 
@@ -29,6 +34,29 @@ func WithCheckpointSync() E2EConfigOpt {
 	}
 }
 
+// WithValidatorDutyDelay delays every duty of the validator clients, to simulate slow or clock-skewed validators.
+// The validator client must be built with the debug tag, as the end to end tests are.
+func WithValidatorDutyDelay(delay time.Duration) E2EConfigOpt {
+	return func(cfg *E2EConfig) {
+		cfg.ValidatorFlags = append(cfg.ValidatorFlags, fmt.Sprintf("--%s=%s", flags.InjectDutyDelayFlag.Name, delay))
+	}
+}
+
+// WithBuilder runs a local block builder relay in front of the execution client of every beacon node, with
+// proposers building their blocks through it. The builders cycle through the provided faults, one per slot,
+// followed by a slot without fault.
+func WithBuilder(faults ...builder.Fault) E2EConfigOpt {
+	return func(cfg *E2EConfig) {
+		cfg.UseBuilder = true
+		cfg.BuilderFaults = faults
+		cfg.ValidatorFlags = append(cfg.ValidatorFlags, "--"+flags.EnableBuilderFlag.Name)
+		if len(faults) > 0 {
+			// Faulty relays must not open the circuit breaker, so that every fault keeps being exercised.
+			cfg.BeaconFlags = append(cfg.BeaconFlags, fmt.Sprintf("--%s=%d", beaconflags.MaxBuilderRelayFailureRate.Name, 100))
+		}
+	}
+}
+
 // E2EConfig defines the struct for all configurations needed for E2E testing.
 type E2EConfig struct {
 	TestCheckpointSync      bool
@@ -40,6 +68,7 @@ type E2EConfig struct {
 	TestDeposits            bool
 	UseFixedPeerIDs         bool
 	UseValidatorCrossClient bool
+	UseBuilder              bool
 	EpochsToRun             uint64
 	Seed                    int64
 	TracingSinkEndpoint     string
@@ -49,6 +78,7 @@ type E2EConfig struct {
 	ValidatorFlags          []string
 	PeerIDs                 []string
 	ExtraEpochs             uint64
+	BuilderFaults           []builder.Fault
 }
 
 // Evaluator defines the structure of the evaluators used to
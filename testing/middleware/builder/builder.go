@@ -0,0 +1,578 @@
+// Package builder provides a local block builder relay for offline testing. It serves the builder API to
+// a consensus client and builds its payloads with the execution client the engine API requests of the
+// consensus client are forwarded to. Faults such as late bids or withheld payloads can be injected using
+// custom triggers, to test how proposers fall back to local block production. Useful for end-to-end testing.
+package builder
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethRPC "github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+	builderAPI "github.com/prysmaticlabs/prysm/v3/api/client/builder"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/signing"
+	"github.com/prysmaticlabs/prysm/v3/config/params"
+	"github.com/prysmaticlabs/prysm/v3/consensus-types/blocks"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v3/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v3/network"
+	v1 "github.com/prysmaticlabs/prysm/v3/proto/engine/v1"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/runtime/version"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	forkchoiceUpdatedMethod = "engine_forkchoiceUpdatedV1"
+	getPayloadMethod        = "engine_getPayloadV1"
+
+	builderPathPrefix = "/eth/v1/builder/"
+	headerPathPrefix  = builderPathPrefix + "header/"
+	statusPath        = builderPathPrefix + "status"
+	blindedBlocksPath = builderPathPrefix + "blinded_blocks"
+	validatorsPath    = builderPathPrefix + "validators"
+
+	// maxTrackedPayloads is the number of payload IDs and built payloads kept in memory by the builder.
+	maxTrackedPayloads = 64
+	// payloadIDPollInterval is how often the builder checks for a payload ID while waiting for one.
+	payloadIDPollInterval = 20 * time.Millisecond
+)
+
+var (
+	defaultBuilderHost  = "127.0.0.1"
+	defaultBuilderPort  = 18550
+	defaultLateBidDelay = 2 * time.Second
+	defaultPayloadWait  = 500 * time.Millisecond
+	// defaultBidValue is 1 Gwei in Wei.
+	defaultBidValue = big.NewInt(1000000000)
+)
+
+// Fault the builder can be made to exhibit when serving the builder API.
+type Fault int
+
+const (
+	// LateBid delays the response to a header request, so that the bid arrives after the proposer stopped waiting.
+	LateBid Fault = iota
+	// InvalidSignature signs the bid with an incorrect signing root.
+	InvalidSignature
+	// WithheldPayload refuses to reveal the payload of a signed blinded block.
+	WithheldPayload
+	// WrongForkBid responds to a header request with a bid for an unexpected fork version.
+	WrongForkBid
+)
+
+// String returns the name of the fault.
+func (f Fault) String() string {
+	switch f {
+	case LateBid:
+		return "late bid"
+	case InvalidSignature:
+		return "invalid signature"
+	case WithheldPayload:
+		return "withheld payload"
+	case WrongForkBid:
+		return "wrong fork bid"
+	default:
+		return fmt.Sprintf("unknown fault %d", f)
+	}
+}
+
+type jsonRPCObject struct {
+	Jsonrpc string            `json:"jsonrpc"`
+	Method  string            `json:"method"`
+	Params  []json.RawMessage `json:"params"`
+	ID      uint64            `json:"id"`
+	Result  json.RawMessage   `json:"result"`
+}
+
+type forkchoiceUpdatedResponse struct {
+	PayloadId *v1.PayloadIDBytes `json:"payloadId"`
+}
+
+type blindedBlockRequest struct {
+	Message struct {
+		Slot string `json:"slot"`
+		Body struct {
+			ExecutionPayloadHeader struct {
+				BlockHash hexutil.Bytes `json:"block_hash"`
+			} `json:"execution_payload_header"`
+		} `json:"body"`
+	} `json:"message"`
+}
+
+// Builder is a local block builder relay which sits as a middleware between an Ethereum consensus client and
+// an execution client. Engine API requests are forwarded to the execution client, and payloads prepared for the
+// consensus client are offered as builder bids through the builder API.
+type Builder struct {
+	cfg           *config
+	address       string
+	srv           *http.Server
+	engine        *gethRPC.Client
+	lock          sync.RWMutex
+	faults        map[Fault]func(slot types.Slot) bool
+	payloadIDs    map[[32]byte]v1.PayloadIDBytes
+	payloadIDKeys [][32]byte
+	payloads      map[[32]byte]*v1.ExecutionPayload
+	payloadKeys   [][32]byte
+	registrations map[[48]byte]*ethpb.ValidatorRegistrationV1
+}
+
+// New creates a builder relay building payloads with an execution client.
+func New(opts ...Option) (*Builder, error) {
+	b := &Builder{
+		cfg: &config{
+			builderHost:  defaultBuilderHost,
+			builderPort:  defaultBuilderPort,
+			logger:       logrus.New(),
+			bidValue:     defaultBidValue,
+			lateBidDelay: defaultLateBidDelay,
+			payloadWait:  defaultPayloadWait,
+		},
+		faults:        make(map[Fault]func(slot types.Slot) bool),
+		payloadIDs:    make(map[[32]byte]v1.PayloadIDBytes),
+		payloads:      make(map[[32]byte]*v1.ExecutionPayload),
+		registrations: make(map[[48]byte]*ethpb.ValidatorRegistrationV1),
+	}
+	for _, o := range opts {
+		if err := o(b); err != nil {
+			return nil, err
+		}
+	}
+	if b.cfg.destinationUrl == nil {
+		return nil, errors.New("must provide a destination address for builder")
+	}
+	if b.cfg.secretKey == nil {
+		sk, err := bls.RandKey()
+		if err != nil {
+			return nil, errors.Wrap(err, "could not generate builder key")
+		}
+		b.cfg.secretKey = sk
+	}
+	engine, err := gethRPC.DialHTTPWithClient(b.cfg.destinationUrl.String(), b.httpClient())
+	if err != nil {
+		return nil, errors.Wrap(err, "could not dial execution client")
+	}
+	b.engine = engine
+	mux := http.NewServeMux()
+	mux.HandleFunc(builderPathPrefix, b.handleBuilderAPI)
+	mux.HandleFunc("/", b.handleEngineAPI)
+	addr := fmt.Sprintf("%s:%d", b.cfg.builderHost, b.cfg.builderPort)
+	b.srv = &http.Server{
+		Handler:           mux,
+		Addr:              addr,
+		ReadHeaderTimeout: time.Second,
+	}
+	b.address = addr
+	return b, nil
+}
+
+// Address for the builder server.
+func (b *Builder) Address() string {
+	return b.address
+}
+
+// PublicKey the builder signs its bids with.
+func (b *Builder) PublicKey() bls.PublicKey {
+	return b.cfg.secretKey.PublicKey()
+}
+
+// Start a builder server.
+func (b *Builder) Start(ctx context.Context) error {
+	b.srv.BaseContext = func(listener net.Listener) context.Context {
+		return ctx
+	}
+	b.cfg.logger.WithFields(logrus.Fields{
+		"forwardingAddress": b.cfg.destinationUrl.String(),
+		"builderPubKey":     fmt.Sprintf("%#x", b.PublicKey().Marshal()),
+	}).Infof("Builder now listening on address %s", b.address)
+	go func() {
+		if err := b.srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			b.cfg.logger.Error(err)
+		}
+	}()
+	<-ctx.Done()
+	b.engine.Close()
+	return b.srv.Shutdown(context.Background())
+}
+
+// AddFault makes the builder exhibit a fault for the slots the trigger returns true for.
+func (b *Builder) AddFault(f Fault, trigger func(slot types.Slot) bool) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	b.cfg.logger.Infof("Adding in fault %s", f)
+	b.faults[f] = trigger
+}
+
+// RemoveFault stops the builder from exhibiting the provided fault.
+func (b *Builder) RemoveFault(f Fault) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	b.cfg.logger.Infof("Removing fault %s", f)
+	delete(b.faults, f)
+}
+
+// Registrations returns the number of validators registered with the builder.
+func (b *Builder) Registrations() int {
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+	return len(b.registrations)
+}
+
+func (b *Builder) faultTriggered(f Fault, slot types.Slot) bool {
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+	trigger, ok := b.faults[f]
+	if !ok || !trigger(slot) {
+		return false
+	}
+	b.cfg.logger.WithField("slot", slot).Infof("Triggering fault %s", f)
+	return true
+}
+
+func (b *Builder) httpClient() *http.Client {
+	if b.cfg.secret != "" {
+		return network.NewHttpClientWithSecret(b.cfg.secret)
+	}
+	return &http.Client{}
+}
+
+// Forwards engine API requests to the execution client, keeping track of the payloads being prepared
+// for the consensus client so that the builder can bid with them.
+func (b *Builder) handleEngineAPI(w http.ResponseWriter, r *http.Request) {
+	requestBytes, err := io.ReadAll(r.Body)
+	if err != nil {
+		b.cfg.logger.WithError(err).Error("Could not read request")
+		return
+	}
+	proxyReq, err := http.NewRequestWithContext(r.Context(), r.Method, b.cfg.destinationUrl.String(), bytes.NewBuffer(requestBytes))
+	if err != nil {
+		b.cfg.logger.WithError(err).Error("Could not create new request")
+		return
+	}
+	proxyReq.Header.Set("X-Forwarded-For", r.RemoteAddr)
+	proxyReq.Header.Set("Content-Type", "application/json")
+	proxyRes, err := b.httpClient().Do(proxyReq)
+	if err != nil {
+		b.cfg.logger.WithError(err).Error("Could not forward request to destination server")
+		w.WriteHeader(http.StatusBadGateway)
+		return
+	}
+	defer func() {
+		if err = proxyRes.Body.Close(); err != nil {
+			b.cfg.logger.WithError(err).Error("Could not close proxy response body")
+		}
+	}()
+	responseBytes, err := io.ReadAll(proxyRes.Body)
+	if err != nil {
+		b.cfg.logger.WithError(err).Error("Could not read proxy response")
+		w.WriteHeader(http.StatusBadGateway)
+		return
+	}
+	b.trackPayloadID(requestBytes, responseBytes)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(proxyRes.StatusCode)
+	if _, err = w.Write(responseBytes); err != nil {
+		b.cfg.logger.WithError(err).Error("Could not write proxy response")
+	}
+}
+
+// Records the payload ID returned for a forkchoice update with payload attributes, keyed by the head block hash.
+func (b *Builder) trackPayloadID(requestBytes, responseBytes []byte) {
+	req := &jsonRPCObject{}
+	if err := json.Unmarshal(requestBytes, req); err != nil || req.Method != forkchoiceUpdatedMethod {
+		return
+	}
+	if len(req.Params) < 2 || bytes.Equal(bytes.TrimSpace(req.Params[1]), []byte("null")) {
+		return
+	}
+	state := &v1.ForkchoiceState{}
+	if err := json.Unmarshal(req.Params[0], state); err != nil {
+		b.cfg.logger.WithError(err).Error("Could not unmarshal forkchoice state")
+		return
+	}
+	res := &jsonRPCObject{}
+	if err := json.Unmarshal(responseBytes, res); err != nil {
+		b.cfg.logger.WithError(err).Error("Could not unmarshal forkchoice updated response")
+		return
+	}
+	result := &forkchoiceUpdatedResponse{}
+	if err := json.Unmarshal(res.Result, result); err != nil || result.PayloadId == nil {
+		return
+	}
+	headHash := bytesutil.ToBytes32(state.HeadBlockHash)
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	if _, ok := b.payloadIDs[headHash]; !ok {
+		b.payloadIDKeys = append(b.payloadIDKeys, headHash)
+	}
+	b.payloadIDs[headHash] = *result.PayloadId
+	if len(b.payloadIDKeys) > maxTrackedPayloads {
+		delete(b.payloadIDs, b.payloadIDKeys[0])
+		b.payloadIDKeys = b.payloadIDKeys[1:]
+	}
+}
+
+// Serves the builder API endpoints.
+func (b *Builder) handleBuilderAPI(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, headerPathPrefix):
+		b.handleGetHeader(w, r)
+	case r.Method == http.MethodPost && r.URL.Path == blindedBlocksPath:
+		b.handleSubmitBlindedBlock(w, r)
+	case r.Method == http.MethodPost && r.URL.Path == validatorsPath:
+		b.handleRegisterValidators(w, r)
+	case r.Method == http.MethodGet && r.URL.Path == statusPath:
+		w.WriteHeader(http.StatusOK)
+	default:
+		b.writeError(w, http.StatusNotFound, "unknown builder API endpoint")
+	}
+}
+
+func (b *Builder) handleRegisterValidators(w http.ResponseWriter, r *http.Request) {
+	var regs []*builderAPI.SignedValidatorRegistration
+	if err := json.NewDecoder(r.Body).Decode(&regs); err != nil {
+		b.writeError(w, http.StatusBadRequest, "could not decode validator registrations")
+		return
+	}
+	b.lock.Lock()
+	for _, reg := range regs {
+		if reg == nil || reg.Message == nil {
+			continue
+		}
+		b.registrations[bytesutil.ToBytes48(reg.Message.Pubkey)] = reg.Message
+	}
+	b.lock.Unlock()
+	b.cfg.logger.WithField("count", len(regs)).Debug("Registered validators")
+	w.WriteHeader(http.StatusOK)
+}
+
+func (b *Builder) handleGetHeader(w http.ResponseWriter, r *http.Request) {
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, headerPathPrefix), "/")
+	if len(parts) != 3 {
+		b.writeError(w, http.StatusBadRequest, "invalid header request path")
+		return
+	}
+	s, err := strconv.ParseUint(parts[0], 10, 64)
+	if err != nil {
+		b.writeError(w, http.StatusBadRequest, "invalid slot")
+		return
+	}
+	slot := types.Slot(s)
+	parentHash, err := hexutil.Decode(parts[1])
+	if err != nil || len(parentHash) != 32 {
+		b.writeError(w, http.StatusBadRequest, "invalid parent hash")
+		return
+	}
+	pubkey, err := hexutil.Decode(parts[2])
+	if err != nil || len(pubkey) != 48 {
+		b.writeError(w, http.StatusBadRequest, "invalid pubkey")
+		return
+	}
+	b.lock.RLock()
+	_, registered := b.registrations[bytesutil.ToBytes48(pubkey)]
+	b.lock.RUnlock()
+	if !registered {
+		b.cfg.logger.WithField("slot", slot).Debug("Proposer is not registered, not bidding")
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	if b.faultTriggered(LateBid, slot) {
+		select {
+		case <-time.After(b.cfg.lateBidDelay):
+		case <-r.Context().Done():
+			return
+		}
+	}
+
+	payload, err := b.buildPayload(r.Context(), bytesutil.ToBytes32(parentHash))
+	if err != nil {
+		b.cfg.logger.WithError(err).WithField("slot", slot).Error("Could not build payload")
+		b.writeError(w, http.StatusInternalServerError, "could not build payload")
+		return
+	}
+	if payload == nil || len(payload.Transactions) == 0 {
+		// Bids with empty payloads are rejected by proposers, so the builder does not bid at all.
+		b.cfg.logger.WithField("slot", slot).Debug("No payload with transactions to bid with")
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	wrapped, err := blocks.WrappedExecutionPayload(payload)
+	if err != nil {
+		b.writeError(w, http.StatusInternalServerError, "could not wrap payload")
+		return
+	}
+	header, err := blocks.PayloadToHeader(wrapped)
+	if err != nil {
+		b.writeError(w, http.StatusInternalServerError, "could not compute payload header")
+		return
+	}
+	bid := &ethpb.BuilderBid{
+		Header: header,
+		Value:  bytesutil.PadTo(bytesutil.ReverseByteOrder(b.cfg.bidValue.Bytes()), 32),
+		Pubkey: b.PublicKey().Marshal(),
+	}
+	sig, err := b.signBid(bid, b.faultTriggered(InvalidSignature, slot))
+	if err != nil {
+		b.cfg.logger.WithError(err).Error("Could not sign bid")
+		b.writeError(w, http.StatusInternalServerError, "could not sign bid")
+		return
+	}
+
+	b.lock.Lock()
+	blockHash := bytesutil.ToBytes32(payload.BlockHash)
+	if _, ok := b.payloads[blockHash]; !ok {
+		b.payloadKeys = append(b.payloadKeys, blockHash)
+	}
+	b.payloads[blockHash] = payload
+	if len(b.payloadKeys) > maxTrackedPayloads {
+		delete(b.payloads, b.payloadKeys[0])
+		b.payloadKeys = b.payloadKeys[1:]
+	}
+	b.lock.Unlock()
+
+	res := &builderAPI.ExecHeaderResponse{Version: version.String(version.Bellatrix)}
+	if b.faultTriggered(WrongForkBid, slot) {
+		res.Version = version.String(version.Capella)
+	}
+	res.Data.Signature = sig
+	res.Data.Message = &builderAPI.BuilderBid{
+		Header: &builderAPI.ExecutionPayloadHeader{ExecutionPayloadHeader: header},
+		Value:  builderAPI.Uint256{Int: b.cfg.bidValue},
+		Pubkey: bid.Pubkey,
+	}
+	b.cfg.logger.WithFields(logrus.Fields{
+		"slot":      slot,
+		"blockHash": fmt.Sprintf("%#x", payload.BlockHash),
+		"value":     b.cfg.bidValue.String(),
+	}).Info("Sending builder bid")
+	b.writeJSON(w, res)
+}
+
+// Retrieves the payload prepared by the execution client on top of the given parent block hash. It returns nil
+// if no payload is being prepared on top of the parent after waiting for the consensus client to request one.
+func (b *Builder) buildPayload(ctx context.Context, parentHash [32]byte) (*v1.ExecutionPayload, error) {
+	deadline := time.Now().Add(b.cfg.payloadWait)
+	var payloadID v1.PayloadIDBytes
+	for {
+		var ok bool
+		b.lock.RLock()
+		payloadID, ok = b.payloadIDs[parentHash]
+		b.lock.RUnlock()
+		if ok {
+			break
+		}
+		if time.Now().After(deadline) {
+			return nil, nil
+		}
+		select {
+		case <-time.After(payloadIDPollInterval):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	payload := &v1.ExecutionPayload{}
+	if err := b.engine.CallContext(ctx, payload, getPayloadMethod, payloadID); err != nil {
+		return nil, errors.Wrap(err, "could not get payload from execution client")
+	}
+	return payload, nil
+}
+
+// Signs the bid with the builder domain. An invalid signature signs the bid without its domain.
+func (b *Builder) signBid(bid *ethpb.BuilderBid, invalid bool) ([]byte, error) {
+	d, err := signing.ComputeDomain(params.BeaconConfig().DomainApplicationBuilder,
+		nil, /* fork version */
+		nil /* genesis val root */)
+	if err != nil {
+		return nil, err
+	}
+	if invalid {
+		d = make([]byte, len(d))
+	}
+	sr, err := signing.ComputeSigningRoot(bid, d)
+	if err != nil {
+		return nil, err
+	}
+	return b.cfg.secretKey.Sign(sr[:]).Marshal(), nil
+}
+
+func (b *Builder) handleSubmitBlindedBlock(w http.ResponseWriter, r *http.Request) {
+	req := &blindedBlockRequest{}
+	if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+		b.writeError(w, http.StatusBadRequest, "could not decode blinded block")
+		return
+	}
+	s, err := strconv.ParseUint(req.Message.Slot, 10, 64)
+	if err != nil {
+		b.writeError(w, http.StatusBadRequest, "invalid slot")
+		return
+	}
+	slot := types.Slot(s)
+	blockHash := req.Message.Body.ExecutionPayloadHeader.BlockHash
+	if b.faultTriggered(WithheldPayload, slot) {
+		b.writeError(w, http.StatusInternalServerError, "payload withheld")
+		return
+	}
+	b.lock.RLock()
+	p, ok := b.payloads[bytesutil.ToBytes32(blockHash)]
+	b.lock.RUnlock()
+	if !ok {
+		b.writeError(w, http.StatusBadRequest, fmt.Sprintf("unknown payload %#x", blockHash))
+		return
+	}
+	txs := make([]hexutil.Bytes, len(p.Transactions))
+	for i := range p.Transactions {
+		txs[i] = p.Transactions[i]
+	}
+	b.cfg.logger.WithFields(logrus.Fields{
+		"slot":      slot,
+		"blockHash": fmt.Sprintf("%#x", blockHash),
+	}).Info("Revealing payload")
+	b.writeJSON(w, &builderAPI.ExecPayloadResponse{
+		Version: version.String(version.Bellatrix),
+		Data: builderAPI.ExecutionPayload{
+			ParentHash:    p.ParentHash,
+			FeeRecipient:  p.FeeRecipient,
+			StateRoot:     p.StateRoot,
+			ReceiptsRoot:  p.ReceiptsRoot,
+			LogsBloom:     p.LogsBloom,
+			PrevRandao:    p.PrevRandao,
+			BlockNumber:   builderAPI.Uint64String(p.BlockNumber),
+			GasLimit:      builderAPI.Uint64String(p.GasLimit),
+			GasUsed:       builderAPI.Uint64String(p.GasUsed),
+			Timestamp:     builderAPI.Uint64String(p.Timestamp),
+			ExtraData:     p.ExtraData,
+			BaseFeePerGas: builderAPI.Uint256{Int: new(big.Int).SetBytes(bytesutil.ReverseByteOrder(p.BaseFeePerGas))},
+			BlockHash:     p.BlockHash,
+			Transactions:  txs,
+		},
+	})
+}
+
+func (b *Builder) writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		b.cfg.logger.WithError(err).Error("Could not write response")
+	}
+}
+
+func (b *Builder) writeError(w http.ResponseWriter, code int, msg string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(&builderAPI.ErrorMessage{Code: code, Message: msg}); err != nil {
+		b.cfg.logger.WithError(err).Error("Could not write error response")
+	}
+}
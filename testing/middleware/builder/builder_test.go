@@ -0,0 +1,246 @@
+package builder
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	builderAPI "github.com/prysmaticlabs/prysm/v3/api/client/builder"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/signing"
+	"github.com/prysmaticlabs/prysm/v3/config/params"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v3/crypto/rand"
+	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
+	pb "github.com/prysmaticlabs/prysm/v3/proto/engine/v1"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+)
+
+var (
+	parentHash = [32]byte{'p'}
+	pubkey     = [48]byte{'k'}
+)
+
+func TestBuilder_GetHeader(t *testing.T) {
+	t.Run("no bid for unregistered proposer", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		_, client, _ := setupBuilder(ctx, t)
+
+		_, err := client.GetHeader(ctx, 1, parentHash, pubkey)
+		require.ErrorIs(t, err, builderAPI.ErrNoContent)
+	})
+	t.Run("no bid without prepared payload", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		_, client, _ := setupBuilder(ctx, t)
+		registerValidator(ctx, t, client)
+
+		_, err := client.GetHeader(ctx, 1, parentHash, pubkey)
+		require.ErrorIs(t, err, builderAPI.ErrNoContent)
+	})
+	t.Run("bids with prepared payload", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		b, client, rpcClient := setupBuilder(ctx, t)
+		registerValidator(ctx, t, client)
+		preparePayload(ctx, t, rpcClient)
+
+		bid, err := client.GetHeader(ctx, 1, parentHash, pubkey)
+		require.NoError(t, err)
+		want := testPayload()
+		assert.DeepEqual(t, want.BlockHash, bid.Message.Header.BlockHash)
+		assert.DeepEqual(t, want.ParentHash, bid.Message.Header.ParentHash)
+		assert.DeepEqual(t, b.PublicKey().Marshal(), bid.Message.Pubkey)
+		assert.DeepEqual(t, bytesutil.PadTo(bytesutil.ReverseByteOrder(defaultBidValue.Bytes()), 32), bid.Message.Value)
+		require.NoError(t, verifyBid(bid))
+
+		payload, err := client.SubmitBlindedBlock(ctx, blindedBlock(1, bid.Message.Header))
+		require.NoError(t, err)
+		require.DeepEqual(t, want, payload)
+	})
+}
+
+func TestBuilder_Faults(t *testing.T) {
+	always := func(types.Slot) bool { return true }
+	t.Run("late bid", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		b, client, rpcClient := setupBuilder(ctx, t, WithLateBidDelay(time.Second))
+		registerValidator(ctx, t, client)
+		preparePayload(ctx, t, rpcClient)
+		b.AddFault(LateBid, func(slot types.Slot) bool { return slot == 2 })
+
+		_, err := client.GetHeader(ctx, 1, parentHash, pubkey)
+		require.NoError(t, err)
+		timeoutCtx, cancelTimeout := context.WithTimeout(ctx, 200*time.Millisecond)
+		defer cancelTimeout()
+		_, err = client.GetHeader(timeoutCtx, 2, parentHash, pubkey)
+		require.ErrorContains(t, "context deadline exceeded", err)
+	})
+	t.Run("invalid signature", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		b, client, rpcClient := setupBuilder(ctx, t)
+		registerValidator(ctx, t, client)
+		preparePayload(ctx, t, rpcClient)
+		b.AddFault(InvalidSignature, always)
+
+		bid, err := client.GetHeader(ctx, 1, parentHash, pubkey)
+		require.NoError(t, err)
+		require.ErrorContains(t, "signature did not verify", verifyBid(bid))
+
+		b.RemoveFault(InvalidSignature)
+		bid, err = client.GetHeader(ctx, 1, parentHash, pubkey)
+		require.NoError(t, err)
+		require.NoError(t, verifyBid(bid))
+	})
+	t.Run("withheld payload", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		b, client, rpcClient := setupBuilder(ctx, t)
+		registerValidator(ctx, t, client)
+		preparePayload(ctx, t, rpcClient)
+		b.AddFault(WithheldPayload, always)
+
+		bid, err := client.GetHeader(ctx, 1, parentHash, pubkey)
+		require.NoError(t, err)
+		_, err = client.SubmitBlindedBlock(ctx, blindedBlock(1, bid.Message.Header))
+		require.ErrorContains(t, "payload withheld", err)
+	})
+	t.Run("wrong fork bid", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		b, client, rpcClient := setupBuilder(ctx, t)
+		registerValidator(ctx, t, client)
+		preparePayload(ctx, t, rpcClient)
+		b.AddFault(WrongForkBid, always)
+
+		_, err := client.GetHeader(ctx, 1, parentHash, pubkey)
+		require.ErrorContains(t, "unsupported fork version", err)
+	})
+}
+
+func setupBuilder(ctx context.Context, t *testing.T, opts ...Option) (*Builder, *builderAPI.Client, *rpc.Client) {
+	srv := engineServerSetup(t)
+	t.Cleanup(srv.Close)
+	r := rand.NewGenerator()
+	opts = append([]Option{WithPort(r.Intn(50000)), WithDestinationAddress(srv.URL)}, opts...)
+	b, err := New(opts...)
+	require.NoError(t, err)
+	go func() {
+		if err := b.Start(ctx); err != nil {
+			t.Log(err)
+		}
+	}()
+	time.Sleep(time.Millisecond * 100)
+
+	client, err := builderAPI.NewClient("http://" + b.Address())
+	require.NoError(t, err)
+	require.NoError(t, client.Status(ctx))
+	rpcClient, err := rpc.DialHTTP("http://" + b.Address())
+	require.NoError(t, err)
+	return b, client, rpcClient
+}
+
+func registerValidator(ctx context.Context, t *testing.T, client *builderAPI.Client) {
+	require.NoError(t, client.RegisterValidator(ctx, []*ethpb.SignedValidatorRegistrationV1{{
+		Message: &ethpb.ValidatorRegistrationV1{
+			FeeRecipient: make([]byte, 20),
+			GasLimit:     30000000,
+			Timestamp:    1,
+			Pubkey:       pubkey[:],
+		},
+		Signature: make([]byte, 96),
+	}}))
+}
+
+// Sends a forkchoice update with payload attributes through the builder, as a consensus client would.
+func preparePayload(ctx context.Context, t *testing.T, rpcClient *rpc.Client) {
+	state := &pb.ForkchoiceState{
+		HeadBlockHash:      parentHash[:],
+		SafeBlockHash:      parentHash[:],
+		FinalizedBlockHash: parentHash[:],
+	}
+	attrs := &pb.PayloadAttributes{
+		Timestamp:             1,
+		PrevRandao:            make([]byte, 32),
+		SuggestedFeeRecipient: make([]byte, 20),
+	}
+	res := &forkchoiceUpdatedResponse{}
+	require.NoError(t, rpcClient.CallContext(ctx, res, forkchoiceUpdatedMethod, state, attrs))
+	require.NotNil(t, res.PayloadId)
+}
+
+func verifyBid(bid *ethpb.SignedBuilderBid) error {
+	d, err := signing.ComputeDomain(params.BeaconConfig().DomainApplicationBuilder, nil, nil)
+	if err != nil {
+		return err
+	}
+	return signing.VerifySigningRoot(bid.Message, bid.Message.Pubkey, bid.Signature, d)
+}
+
+func blindedBlock(slot types.Slot, header *pb.ExecutionPayloadHeader) *ethpb.SignedBlindedBeaconBlockBellatrix {
+	return &ethpb.SignedBlindedBeaconBlockBellatrix{
+		Block: &ethpb.BlindedBeaconBlockBellatrix{
+			Slot: slot,
+			Body: &ethpb.BlindedBeaconBlockBodyBellatrix{
+				Eth1Data:               &ethpb.Eth1Data{},
+				SyncAggregate:          &ethpb.SyncAggregate{},
+				ExecutionPayloadHeader: header,
+			},
+		},
+	}
+}
+
+func testPayload() *pb.ExecutionPayload {
+	return &pb.ExecutionPayload{
+		ParentHash:    parentHash[:],
+		FeeRecipient:  make([]byte, 20),
+		StateRoot:     bytesutil.PadTo([]byte("state"), 32),
+		ReceiptsRoot:  bytesutil.PadTo([]byte("receipts"), 32),
+		LogsBloom:     make([]byte, 256),
+		PrevRandao:    make([]byte, 32),
+		BlockNumber:   1,
+		GasLimit:      30000000,
+		GasUsed:       21000,
+		Timestamp:     1,
+		ExtraData:     []byte{},
+		BaseFeePerGas: bytesutil.PadTo([]byte{7}, 32),
+		BlockHash:     bytesutil.PadTo([]byte("block"), 32),
+		Transactions:  [][]byte{{1, 2, 3}},
+	}
+}
+
+// Sets up a fake execution client answering forkchoice updates with a payload ID and serving a payload for it.
+func engineServerSetup(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		defer func() {
+			require.NoError(t, r.Body.Close())
+		}()
+		req := &jsonRPCObject{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(req))
+		var result interface{}
+		switch req.Method {
+		case forkchoiceUpdatedMethod:
+			result = map[string]interface{}{
+				"payloadStatus": &pb.PayloadStatus{Status: pb.PayloadStatus_VALID},
+				"payloadId":     &pb.PayloadIDBytes{1},
+			}
+		case getPayloadMethod:
+			result = testPayload()
+		}
+		resp := map[string]interface{}{
+			"jsonrpc": "2.0",
+			"id":      req.ID,
+			"result":  result,
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(resp))
+	}))
+}
@@ -0,0 +1,114 @@
+package builder
+
+import (
+	"math/big"
+	"net/url"
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v3/crypto/bls"
+	"github.com/sirupsen/logrus"
+)
+
+type config struct {
+	builderPort    int
+	builderHost    string
+	destinationUrl *url.URL
+	logger         *logrus.Logger
+	secret         string
+	secretKey      bls.SecretKey
+	bidValue       *big.Int
+	lateBidDelay   time.Duration
+	payloadWait    time.Duration
+}
+
+type Option func(b *Builder) error
+
+// WithHost sets the builder server host.
+func WithHost(host string) Option {
+	return func(b *Builder) error {
+		b.cfg.builderHost = host
+		return nil
+	}
+}
+
+// WithPort sets the builder server port.
+func WithPort(port int) Option {
+	return func(b *Builder) error {
+		b.cfg.builderPort = port
+		return nil
+	}
+}
+
+// WithDestinationAddress sets the address of the execution client engine API requests are forwarded to,
+// and payloads are built with.
+func WithDestinationAddress(addr string) Option {
+	return func(b *Builder) error {
+		if addr == "" {
+			return errors.New("must provide a destination address for builder")
+		}
+		u, err := url.Parse(addr)
+		if err != nil {
+			return errors.Wrapf(err, "could not parse URL for destination address: %s", addr)
+		}
+		b.cfg.destinationUrl = u
+		return nil
+	}
+}
+
+// WithLogger sets a custom logger for the builder.
+func WithLogger(l *logrus.Logger) Option {
+	return func(b *Builder) error {
+		b.cfg.logger = l
+		return nil
+	}
+}
+
+// WithLogFile specifies a log file to write
+// the builder output to.
+func WithLogFile(f *os.File) Option {
+	return func(b *Builder) error {
+		if b.cfg.logger == nil {
+			return errors.New("nil logger provided")
+		}
+		b.cfg.logger.SetOutput(f)
+		return nil
+	}
+}
+
+// WithJwtSecret adds in support for jwt authenticated
+// connections to the execution client.
+func WithJwtSecret(secret string) Option {
+	return func(b *Builder) error {
+		b.cfg.secret = secret
+		return nil
+	}
+}
+
+// WithSecretKey sets the key the builder signs its bids with. A random key is used by default.
+func WithSecretKey(sk bls.SecretKey) Option {
+	return func(b *Builder) error {
+		b.cfg.secretKey = sk
+		return nil
+	}
+}
+
+// WithBidValue sets the value in wei of the bids of the builder.
+func WithBidValue(value *big.Int) Option {
+	return func(b *Builder) error {
+		if value == nil || value.Sign() < 0 {
+			return errors.New("bid value must be a non-negative number")
+		}
+		b.cfg.bidValue = value
+		return nil
+	}
+}
+
+// WithLateBidDelay sets how long bids are delayed by the late bid fault.
+func WithLateBidDelay(delay time.Duration) Option {
+	return func(b *Builder) error {
+		b.cfg.lateBidDelay = delay
+		return nil
+	}
+}
@@ -37,6 +37,26 @@ func (m *MockNodeClient) EXPECT() *MockNodeClientMockRecorder {
 	return m.recorder
 }
 
+// GetBuilderCircuitBreaker mocks base method.
+func (m *MockNodeClient) GetBuilderCircuitBreaker(arg0 context.Context, arg1 *emptypb.Empty, arg2 ...grpc.CallOption) (*eth.BuilderCircuitBreakerStatus, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetBuilderCircuitBreaker", varargs...)
+	ret0, _ := ret[0].(*eth.BuilderCircuitBreakerStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBuilderCircuitBreaker indicates an expected call of GetBuilderCircuitBreaker.
+func (mr *MockNodeClientMockRecorder) GetBuilderCircuitBreaker(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBuilderCircuitBreaker", reflect.TypeOf((*MockNodeClient)(nil).GetBuilderCircuitBreaker), varargs...)
+}
+
 // GetETH1ConnectionStatus mocks base method.
 func (m *MockNodeClient) GetETH1ConnectionStatus(arg0 context.Context, arg1 *emptypb.Empty, arg2 ...grpc.CallOption) (*eth.ETH1ConnectionStatus, error) {
 	m.ctrl.T.Helper()
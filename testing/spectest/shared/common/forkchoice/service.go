@@ -80,6 +80,9 @@ type engineMock struct {
 func (m *engineMock) GetPayload(context.Context, [8]byte) (*pb.ExecutionPayload, error) {
 	return nil, nil
 }
+func (m *engineMock) GetPayloadWithValue(context.Context, [8]byte) (*pb.ExecutionPayload, *big.Int, error) {
+	return nil, nil, nil
+}
 func (m *engineMock) ForkchoiceUpdated(context.Context, *pb.ForkchoiceState, *pb.PayloadAttributes) (*pb.PayloadIDBytes, []byte, error) {
 	return nil, m.latestValidHash, m.payloadStatus
 }
@@ -0,0 +1,139 @@
+package accounts
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/signing"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/rpc/apimiddleware"
+	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v3/config/params"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v3/io/file"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	validatorpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1/validator-client"
+	"github.com/prysmaticlabs/prysm/v3/validator/client/iface"
+)
+
+// VoluntaryExitFilePrefix is the file name prefix of signed voluntary exits written to disk.
+const VoluntaryExitFilePrefix = "voluntary-exit-"
+
+// ExitTarget is a validator listed for a batch voluntary exit, identified by its index, its public key or both.
+type ExitTarget struct {
+	Index    types.ValidatorIndex
+	HasIndex bool
+	PubKey   []byte
+}
+
+// ParseExitList parses a list of validators to exit, one per line. A line is a validator index, a hex
+// encoded public key, or both separated by a comma. Empty lines and lines starting with '#' are ignored.
+func ParseExitList(r io.Reader) ([]*ExitTarget, error) {
+	var targets []*ExitTarget
+	scanner := bufio.NewScanner(r)
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		target := &ExitTarget{}
+		for _, part := range strings.Split(line, ",") {
+			part = strings.TrimSpace(part)
+			if strings.HasPrefix(part, "0x") {
+				if target.PubKey != nil {
+					return nil, errors.Errorf("line %d: more than one public key", lineNum)
+				}
+				pubKey, err := hexutil.Decode(part)
+				if err != nil || len(pubKey) != fieldparams.BLSPubkeyLength {
+					return nil, errors.Errorf("line %d: invalid public key %s", lineNum, part)
+				}
+				target.PubKey = pubKey
+				continue
+			}
+			if target.HasIndex {
+				return nil, errors.Errorf("line %d: more than one validator index", lineNum)
+			}
+			index, err := strconv.ParseUint(part, 10, 64)
+			if err != nil {
+				return nil, errors.Errorf("line %d: invalid validator index %s", lineNum, part)
+			}
+			target.Index = types.ValidatorIndex(index)
+			target.HasIndex = true
+		}
+		targets = append(targets, target)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Wrap(err, "could not read exit list")
+	}
+	return targets, nil
+}
+
+// VoluntaryExitDomain computes the signature domain of voluntary exits without a beacon node, from
+// the fork version of the exit epoch and the genesis validators root of the network.
+func VoluntaryExitDomain(forkVersion, genesisValidatorsRoot []byte) ([]byte, error) {
+	if len(forkVersion) != 4 {
+		return nil, errors.New("fork version must be 4 bytes long")
+	}
+	if len(genesisValidatorsRoot) != fieldparams.RootLength {
+		return nil, errors.New("genesis validators root must be 32 bytes long")
+	}
+	return signing.ComputeDomain(params.BeaconConfig().DomainVoluntaryExit, forkVersion, genesisValidatorsRoot)
+}
+
+// SignVoluntaryExit signs a voluntary exit with the given signature domain.
+func SignVoluntaryExit(
+	ctx context.Context, signer iface.SigningFunc, pubKey []byte, exit *ethpb.VoluntaryExit, domain []byte,
+) (*ethpb.SignedVoluntaryExit, error) {
+	exitRoot, err := signing.ComputeSigningRoot(exit, domain)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute signing root")
+	}
+	sig, err := signer(ctx, &validatorpb.SignRequest{
+		PublicKey:       pubKey,
+		SigningRoot:     exitRoot[:],
+		SignatureDomain: domain,
+		Object:          &validatorpb.SignRequest_Exit{Exit: exit},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not sign voluntary exit")
+	}
+	return &ethpb.SignedVoluntaryExit{Exit: exit, Signature: sig.Marshal()}, nil
+}
+
+// WriteSignedVoluntaryExit writes a signed voluntary exit to the directory in the JSON format of the
+// beacon node API, and returns the path of the file.
+func WriteSignedVoluntaryExit(dir string, exit *ethpb.SignedVoluntaryExit) (string, error) {
+	b, err := json.MarshalIndent(&apimiddleware.SignedVoluntaryExitJson{
+		Exit: &apimiddleware.VoluntaryExitJson{
+			Epoch:          strconv.FormatUint(uint64(exit.Exit.Epoch), 10),
+			ValidatorIndex: strconv.FormatUint(uint64(exit.Exit.ValidatorIndex), 10),
+		},
+		Signature: hexutil.Encode(exit.Signature),
+	}, "", "  ")
+	if err != nil {
+		return "", errors.Wrap(err, "could not marshal voluntary exit")
+	}
+	exists, err := file.HasDir(dir)
+	if err != nil {
+		return "", errors.Wrap(err, "could not check output directory")
+	}
+	if !exists {
+		if err := file.MkdirAll(dir); err != nil {
+			return "", errors.Wrap(err, "could not create output directory")
+		}
+	}
+	path := filepath.Join(dir, fmt.Sprintf("%s%d.json", VoluntaryExitFilePrefix, exit.Exit.ValidatorIndex))
+	if err := file.WriteFile(path, b); err != nil {
+		return "", errors.Wrap(err, "could not write voluntary exit")
+	}
+	return path, nil
+}
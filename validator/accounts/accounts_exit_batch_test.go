@@ -0,0 +1,72 @@
+package accounts
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/signing"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/rpc/apimiddleware"
+	"github.com/prysmaticlabs/prysm/v3/config/params"
+	"github.com/prysmaticlabs/prysm/v3/crypto/bls"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+	"github.com/prysmaticlabs/prysm/v3/validator/keymanager/local"
+)
+
+func TestParseExitList(t *testing.T) {
+	key, err := bls.RandKey()
+	require.NoError(t, err)
+	pubKey := hexutil.Encode(key.PublicKey().Marshal())
+
+	targets, err := ParseExitList(strings.NewReader("# validators to exit\n1\n\n" + pubKey + "\n 3, " + pubKey + "\n"))
+	require.NoError(t, err)
+	require.Equal(t, 3, len(targets))
+	assert.DeepEqual(t, &ExitTarget{Index: 1, HasIndex: true}, targets[0])
+	assert.DeepEqual(t, &ExitTarget{PubKey: key.PublicKey().Marshal()}, targets[1])
+	assert.DeepEqual(t, &ExitTarget{Index: 3, HasIndex: true, PubKey: key.PublicKey().Marshal()}, targets[2])
+
+	_, err = ParseExitList(strings.NewReader("1\nfoo\n"))
+	assert.ErrorContains(t, "line 2: invalid validator index foo", err)
+	_, err = ParseExitList(strings.NewReader("0x1234"))
+	assert.ErrorContains(t, "line 1: invalid public key 0x1234", err)
+	_, err = ParseExitList(strings.NewReader("1,2"))
+	assert.ErrorContains(t, "line 1: more than one validator index", err)
+}
+
+func TestSignVoluntaryExit_Offline(t *testing.T) {
+	ctx := context.Background()
+	km, err := local.NewInteropKeymanager(ctx, 0, 1)
+	require.NoError(t, err)
+	pubKeys, err := km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+
+	genesisValidatorsRoot := make([]byte, 32)
+	genesisValidatorsRoot[0] = 1
+	domain, err := VoluntaryExitDomain(params.BeaconConfig().GenesisForkVersion, genesisValidatorsRoot)
+	require.NoError(t, err)
+	_, err = VoluntaryExitDomain([]byte{1}, genesisValidatorsRoot)
+	assert.ErrorContains(t, "fork version must be 4 bytes long", err)
+
+	exit := &ethpb.VoluntaryExit{Epoch: 10, ValidatorIndex: 5}
+	signedExit, err := SignVoluntaryExit(ctx, km.Sign, pubKeys[0][:], exit, domain)
+	require.NoError(t, err)
+	require.NoError(t, signing.VerifySigningRoot(exit, pubKeys[0][:], signedExit.Signature, domain))
+
+	dir := filepath.Join(t.TempDir(), "exits")
+	path, err := WriteSignedVoluntaryExit(dir, signedExit)
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, "voluntary-exit-5.json"), path)
+	b, err := os.ReadFile(path)
+	require.NoError(t, err)
+	saved := &apimiddleware.SignedVoluntaryExitJson{}
+	require.NoError(t, json.Unmarshal(b, saved))
+	assert.Equal(t, "10", saved.Exit.Epoch)
+	assert.Equal(t, "5", saved.Exit.ValidatorIndex)
+	assert.Equal(t, hexutil.Encode(signedExit.Signature), saved.Signature)
+}
@@ -128,6 +128,17 @@ func InputRemoteKeymanagerConfig(cliCtx *cli.Context) (*remote.KeymanagerOpts, e
 		}
 	}
 
+	// Any addresses after the first one are failover replicas of the remote signer.
+	addrs := make([]string, 0)
+	for _, a := range strings.Split(addr, ",") {
+		if a = strings.TrimSpace(a); a != "" {
+			addrs = append(addrs, a)
+		}
+	}
+	if len(addrs) == 0 {
+		return nil, errors.New("remote gRPC address cannot be empty")
+	}
+
 	newCfg := &remote.KeymanagerOpts{
 		RemoteCertificate: &remote.CertificateConfig{
 			RequireTls:     requireTls,
@@ -135,7 +146,8 @@ func InputRemoteKeymanagerConfig(cliCtx *cli.Context) (*remote.KeymanagerOpts, e
 			ClientKeyPath:  keyPath,
 			CACertPath:     caPath,
 		},
-		RemoteAddr: addr,
+		RemoteAddr:  addrs[0],
+		RemoteAddrs: addrs[1:],
 	}
 	fmt.Printf("%s\n", newCfg)
 	return newCfg, nil
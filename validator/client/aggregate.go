@@ -15,6 +15,7 @@ import (
 	validatorpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1/validator-client"
 	prysmTime "github.com/prysmaticlabs/prysm/v3/time"
 	"github.com/prysmaticlabs/prysm/v3/time/slots"
+	"github.com/prysmaticlabs/prysm/v3/validator/client/iface"
 	"go.opencensus.io/trace"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -31,12 +32,15 @@ func (v *validator) SubmitAggregateAndProof(ctx context.Context, slot types.Slot
 	span.AddAttributes(trace.StringAttribute("validator", fmt.Sprintf("%#x", pubKey)))
 	fmtKey := fmt.Sprintf("%#x", pubKey[:])
 
+	v.waitInjectedDutyDelay(ctx, iface.RoleAggregator, slot, pubKey)
+
 	duty, err := v.duty(pubKey)
 	if err != nil {
 		log.WithError(err).Error("Could not fetch validator assignment")
 		if v.emitAccountMetrics {
 			ValidatorAggFailVec.WithLabelValues(fmtKey).Inc()
 		}
+		v.sendDutyEvent(validatorpb.DutyEvent_AGGREGATION, slot, pubKey, err)
 		return
 	}
 
@@ -56,13 +60,14 @@ func (v *validator) SubmitAggregateAndProof(ctx context.Context, slot types.Slot
 		if v.emitAccountMetrics {
 			ValidatorAggFailVec.WithLabelValues(fmtKey).Inc()
 		}
+		v.sendDutyEvent(validatorpb.DutyEvent_AGGREGATION, slot, pubKey, err)
 		return
 	}
 
 	// As specified in spec, an aggregator should wait until two thirds of the way through slot
 	// to broadcast the best aggregate to the global aggregate channel.
 	// https://github.com/ethereum/consensus-specs/blob/v0.9.3/specs/validator/0_beacon-chain-validator.md#broadcast-aggregate
-	v.waitToSlotTwoThirds(ctx, slot)
+	v.waitToSlotTwoThirds(ctx, slot, v.aggregationOffset(pubKey))
 
 	res, err := v.validatorClient.SubmitAggregateSelectionProof(ctx, &ethpb.AggregateSelectionRequest{
 		Slot:           slot,
@@ -80,13 +85,14 @@ func (v *validator) SubmitAggregateAndProof(ctx context.Context, slot types.Slot
 				ValidatorAggFailVec.WithLabelValues(fmtKey).Inc()
 			}
 		}
-
+		v.sendDutyEvent(validatorpb.DutyEvent_AGGREGATION, slot, pubKey, err)
 		return
 	}
 
 	sig, err := v.aggregateAndProofSig(ctx, pubKey, res.AggregateAndProof, slot)
 	if err != nil {
 		log.WithError(err).Error("Could not sign aggregate and proof")
+		v.sendDutyEvent(validatorpb.DutyEvent_AGGREGATION, slot, pubKey, err)
 		return
 	}
 	_, err = v.validatorClient.SubmitSignedAggregateSelectionProof(ctx, &ethpb.SignedAggregateSubmitRequest{
@@ -100,8 +106,10 @@ func (v *validator) SubmitAggregateAndProof(ctx context.Context, slot types.Slot
 		if v.emitAccountMetrics {
 			ValidatorAggFailVec.WithLabelValues(fmtKey).Inc()
 		}
+		v.sendDutyEvent(validatorpb.DutyEvent_AGGREGATION, slot, pubKey, err)
 		return
 	}
+	v.sendDutyEvent(validatorpb.DutyEvent_AGGREGATION, slot, pubKey, nil)
 
 	if err := v.addIndicesToLog(duty); err != nil {
 		log.WithError(err).Error("Could not add aggregator indices to logs")
@@ -145,14 +153,15 @@ func (v *validator) signSlotWithSelectionProof(ctx context.Context, pubKey [fiel
 
 // waitToSlotTwoThirds waits until two third through the current slot period
 // such that any attestations from this slot have time to reach the beacon node
-// before creating the aggregated attestation.
-func (v *validator) waitToSlotTwoThirds(ctx context.Context, slot types.Slot) {
+// before creating the aggregated attestation. The offset spreads the submissions
+// of the validators after two thirds of the slot.
+func (v *validator) waitToSlotTwoThirds(ctx context.Context, slot types.Slot, offset time.Duration) {
 	ctx, span := trace.StartSpan(ctx, "validator.waitToSlotTwoThirds")
 	defer span.End()
 
 	oneThird := slots.DivideSlotBy(3 /* one third of slot duration */)
 	twoThird := oneThird + oneThird
-	delay := twoThird
+	delay := twoThird + offset
 
 	startTime := slots.StartTime(v.genesisTime, slot)
 	finalTime := startTime.Add(delay)
@@ -126,7 +126,7 @@ func TestWaitForSlotTwoThird_WaitCorrectly(t *testing.T) {
 	timeToSleep := oneThird + oneThird
 
 	twoThirdTime := currentTime.Add(timeToSleep)
-	validator.waitToSlotTwoThirds(context.Background(), numOfSlots)
+	validator.waitToSlotTwoThirds(context.Background(), numOfSlots, 0)
 	currentTime = time.Now()
 	assert.Equal(t, twoThirdTime.Unix(), currentTime.Unix())
 }
@@ -141,7 +141,7 @@ func TestWaitForSlotTwoThird_DoneContext_ReturnsImmediately(t *testing.T) {
 	expectedTime := time.Now()
 	ctx, cancel := context.WithCancel(context.Background())
 	cancel()
-	validator.waitToSlotTwoThirds(ctx, numOfSlots)
+	validator.waitToSlotTwoThirds(ctx, numOfSlots, 0)
 	currentTime = time.Now()
 	assert.Equal(t, expectedTime.Unix(), currentTime.Unix())
 }
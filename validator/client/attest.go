@@ -37,7 +37,8 @@ func (v *validator) SubmitAttestation(ctx context.Context, slot types.Slot, pubK
 	defer span.End()
 	span.AddAttributes(trace.StringAttribute("validator", fmt.Sprintf("%#x", pubKey)))
 
-	v.waitOneThirdOrValidBlock(ctx, slot)
+	v.waitInjectedDutyDelay(ctx, iface.RoleAttester, slot, pubKey)
+	v.waitOneThirdOrValidBlock(ctx, slot, v.attestationOffset(pubKey))
 
 	var b strings.Builder
 	if err := b.WriteByte(byte(iface.RoleAttester)); err != nil {
@@ -63,6 +64,7 @@ func (v *validator) SubmitAttestation(ctx context.Context, slot types.Slot, pubK
 		if v.emitAccountMetrics {
 			ValidatorAttestFailVec.WithLabelValues(fmtKey).Inc()
 		}
+		v.sendDutyEvent(validatorpb.DutyEvent_ATTESTATION, slot, pubKey, err)
 		tracing.AnnotateError(span, err)
 		return
 	}
@@ -81,6 +83,7 @@ func (v *validator) SubmitAttestation(ctx context.Context, slot types.Slot, pubK
 		if v.emitAccountMetrics {
 			ValidatorAttestFailVec.WithLabelValues(fmtKey).Inc()
 		}
+		v.sendDutyEvent(validatorpb.DutyEvent_ATTESTATION, slot, pubKey, err)
 		tracing.AnnotateError(span, err)
 		return
 	}
@@ -96,6 +99,7 @@ func (v *validator) SubmitAttestation(ctx context.Context, slot types.Slot, pubK
 		if v.emitAccountMetrics {
 			ValidatorAttestFailVec.WithLabelValues(fmtKey).Inc()
 		}
+		v.sendDutyEvent(validatorpb.DutyEvent_ATTESTATION, slot, pubKey, err)
 		tracing.AnnotateError(span, err)
 		return
 	}
@@ -106,6 +110,7 @@ func (v *validator) SubmitAttestation(ctx context.Context, slot types.Slot, pubK
 		if v.emitAccountMetrics {
 			ValidatorAttestFailVec.WithLabelValues(fmtKey).Inc()
 		}
+		v.sendDutyEvent(validatorpb.DutyEvent_ATTESTATION, slot, pubKey, err)
 		tracing.AnnotateError(span, err)
 		return
 	}
@@ -120,10 +125,12 @@ func (v *validator) SubmitAttestation(ctx context.Context, slot types.Slot, pubK
 		}
 	}
 	if !found {
-		log.Errorf("Validator ID %d not found in committee of %v", duty.ValidatorIndex, duty.Committee)
+		err := fmt.Errorf("validator ID %d not found in committee of %v", duty.ValidatorIndex, duty.Committee)
+		log.Error(err)
 		if v.emitAccountMetrics {
 			ValidatorAttestFailVec.WithLabelValues(fmtKey).Inc()
 		}
+		v.sendDutyEvent(validatorpb.DutyEvent_ATTESTATION, slot, pubKey, err)
 		return
 	}
 
@@ -142,6 +149,7 @@ func (v *validator) SubmitAttestation(ctx context.Context, slot types.Slot, pubK
 		log.WithFields(
 			attestationLogFields(pubKey, indexedAtt),
 		).Debug("Attempted slashable attestation details")
+		v.sendDutyEvent(validatorpb.DutyEvent_ATTESTATION, slot, pubKey, err)
 		tracing.AnnotateError(span, err)
 		return
 	}
@@ -151,9 +159,11 @@ func (v *validator) SubmitAttestation(ctx context.Context, slot types.Slot, pubK
 		if v.emitAccountMetrics {
 			ValidatorAttestFailVec.WithLabelValues(fmtKey).Inc()
 		}
+		v.sendDutyEvent(validatorpb.DutyEvent_ATTESTATION, slot, pubKey, err)
 		tracing.AnnotateError(span, err)
 		return
 	}
+	v.sendDutyEvent(validatorpb.DutyEvent_ATTESTATION, slot, pubKey, nil)
 
 	if err := v.saveAttesterIndexToData(data, duty.ValidatorIndex); err != nil {
 		log.WithError(err).Error("Could not save validator index for logging")
@@ -248,11 +258,18 @@ func (v *validator) saveAttesterIndexToData(data *ethpb.AttestationData, index t
 
 // waitOneThirdOrValidBlock waits until (a) or (b) whichever comes first:
 //   (a) the validator has received a valid block that is the same slot as input slot
-//   (b) one-third of the slot has transpired (SECONDS_PER_SLOT / 3 seconds after the start of slot)
-func (v *validator) waitOneThirdOrValidBlock(ctx context.Context, slot types.Slot) {
+//   (b) one-third of the slot has transpired (SECONDS_PER_SLOT / 3 seconds after the start of slot),
+//       or the maximum block wait when it is configured
+// A positive offset delays the validator once the wait for the block ends, while a negative
+// offset shortens the wait for the block.
+func (v *validator) waitOneThirdOrValidBlock(ctx context.Context, slot types.Slot, offset time.Duration) {
 	ctx, span := trace.StartSpan(ctx, "validator.waitOneThirdOrValidBlock")
 	defer span.End()
 
+	if offset > 0 {
+		defer waitFor(ctx, offset)
+	}
+
 	// Don't need to wait if requested slot is the same as highest valid slot.
 	v.highestValidSlotLock.Lock()
 	if slot <= v.highestValidSlot {
@@ -261,7 +278,10 @@ func (v *validator) waitOneThirdOrValidBlock(ctx context.Context, slot types.Slo
 	}
 	v.highestValidSlotLock.Unlock()
 
-	delay := slots.DivideSlotBy(3 /* a third of the slot duration */)
+	delay := v.blockWait()
+	if offset < 0 {
+		delay += offset
+	}
 	startTime := slots.StartTime(v.genesisTime, slot)
 	finalTime := startTime.Add(delay)
 	wait := prysmTime.Until(finalTime)
@@ -74,6 +74,8 @@ func (v *validator) slashableAttestationCheck(
 			log.Warn("Attestation is slashable as it is surrounding a previous attestation")
 		case kv.SurroundedVote:
 			log.Warn("Attestation is slashable as it is surrounded by a previous attestation")
+		case kv.BelowWatermark:
+			log.Warn("Attestation is not above the highest signed source and target epochs")
 		}
 		return errors.Wrap(err, failedAttLocalProtectionErr)
 	}
@@ -498,7 +498,7 @@ func TestServer_WaitToSlotOneThird_CanWait(t *testing.T) {
 
 	timeToSleep := params.BeaconConfig().SecondsPerSlot / 3
 	oneThird := currentTime + timeToSleep
-	v.waitOneThirdOrValidBlock(context.Background(), currentSlot)
+	v.waitOneThirdOrValidBlock(context.Background(), currentSlot, 0)
 
 	if oneThird != uint64(time.Now().Unix()) {
 		t.Errorf("Wanted %d time for slot one third but got %d", oneThird, currentTime)
@@ -516,7 +516,7 @@ func TestServer_WaitToSlotOneThird_SameReqSlot(t *testing.T) {
 		highestValidSlot: currentSlot,
 	}
 
-	v.waitOneThirdOrValidBlock(context.Background(), currentSlot)
+	v.waitOneThirdOrValidBlock(context.Background(), currentSlot, 0)
 
 	if currentTime != uint64(time.Now().Unix()) {
 		t.Errorf("Wanted %d time for slot one third but got %d", uint64(time.Now().Unix()), currentTime)
@@ -549,7 +549,7 @@ func TestServer_WaitToSlotOneThird_ReceiveBlockSlot(t *testing.T) {
 		wg.Done()
 	}()
 
-	v.waitOneThirdOrValidBlock(context.Background(), currentSlot)
+	v.waitOneThirdOrValidBlock(context.Background(), currentSlot, 0)
 
 	if currentTime != uint64(time.Now().Unix()) {
 		t.Errorf("Wanted %d time for slot one third but got %d", uint64(time.Now().Unix()), currentTime)
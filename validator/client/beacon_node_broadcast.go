@@ -0,0 +1,137 @@
+package client
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/prysmaticlabs/prysm/v3/monitoring/tracing"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc"
+)
+
+// broadcastValidatorClient fetches duties and data from the primary beacon node, and submits signed
+// attestations, aggregates, sync committee messages and blocks to every configured beacon node at once.
+// Messages are signed once before they are submitted, so every beacon node receives the same signature
+// for a duty.
+type broadcastValidatorClient struct {
+	ethpb.BeaconNodeValidatorClient
+	conn    *beaconNodeConn
+	clients []ethpb.BeaconNodeValidatorClient
+}
+
+// newBroadcastValidatorClient wraps the validator client of the primary beacon node, so that signed
+// messages are also submitted to the other beacon nodes of the connection.
+func newBroadcastValidatorClient(conn *beaconNodeConn) *broadcastValidatorClient {
+	clients := make([]ethpb.BeaconNodeValidatorClient, len(conn.conns))
+	for i, cc := range conn.conns {
+		clients[i] = ethpb.NewBeaconNodeValidatorClient(cc)
+	}
+	return &broadcastValidatorClient{
+		BeaconNodeValidatorClient: ethpb.NewBeaconNodeValidatorClient(conn),
+		conn:                      conn,
+		clients:                   clients,
+	}
+}
+
+type broadcastResult[T any] struct {
+	resp T
+	err  error
+}
+
+// broadcast submits a signed message to the primary beacon node and, concurrently, to every other
+// beacon node. The response of the primary beacon node is returned if it accepted the message.
+// Otherwise the response of another beacon node which accepted the message is returned, so a duty is
+// only reported as failed if no beacon node accepted it.
+func broadcast[T any](
+	ctx context.Context,
+	c *broadcastValidatorClient,
+	method string,
+	submit func(ethpb.BeaconNodeValidatorClient) (T, error),
+) (T, error) {
+	ctx, span := trace.StartSpan(ctx, "validator.broadcast")
+	defer span.End()
+	span.AddAttributes(trace.StringAttribute("method", method))
+
+	primary := c.conn.primaryIndex()
+	results := make(chan broadcastResult[T], len(c.clients)-1)
+	for i, client := range c.clients {
+		if i == primary {
+			continue
+		}
+		go func(endpoint string, client ethpb.BeaconNodeValidatorClient) {
+			resp, err := submit(client)
+			if err != nil {
+				log.WithError(err).WithFields(logrus.Fields{
+					"endpoint": endpoint,
+					"method":   method,
+				}).Debug("Beacon node did not accept broadcast message")
+			}
+			BeaconNodeBroadcastsTotal.WithLabelValues(endpoint, method, broadcastOutcome(err)).Inc()
+			results <- broadcastResult[T]{resp: resp, err: err}
+		}(c.conn.endpoints[i], client)
+	}
+
+	resp, err := submit(c.BeaconNodeValidatorClient)
+	BeaconNodeBroadcastsTotal.WithLabelValues(c.conn.endpoints[primary], method, broadcastOutcome(err)).Inc()
+	if err == nil {
+		return resp, nil
+	}
+	for i := 0; i < len(c.clients)-1; i++ {
+		select {
+		case r := <-results:
+			if r.err == nil {
+				log.WithError(err).WithField("method", method).Warn("Primary beacon node did not accept message, but another beacon node did")
+				return r.resp, nil
+			}
+		case <-ctx.Done():
+			tracing.AnnotateError(span, ctx.Err())
+			return resp, err
+		}
+	}
+	tracing.AnnotateError(span, err)
+	return resp, err
+}
+
+func broadcastOutcome(err error) string {
+	if err != nil {
+		return "failure"
+	}
+	return "success"
+}
+
+// ProposeBeaconBlock submits a signed block to every beacon node.
+func (c *broadcastValidatorClient) ProposeBeaconBlock(ctx context.Context, in *ethpb.GenericSignedBeaconBlock, opts ...grpc.CallOption) (*ethpb.ProposeResponse, error) {
+	return broadcast(ctx, c, "ProposeBeaconBlock", func(client ethpb.BeaconNodeValidatorClient) (*ethpb.ProposeResponse, error) {
+		return client.ProposeBeaconBlock(ctx, in, opts...)
+	})
+}
+
+// ProposeAttestation submits a signed attestation to every beacon node.
+func (c *broadcastValidatorClient) ProposeAttestation(ctx context.Context, in *ethpb.Attestation, opts ...grpc.CallOption) (*ethpb.AttestResponse, error) {
+	return broadcast(ctx, c, "ProposeAttestation", func(client ethpb.BeaconNodeValidatorClient) (*ethpb.AttestResponse, error) {
+		return client.ProposeAttestation(ctx, in, opts...)
+	})
+}
+
+// SubmitSignedAggregateSelectionProof submits a signed aggregate and proof to every beacon node.
+func (c *broadcastValidatorClient) SubmitSignedAggregateSelectionProof(ctx context.Context, in *ethpb.SignedAggregateSubmitRequest, opts ...grpc.CallOption) (*ethpb.SignedAggregateSubmitResponse, error) {
+	return broadcast(ctx, c, "SubmitSignedAggregateSelectionProof", func(client ethpb.BeaconNodeValidatorClient) (*ethpb.SignedAggregateSubmitResponse, error) {
+		return client.SubmitSignedAggregateSelectionProof(ctx, in, opts...)
+	})
+}
+
+// SubmitSyncMessage submits a signed sync committee message to every beacon node.
+func (c *broadcastValidatorClient) SubmitSyncMessage(ctx context.Context, in *ethpb.SyncCommitteeMessage, opts ...grpc.CallOption) (*empty.Empty, error) {
+	return broadcast(ctx, c, "SubmitSyncMessage", func(client ethpb.BeaconNodeValidatorClient) (*empty.Empty, error) {
+		return client.SubmitSyncMessage(ctx, in, opts...)
+	})
+}
+
+// SubmitSignedContributionAndProof submits a signed sync committee contribution and proof to every beacon node.
+func (c *broadcastValidatorClient) SubmitSignedContributionAndProof(ctx context.Context, in *ethpb.SignedContributionAndProof, opts ...grpc.CallOption) (*empty.Empty, error) {
+	return broadcast(ctx, c, "SubmitSignedContributionAndProof", func(client ethpb.BeaconNodeValidatorClient) (*empty.Empty, error) {
+		return client.SubmitSignedContributionAndProof(ctx, in, opts...)
+	})
+}
@@ -0,0 +1,96 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+	"google.golang.org/grpc"
+)
+
+type fakeValidatorServer struct {
+	ethpb.UnimplementedBeaconNodeValidatorServer
+	reject       bool
+	lock         sync.Mutex
+	attestations []*ethpb.Attestation
+	received     chan struct{}
+}
+
+func (s *fakeValidatorServer) ProposeAttestation(_ context.Context, att *ethpb.Attestation) (*ethpb.AttestResponse, error) {
+	s.lock.Lock()
+	s.attestations = append(s.attestations, att)
+	reject := s.reject
+	s.lock.Unlock()
+	s.received <- struct{}{}
+	if reject {
+		return nil, errors.New("rejected")
+	}
+	return &ethpb.AttestResponse{AttestationDataRoot: att.Signature}, nil
+}
+
+func startFakeValidatorServer(t *testing.T, server *fakeValidatorServer) string {
+	server.received = make(chan struct{}, 10)
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	s := grpc.NewServer()
+	ethpb.RegisterBeaconNodeValidatorServer(s, server)
+	go func() {
+		if err := s.Serve(lis); err != nil {
+			t.Log(err)
+		}
+	}()
+	t.Cleanup(s.Stop)
+	return lis.Addr().String()
+}
+
+func TestBroadcastValidatorClient_SubmitsToEveryBeaconNode(t *testing.T) {
+	ctx := context.Background()
+	servers := []*fakeValidatorServer{{}, {}, {}}
+	endpoints := make([]string, len(servers))
+	for i, s := range servers {
+		endpoints[i] = startFakeValidatorServer(t, s)
+	}
+	conn, err := dialBeaconNodes(ctx, endpoints, grpc.WithInsecure())
+	require.NoError(t, err)
+	defer func() { require.NoError(t, conn.Close()) }()
+
+	client := newBroadcastValidatorClient(conn)
+	att := &ethpb.Attestation{Signature: []byte{'a'}}
+	resp, err := client.ProposeAttestation(ctx, att)
+	require.NoError(t, err)
+	assert.DeepEqual(t, att.Signature, resp.AttestationDataRoot)
+	for _, s := range servers {
+		<-s.received
+		s.lock.Lock()
+		require.Equal(t, 1, len(s.attestations))
+		// Every beacon node receives the same signature.
+		assert.DeepEqual(t, att.Signature, s.attestations[0].Signature)
+		s.lock.Unlock()
+	}
+}
+
+func TestBroadcastValidatorClient_PrimaryRejects(t *testing.T) {
+	ctx := context.Background()
+	primary := &fakeValidatorServer{reject: true}
+	other := &fakeValidatorServer{}
+	conn, err := dialBeaconNodes(ctx, []string{startFakeValidatorServer(t, primary), startFakeValidatorServer(t, other)}, grpc.WithInsecure())
+	require.NoError(t, err)
+	defer func() { require.NoError(t, conn.Close()) }()
+
+	client := newBroadcastValidatorClient(conn)
+	resp, err := client.ProposeAttestation(ctx, &ethpb.Attestation{Signature: []byte{'b'}})
+	require.NoError(t, err)
+	assert.DeepEqual(t, []byte{'b'}, resp.AttestationDataRoot)
+
+	// The server goroutines read the field concurrently.
+	other.lock.Lock()
+	other.reject = true
+	other.lock.Unlock()
+	_, err = client.ProposeAttestation(ctx, &ethpb.Attestation{Signature: []byte{'c'}})
+	require.ErrorContains(t, "rejected", err)
+}
@@ -0,0 +1,321 @@
+package client
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v3/config/params"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	validatorpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1/validator-client"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// maxHeadSlotLag is the number of slots the head of a beacon node may lag behind the best head
+// among the probed beacon nodes before it is ranked below the beacon nodes which are not lagging.
+const maxHeadSlotLag = 2
+
+// beaconNodeStatus is the health of a beacon node, as determined by its latest probe.
+type beaconNodeStatus struct {
+	reachable          bool
+	syncing            bool
+	optimistic         bool
+	executionConnected bool
+	headSlot           types.Slot
+	peerCount          uint64
+	lastProbe          time.Time
+}
+
+// healthy returns true if the beacon node can be relied on to perform duties.
+func (s *beaconNodeStatus) healthy() bool {
+	return s.reachable && !s.syncing && !s.optimistic && s.executionConnected && s.peerCount > 0
+}
+
+// beaconNodeConn is a gRPC client connection to one or more beacon nodes. Requests are sent to the
+// primary beacon node, which is the best ranked beacon node according to the latest health probes.
+// Requests failing because the primary beacon node cannot be reached are retried on the next best
+// ranked beacon node, so duties move to a healthy beacon node within the same slot.
+type beaconNodeConn struct {
+	endpoints []string
+	conns     []*grpc.ClientConn
+	statuses  []*beaconNodeStatus
+	primary   int
+	lock      sync.RWMutex
+}
+
+// dialBeaconNodes dials each of the given beacon node endpoints.
+func dialBeaconNodes(ctx context.Context, endpoints []string, opts ...grpc.DialOption) (*beaconNodeConn, error) {
+	c := &beaconNodeConn{
+		endpoints: endpoints,
+		conns:     make([]*grpc.ClientConn, 0, len(endpoints)),
+		statuses:  make([]*beaconNodeStatus, len(endpoints)),
+	}
+	for i, endpoint := range endpoints {
+		conn, err := grpc.DialContext(ctx, endpoint, opts...)
+		if err != nil {
+			if closeErr := c.Close(); closeErr != nil {
+				log.WithError(closeErr).Error("Could not close beacon node connections")
+			}
+			return nil, errors.Wrapf(err, "could not dial beacon node endpoint %s", endpoint)
+		}
+		c.conns = append(c.conns, conn)
+		c.statuses[i] = &beaconNodeStatus{}
+	}
+	BeaconNodePrimaryGaugeVec.WithLabelValues(endpoints[0]).Set(1)
+	return c, nil
+}
+
+// Invoke performs a unary RPC on the primary beacon node, and retries it on the next best ranked
+// beacon node if the primary cannot be reached.
+func (c *beaconNodeConn) Invoke(ctx context.Context, method string, args, reply interface{}, opts ...grpc.CallOption) error {
+	idx := c.primaryIndex()
+	err := c.conns[idx].Invoke(ctx, method, args, reply, opts...)
+	if !c.shouldFailover(ctx, err) {
+		return err
+	}
+	next, ok := c.failover(idx, err)
+	if !ok {
+		return err
+	}
+	return c.conns[next].Invoke(ctx, method, args, reply, opts...)
+}
+
+// NewStream begins a streaming RPC on the primary beacon node, and retries it on the next best
+// ranked beacon node if the primary cannot be reached.
+func (c *beaconNodeConn) NewStream(ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	idx := c.primaryIndex()
+	stream, err := c.conns[idx].NewStream(ctx, desc, method, opts...)
+	if !c.shouldFailover(ctx, err) {
+		return stream, err
+	}
+	next, ok := c.failover(idx, err)
+	if !ok {
+		return stream, err
+	}
+	return c.conns[next].NewStream(ctx, desc, method, opts...)
+}
+
+// Close closes the connections to all beacon nodes.
+func (c *beaconNodeConn) Close() error {
+	var err error
+	for _, conn := range c.conns {
+		if closeErr := conn.Close(); closeErr != nil {
+			err = closeErr
+		}
+	}
+	return err
+}
+
+func (c *beaconNodeConn) primaryIndex() int {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return c.primary
+}
+
+// PrimaryEndpoint returns the endpoint of the beacon node requests are currently sent to.
+func (c *beaconNodeConn) PrimaryEndpoint() string {
+	return c.endpoints[c.primaryIndex()]
+}
+
+func (c *beaconNodeConn) shouldFailover(ctx context.Context, err error) bool {
+	if err == nil || len(c.conns) == 1 || ctx.Err() != nil {
+		return false
+	}
+	return status.Code(err) == codes.Unavailable
+}
+
+// failover marks the beacon node at idx as unreachable and makes the best ranked of the other
+// beacon nodes the primary. It returns false if the primary was not switched.
+func (c *beaconNodeConn) failover(idx int, err error) (int, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.statuses[idx].reachable = false
+	BeaconNodeHealthyGaugeVec.WithLabelValues(c.endpoints[idx]).Set(0)
+	if c.primary != idx {
+		// Another request already failed over.
+		return c.primary, true
+	}
+	for _, next := range c.ranked() {
+		if next != idx {
+			c.setPrimary(next, logrus.Fields{"error": err})
+			return next, true
+		}
+	}
+	return idx, false
+}
+
+// ranked returns the indices of the beacon nodes from best to worst. Healthy beacon nodes whose head
+// is not lagging are ranked first, followed by healthy beacon nodes which are lagging, reachable but
+// unhealthy beacon nodes and unreachable beacon nodes. Beacon nodes of the same rank keep their
+// configured order, so the first configured beacon node is preferred when it is healthy.
+// The caller must hold the lock.
+func (c *beaconNodeConn) ranked() []int {
+	var bestHead types.Slot
+	for _, s := range c.statuses {
+		if s.reachable && s.headSlot > bestHead {
+			bestHead = s.headSlot
+		}
+	}
+	tier := func(i int) int {
+		s := c.statuses[i]
+		switch {
+		case s.healthy() && s.headSlot+maxHeadSlotLag >= bestHead:
+			return 0
+		case s.healthy():
+			return 1
+		case s.reachable:
+			return 2
+		default:
+			return 3
+		}
+	}
+	indices := make([]int, len(c.statuses))
+	for i := range indices {
+		indices[i] = i
+	}
+	sort.SliceStable(indices, func(i, j int) bool { return tier(indices[i]) < tier(indices[j]) })
+	return indices
+}
+
+// setPrimary makes the beacon node at idx the primary. The caller must hold the lock.
+func (c *beaconNodeConn) setPrimary(idx int, fields logrus.Fields) {
+	if c.primary == idx {
+		return
+	}
+	log.WithFields(fields).WithFields(logrus.Fields{
+		"previous": c.endpoints[c.primary],
+		"current":  c.endpoints[idx],
+	}).Warn("Switched primary beacon node")
+	BeaconNodePrimaryGaugeVec.WithLabelValues(c.endpoints[c.primary]).Set(0)
+	BeaconNodePrimaryGaugeVec.WithLabelValues(c.endpoints[idx]).Set(1)
+	BeaconNodeSwitchesTotal.WithLabelValues(c.endpoints[idx]).Inc()
+	c.primary = idx
+}
+
+// probe checks the health of every beacon node, and switches the primary beacon node if another
+// beacon node is ranked better.
+func (c *beaconNodeConn) probe(ctx context.Context, timeout time.Duration) {
+	statuses := make([]*beaconNodeStatus, len(c.conns))
+	var wg sync.WaitGroup
+	for i := range c.conns {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			probeCtx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+			statuses[i] = probeBeaconNode(probeCtx, c.conns[i])
+		}(i)
+	}
+	wg.Wait()
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	for i, s := range statuses {
+		c.statuses[i] = s
+		if s.healthy() {
+			BeaconNodeHealthyGaugeVec.WithLabelValues(c.endpoints[i]).Set(1)
+		} else {
+			BeaconNodeHealthyGaugeVec.WithLabelValues(c.endpoints[i]).Set(0)
+		}
+	}
+	ranked := c.ranked()
+	best := ranked[0]
+	for _, idx := range ranked {
+		if idx == c.primary {
+			// The primary is ranked at least as well as any beacon node after it.
+			return
+		}
+		if idx == best {
+			break
+		}
+	}
+	primary := c.statuses[c.primary]
+	c.setPrimary(best, logrus.Fields{
+		"reachable":          primary.reachable,
+		"syncing":            primary.syncing,
+		"optimistic":         primary.optimistic,
+		"executionConnected": primary.executionConnected,
+		"headSlot":           primary.headSlot,
+		"peers":              primary.peerCount,
+	})
+}
+
+// probeBeaconNode queries the sync status, chain head, execution client connection and peers of a beacon node.
+func probeBeaconNode(ctx context.Context, conn grpc.ClientConnInterface) *beaconNodeStatus {
+	s := &beaconNodeStatus{lastProbe: time.Now()}
+	nodeClient := ethpb.NewNodeClient(conn)
+	syncStatus, err := nodeClient.GetSyncStatus(ctx, &emptypb.Empty{})
+	if err != nil {
+		return s
+	}
+	s.reachable = true
+	s.syncing = syncStatus.Syncing
+	head, err := ethpb.NewBeaconChainClient(conn).GetChainHead(ctx, &emptypb.Empty{})
+	if err != nil {
+		// The head is unknown, so consider it optimistic.
+		s.optimistic = true
+	} else {
+		s.headSlot = head.HeadSlot
+		s.optimistic = head.OptimisticStatus
+	}
+	eth1Status, err := nodeClient.GetETH1ConnectionStatus(ctx, &emptypb.Empty{})
+	s.executionConnected = err == nil && eth1Status.CurrentConnectionError == ""
+	peers, err := nodeClient.ListPeers(ctx, &emptypb.Empty{})
+	if err == nil {
+		for _, p := range peers.Peers {
+			if p.ConnectionState == ethpb.ConnectionState_CONNECTED {
+				s.peerCount++
+			}
+		}
+	}
+	return s
+}
+
+// run probes the health of the beacon nodes several times per slot until the context is done.
+func (c *beaconNodeConn) run(ctx context.Context) {
+	interval := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second / 4
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	c.probe(ctx, interval)
+	for {
+		select {
+		case <-ticker.C:
+			c.probe(ctx, interval)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// Statuses returns the health of each beacon node as of its latest probe.
+func (c *beaconNodeConn) Statuses() []*validatorpb.BeaconNodeStatus {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	statuses := make([]*validatorpb.BeaconNodeStatus, len(c.statuses))
+	for i, s := range c.statuses {
+		var lastProbe uint64
+		if !s.lastProbe.IsZero() {
+			lastProbe = uint64(s.lastProbe.Unix())
+		}
+		statuses[i] = &validatorpb.BeaconNodeStatus{
+			Endpoint:           c.endpoints[i],
+			Primary:            i == c.primary,
+			Healthy:            s.healthy(),
+			Reachable:          s.reachable,
+			Syncing:            s.syncing,
+			Optimistic:         s.optimistic,
+			ExecutionConnected: s.executionConnected,
+			HeadSlot:           uint64(s.headSlot),
+			PeerCount:          s.peerCount,
+			LastProbe:          lastProbe,
+		}
+	}
+	return statuses
+}
@@ -0,0 +1,124 @@
+package client
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type fakeBeaconNode struct {
+	ethpb.UnimplementedNodeServer
+	ethpb.UnimplementedBeaconChainServer
+	syncing    bool
+	optimistic bool
+	headSlot   types.Slot
+	peers      int
+}
+
+func (n *fakeBeaconNode) GetSyncStatus(context.Context, *emptypb.Empty) (*ethpb.SyncStatus, error) {
+	return &ethpb.SyncStatus{Syncing: n.syncing}, nil
+}
+
+func (n *fakeBeaconNode) GetChainHead(context.Context, *emptypb.Empty) (*ethpb.ChainHead, error) {
+	return &ethpb.ChainHead{HeadSlot: n.headSlot, OptimisticStatus: n.optimistic}, nil
+}
+
+func (*fakeBeaconNode) GetETH1ConnectionStatus(context.Context, *emptypb.Empty) (*ethpb.ETH1ConnectionStatus, error) {
+	return &ethpb.ETH1ConnectionStatus{}, nil
+}
+
+func (n *fakeBeaconNode) ListPeers(context.Context, *emptypb.Empty) (*ethpb.Peers, error) {
+	peers := make([]*ethpb.Peer, n.peers)
+	for i := range peers {
+		peers[i] = &ethpb.Peer{ConnectionState: ethpb.ConnectionState_CONNECTED}
+	}
+	return &ethpb.Peers{Peers: peers}, nil
+}
+
+func startFakeBeaconNode(t *testing.T, node *fakeBeaconNode) (string, *grpc.Server) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := grpc.NewServer()
+	ethpb.RegisterNodeServer(server, node)
+	ethpb.RegisterBeaconChainServer(server, node)
+	go func() {
+		if err := server.Serve(lis); err != nil {
+			t.Log(err)
+		}
+	}()
+	t.Cleanup(server.Stop)
+	return lis.Addr().String(), server
+}
+
+func TestBeaconNodeConn_ProbeRanksBeaconNodes(t *testing.T) {
+	ctx := context.Background()
+	first := &fakeBeaconNode{syncing: true, headSlot: 10, peers: 5}
+	second := &fakeBeaconNode{headSlot: 10, peers: 5}
+	firstAddr, _ := startFakeBeaconNode(t, first)
+	secondAddr, _ := startFakeBeaconNode(t, second)
+	conn, err := dialBeaconNodes(ctx, []string{firstAddr, secondAddr}, grpc.WithInsecure())
+	require.NoError(t, err)
+	defer func() { require.NoError(t, conn.Close()) }()
+
+	// A syncing beacon node is switched away from.
+	conn.probe(ctx, time.Second)
+	assert.Equal(t, secondAddr, conn.PrimaryEndpoint())
+	statuses := conn.Statuses()
+	require.Equal(t, 2, len(statuses))
+	assert.Equal(t, false, statuses[0].Healthy)
+	assert.Equal(t, true, statuses[0].Syncing)
+	assert.Equal(t, true, statuses[1].Healthy)
+	assert.Equal(t, true, statuses[1].Primary)
+	assert.Equal(t, uint64(5), statuses[1].PeerCount)
+
+	// The first beacon node is preferred again once it is healthy.
+	first.syncing = false
+	conn.probe(ctx, time.Second)
+	assert.Equal(t, firstAddr, conn.PrimaryEndpoint())
+
+	// Optimistic, lagging and isolated beacon nodes are ranked below healthy ones.
+	first.optimistic = true
+	conn.probe(ctx, time.Second)
+	assert.Equal(t, secondAddr, conn.PrimaryEndpoint())
+	first.optimistic = false
+	second.headSlot = 20
+	conn.probe(ctx, time.Second)
+	assert.Equal(t, secondAddr, conn.PrimaryEndpoint())
+	first.headSlot = 20
+	conn.probe(ctx, time.Second)
+	assert.Equal(t, firstAddr, conn.PrimaryEndpoint())
+	first.peers = 0
+	conn.probe(ctx, time.Second)
+	assert.Equal(t, secondAddr, conn.PrimaryEndpoint())
+}
+
+func TestBeaconNodeConn_InvokeFailsOverToReachableBeaconNode(t *testing.T) {
+	ctx := context.Background()
+	firstAddr, firstServer := startFakeBeaconNode(t, &fakeBeaconNode{peers: 1})
+	secondAddr, _ := startFakeBeaconNode(t, &fakeBeaconNode{syncing: true, peers: 1})
+	conn, err := dialBeaconNodes(ctx, []string{firstAddr, secondAddr}, grpc.WithInsecure())
+	require.NoError(t, err)
+	defer func() { require.NoError(t, conn.Close()) }()
+
+	client := ethpb.NewNodeClient(conn)
+	resp, err := client.GetSyncStatus(ctx, &emptypb.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, false, resp.Syncing)
+	assert.Equal(t, firstAddr, conn.PrimaryEndpoint())
+
+	// The request is retried on the other beacon node when the primary is unreachable.
+	firstServer.Stop()
+	resp, err = client.GetSyncStatus(ctx, &emptypb.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, true, resp.Syncing)
+	assert.Equal(t, secondAddr, conn.PrimaryEndpoint())
+	assert.Equal(t, false, conn.Statuses()[0].Reachable)
+}
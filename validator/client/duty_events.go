@@ -0,0 +1,49 @@
+package client
+
+import (
+	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
+	validatorpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1/validator-client"
+	prysmTime "github.com/prysmaticlabs/prysm/v3/time"
+	"github.com/prysmaticlabs/prysm/v3/time/slots"
+)
+
+// dutyEventQueueSize is the number of duty events waiting to be sent to the subscribers of the duty
+// event feed. Further events are dropped, so that a slow subscriber never delays a duty.
+const dutyEventQueueSize = 256
+
+// sendDutyEvent reports the outcome of a duty to the subscribers of the duty event feed. A nil error
+// means the duty was performed, any other error means the duty was missed. The event is sent in the
+// background, and dropped if too many events are waiting to be sent.
+func (v *validator) sendDutyEvent(duty validatorpb.DutyEvent_Duty, slot types.Slot, pubKey [fieldparams.BLSPubkeyLength]byte, err error) {
+	if v.dutyEventFeed == nil {
+		return
+	}
+	event := &validatorpb.DutyEvent{
+		Duty:         duty,
+		Slot:         uint64(slot),
+		PublicKey:    bytesutil.SafeCopyBytes(pubKey[:]),
+		Missed:       err != nil,
+		SlotOffsetMs: prysmTime.Since(slots.StartTime(v.genesisTime, slot)).Milliseconds(),
+	}
+	if err != nil {
+		event.Error = err.Error()
+	}
+	v.dutyEventsOnce.Do(func() {
+		v.dutyEventQueue = make(chan *validatorpb.DutyEvent, dutyEventQueueSize)
+		go v.dispatchDutyEvents()
+	})
+	select {
+	case v.dutyEventQueue <- event:
+	default:
+		DutyEventsDroppedTotal.Inc()
+	}
+}
+
+// dispatchDutyEvents sends the queued duty events to the subscribers of the duty event feed in order.
+func (v *validator) dispatchDutyEvents() {
+	for event := range v.dutyEventQueue {
+		v.dutyEventFeed.Send(event)
+	}
+}
@@ -0,0 +1,110 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v3/async/event"
+	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v3/config/params"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	validatorpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1/validator-client"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+)
+
+func TestSendDutyEvent(t *testing.T) {
+	// The current time is two seconds into slot 10.
+	secondsPerSlot := params.BeaconConfig().SecondsPerSlot
+	genesis := uint64(time.Now().Unix()) - 10*secondsPerSlot - 2
+	v := &validator{genesisTime: genesis, dutyEventFeed: new(event.Feed)}
+	ch := make(chan *validatorpb.DutyEvent, 2)
+	sub := v.dutyEventFeed.Subscribe(ch)
+	defer sub.Unsubscribe()
+	pubKey := [fieldparams.BLSPubkeyLength]byte{1, 2, 3}
+
+	v.sendDutyEvent(validatorpb.DutyEvent_PROPOSAL, 10, pubKey, nil)
+	ev := <-ch
+	assert.Equal(t, validatorpb.DutyEvent_PROPOSAL, ev.Duty)
+	assert.Equal(t, uint64(10), ev.Slot)
+	assert.DeepEqual(t, pubKey[:], ev.PublicKey)
+	assert.Equal(t, false, ev.Missed)
+	assert.Equal(t, "", ev.Error)
+	assert.Equal(t, true, ev.SlotOffsetMs >= 2000 && ev.SlotOffsetMs < 4000, "unexpected slot offset %d", ev.SlotOffsetMs)
+
+	v.sendDutyEvent(validatorpb.DutyEvent_ATTESTATION, 10, pubKey, errors.New("bad"))
+	ev = <-ch
+	assert.Equal(t, true, ev.Missed)
+	assert.Equal(t, "bad", ev.Error)
+}
+
+func TestSendDutyEvent_NoFeed(t *testing.T) {
+	v := &validator{}
+	v.sendDutyEvent(validatorpb.DutyEvent_PROPOSAL, 10, [fieldparams.BLSPubkeyLength]byte{}, nil)
+}
+
+func TestSendDutyEvent_SlowSubscriber(t *testing.T) {
+	v := &validator{dutyEventFeed: new(event.Feed)}
+	// The subscriber never reads from its channel.
+	sub := v.dutyEventFeed.Subscribe(make(chan *validatorpb.DutyEvent))
+	defer sub.Unsubscribe()
+
+	done := make(chan struct{})
+	go func() {
+		for i := 0; i < 2*dutyEventQueueSize; i++ {
+			v.sendDutyEvent(validatorpb.DutyEvent_ATTESTATION, 10, [fieldparams.BLSPubkeyLength]byte{}, nil)
+		}
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Sending duty events blocked on a slow subscriber")
+	}
+}
+
+func TestSubmitAttestation_SlowDutyEventSubscriber(t *testing.T) {
+	validator, _, validatorKey, finish := setup(t)
+	defer finish()
+	validator.duties = &ethpb.DutiesResponse{Duties: []*ethpb.DutiesResponse_Duty{}}
+	validator.dutyEventFeed = new(event.Feed)
+	sub := validator.dutyEventFeed.Subscribe(make(chan *validatorpb.DutyEvent))
+	defer sub.Unsubscribe()
+
+	pubKey := [fieldparams.BLSPubkeyLength]byte{}
+	copy(pubKey[:], validatorKey.PublicKey().Marshal())
+	done := make(chan struct{})
+	go func() {
+		for i := 0; i < dutyEventQueueSize+1; i++ {
+			validator.SubmitAttestation(context.Background(), 30, pubKey)
+		}
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("Attestation duty blocked on a slow duty event subscriber")
+	}
+}
+
+func TestSubmitAttestation_SendsMissedDutyEvent(t *testing.T) {
+	validator, _, validatorKey, finish := setup(t)
+	defer finish()
+	validator.duties = &ethpb.DutiesResponse{Duties: []*ethpb.DutiesResponse_Duty{}}
+	validator.dutyEventFeed = new(event.Feed)
+	ch := make(chan *validatorpb.DutyEvent, 1)
+	sub := validator.dutyEventFeed.Subscribe(ch)
+	defer sub.Unsubscribe()
+
+	pubKey := [fieldparams.BLSPubkeyLength]byte{}
+	copy(pubKey[:], validatorKey.PublicKey().Marshal())
+	validator.SubmitAttestation(context.Background(), 30, pubKey)
+	ev := <-ch
+	assert.Equal(t, validatorpb.DutyEvent_ATTESTATION, ev.Duty)
+	assert.Equal(t, uint64(30), ev.Slot)
+	assert.DeepEqual(t, pubKey[:], ev.PublicKey)
+	assert.Equal(t, true, ev.Missed)
+	assert.Equal(t, true, strings.Contains(ev.Error, "not in duties"), "unexpected error %s", ev.Error)
+}
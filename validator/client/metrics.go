@@ -201,6 +201,60 @@ var (
 			"pubkey",
 		},
 	)
+	// BeaconNodeSwitchesTotal used to count switches of the primary beacon node.
+	BeaconNodeSwitchesTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "validator",
+			Name:      "beacon_node_switches_total",
+			Help:      "Number of times the primary beacon node was switched, by the endpoint switched to",
+		},
+		[]string{
+			"endpoint",
+		},
+	)
+	// BeaconNodeHealthyGaugeVec used to track the health of the beacon nodes.
+	BeaconNodeHealthyGaugeVec = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "validator",
+			Name:      "beacon_node_healthy",
+			Help:      "Whether the beacon node is synced, not optimistic, connected to its execution client and has peers",
+		},
+		[]string{
+			"endpoint",
+		},
+	)
+	// BeaconNodePrimaryGaugeVec used to track which beacon node is the primary.
+	BeaconNodePrimaryGaugeVec = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "validator",
+			Name:      "beacon_node_primary",
+			Help:      "1 for the beacon node that requests are currently sent to, 0 otherwise",
+		},
+		[]string{
+			"endpoint",
+		},
+	)
+	// BeaconNodeBroadcastsTotal used to count signed messages broadcast to the beacon nodes.
+	BeaconNodeBroadcastsTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "validator",
+			Name:      "beacon_node_broadcasts_total",
+			Help:      "Number of signed messages submitted to each beacon node in broadcast mode, by method and outcome",
+		},
+		[]string{
+			"endpoint",
+			"method",
+			"outcome",
+		},
+	)
+	// DutyEventsDroppedTotal used to count duty events dropped because their subscribers were too slow.
+	DutyEventsDroppedTotal = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "validator",
+			Name:      "duty_events_dropped_total",
+			Help:      "Number of duty events not sent to the subscribers of the duty event stream because they were too slow",
+		},
+	)
 )
 
 // LogValidatorGainsAndLosses logs important metrics related to this validator client's
@@ -4,6 +4,7 @@ package client
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/pkg/errors"
@@ -23,12 +24,21 @@ import (
 	prysmTime "github.com/prysmaticlabs/prysm/v3/time"
 	"github.com/prysmaticlabs/prysm/v3/time/slots"
 	"github.com/prysmaticlabs/prysm/v3/validator/client/iface"
+	"github.com/prysmaticlabs/prysm/v3/validator/graffiti"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 const domainDataErr = "could not get domain data"
+
+const (
+	// nodeVersionRefreshPeriod is how long the version of the beacon node used in graffiti
+	// templates is cached for.
+	nodeVersionRefreshPeriod = 10 * time.Minute
+	// nodeVersionRefreshTimeout bounds a background request for the version of the beacon node.
+	nodeVersionRefreshTimeout = 10 * time.Second
+)
 const signingRootErr = "could not get signing root"
 const signExitErr = "could not sign voluntary exit proposal"
 
@@ -53,6 +63,11 @@ func (v *validator) ProposeBlock(ctx context.Context, slot types.Slot, pubKey [f
 	span.AddAttributes(trace.StringAttribute("validator", fmtKey))
 	log := log.WithField("pubKey", fmt.Sprintf("%#x", bytesutil.Trunc(pubKey[:])))
 
+	v.waitInjectedDutyDelay(ctx, iface.RoleProposer, slot, pubKey)
+	if delay := v.proposalDelay(pubKey); delay > 0 {
+		waitUntil(ctx, slots.StartTime(v.genesisTime, slot).Add(delay))
+	}
+
 	// Sign randao reveal, it's used to request block from beacon node
 	epoch := types.Epoch(slot / params.BeaconConfig().SlotsPerEpoch)
 	randaoReveal, err := v.signRandaoReveal(ctx, pubKey, epoch, slot)
@@ -61,10 +76,11 @@ func (v *validator) ProposeBlock(ctx context.Context, slot types.Slot, pubKey [f
 		if v.emitAccountMetrics {
 			ValidatorProposeFailVec.WithLabelValues(fmtKey).Inc()
 		}
+		v.sendDutyEvent(validatorpb.DutyEvent_PROPOSAL, slot, pubKey, err)
 		return
 	}
 
-	g, err := v.getGraffiti(ctx, pubKey)
+	g, err := v.getGraffiti(ctx, pubKey, slot)
 	if err != nil {
 		// Graffiti is not a critical enough to fail block production and cause
 		// validator to miss block reward. When failed, validator should continue
@@ -83,6 +99,7 @@ func (v *validator) ProposeBlock(ctx context.Context, slot types.Slot, pubKey [f
 		if v.emitAccountMetrics {
 			ValidatorProposeFailVec.WithLabelValues(fmtKey).Inc()
 		}
+		v.sendDutyEvent(validatorpb.DutyEvent_PROPOSAL, slot, pubKey, err)
 		return
 	}
 
@@ -93,6 +110,7 @@ func (v *validator) ProposeBlock(ctx context.Context, slot types.Slot, pubKey [f
 		if v.emitAccountMetrics {
 			ValidatorProposeFailVec.WithLabelValues(fmtKey).Inc()
 		}
+		v.sendDutyEvent(validatorpb.DutyEvent_PROPOSAL, slot, pubKey, err)
 		return
 	}
 
@@ -102,12 +120,14 @@ func (v *validator) ProposeBlock(ctx context.Context, slot types.Slot, pubKey [f
 		if v.emitAccountMetrics {
 			ValidatorProposeFailVec.WithLabelValues(fmtKey).Inc()
 		}
+		v.sendDutyEvent(validatorpb.DutyEvent_PROPOSAL, slot, pubKey, err)
 		return
 	}
 
 	blk, err := blocks.BuildSignedBeaconBlock(wb, sig)
 	if err != nil {
 		log.WithError(err).Error("Failed to build signed beacon block")
+		v.sendDutyEvent(validatorpb.DutyEvent_PROPOSAL, slot, pubKey, err)
 		return
 	}
 
@@ -118,6 +138,7 @@ func (v *validator) ProposeBlock(ctx context.Context, slot types.Slot, pubKey [f
 		if v.emitAccountMetrics {
 			ValidatorProposeFailVec.WithLabelValues(fmtKey).Inc()
 		}
+		v.sendDutyEvent(validatorpb.DutyEvent_PROPOSAL, slot, pubKey, err)
 		return
 	}
 
@@ -128,6 +149,7 @@ func (v *validator) ProposeBlock(ctx context.Context, slot types.Slot, pubKey [f
 		if v.emitAccountMetrics {
 			ValidatorProposeFailVec.WithLabelValues(fmtKey).Inc()
 		}
+		v.sendDutyEvent(validatorpb.DutyEvent_PROPOSAL, slot, pubKey, err)
 		return
 	}
 	blkResp, err := v.validatorClient.ProposeBeaconBlock(ctx, proposal)
@@ -136,8 +158,10 @@ func (v *validator) ProposeBlock(ctx context.Context, slot types.Slot, pubKey [f
 		if v.emitAccountMetrics {
 			ValidatorProposeFailVec.WithLabelValues(fmtKey).Inc()
 		}
+		v.sendDutyEvent(validatorpb.DutyEvent_PROPOSAL, slot, pubKey, err)
 		return
 	}
+	v.sendDutyEvent(validatorpb.DutyEvent_PROPOSAL, slot, pubKey, nil)
 
 	span.AddAttributes(
 		trace.StringAttribute("blockRoot", fmt.Sprintf("%#x", blkResp.BlockRoot)),
@@ -197,24 +221,10 @@ func ProposeExit(
 	ctx, span := trace.StartSpan(ctx, "validator.ProposeExit")
 	defer span.End()
 
-	indexResponse, err := validatorClient.ValidatorIndex(ctx, &ethpb.ValidatorIndexRequest{PublicKey: pubKey})
-	if err != nil {
-		return errors.Wrap(err, "gRPC call to get validator index failed")
-	}
-	genesisResponse, err := nodeClient.GetGenesis(ctx, &emptypb.Empty{})
-	if err != nil {
-		return errors.Wrap(err, "gRPC call to get genesis time failed")
-	}
-	totalSecondsPassed := prysmTime.Now().Unix() - genesisResponse.GenesisTime.Seconds
-	currentEpoch := types.Epoch(uint64(totalSecondsPassed) / uint64(params.BeaconConfig().SlotsPerEpoch.Mul(params.BeaconConfig().SecondsPerSlot)))
-	currentSlot := slots.CurrentSlot(uint64(genesisResponse.GenesisTime.AsTime().Unix()))
-	exit := &ethpb.VoluntaryExit{Epoch: currentEpoch, ValidatorIndex: indexResponse.Index}
-	sig, err := signVoluntaryExit(ctx, validatorClient, signer, pubKey, exit, currentSlot)
+	signedExit, err := CreateSignedVoluntaryExit(ctx, validatorClient, nodeClient, signer, pubKey, 0)
 	if err != nil {
-		return errors.Wrap(err, "failed to sign voluntary exit")
+		return err
 	}
-
-	signedExit := &ethpb.SignedVoluntaryExit{Exit: exit, Signature: sig}
 	exitResp, err := validatorClient.ProposeExit(ctx, signedExit)
 	if err != nil {
 		return errors.Wrap(err, "failed to propose voluntary exit")
@@ -227,6 +237,44 @@ func ProposeExit(
 	return nil
 }
 
+// CreateSignedVoluntaryExit creates a voluntary exit of a validator at the given epoch, or at the current
+// epoch if it is zero, and signs it with the signer. The exit is signed with the domain of the fork at
+// its epoch, which remote signers derive from the signing slot.
+func CreateSignedVoluntaryExit(
+	ctx context.Context,
+	validatorClient ethpb.BeaconNodeValidatorClient,
+	nodeClient ethpb.NodeClient,
+	signer iface.SigningFunc,
+	pubKey []byte,
+	epoch types.Epoch,
+) (*ethpb.SignedVoluntaryExit, error) {
+	ctx, span := trace.StartSpan(ctx, "validator.CreateSignedVoluntaryExit")
+	defer span.End()
+
+	indexResponse, err := validatorClient.ValidatorIndex(ctx, &ethpb.ValidatorIndexRequest{PublicKey: pubKey})
+	if err != nil {
+		return nil, errors.Wrap(err, "gRPC call to get validator index failed")
+	}
+	genesisResponse, err := nodeClient.GetGenesis(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, errors.Wrap(err, "gRPC call to get genesis time failed")
+	}
+	if epoch == 0 {
+		totalSecondsPassed := prysmTime.Now().Unix() - genesisResponse.GenesisTime.Seconds
+		epoch = types.Epoch(uint64(totalSecondsPassed) / uint64(params.BeaconConfig().SlotsPerEpoch.Mul(params.BeaconConfig().SecondsPerSlot)))
+	}
+	exitSlot, err := slots.EpochStart(epoch)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get start slot of exit epoch")
+	}
+	exit := &ethpb.VoluntaryExit{Epoch: epoch, ValidatorIndex: indexResponse.Index}
+	sig, err := signVoluntaryExit(ctx, validatorClient, signer, pubKey, exit, exitSlot)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to sign voluntary exit")
+	}
+	return &ethpb.SignedVoluntaryExit{Exit: exit, Signature: sig}, nil
+}
+
 // Sign randao reveal with randao domain and private key.
 func (v *validator) signRandaoReveal(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, epoch types.Epoch, slot types.Slot) ([]byte, error) {
 	domain, err := v.domainData(ctx, epoch, params.BeaconConfig().DomainRandao[:])
@@ -328,9 +376,49 @@ func signVoluntaryExit(
 	return sig.Marshal(), nil
 }
 
-// Gets the graffiti from cli or file for the validator public key.
-func (v *validator) getGraffiti(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) ([]byte, error) {
-	// When specified, default graffiti from the command line takes the first priority.
+// Gets the graffiti for a proposal of the validator public key. Graffiti templates are rendered
+// with the details of the proposal.
+func (v *validator) getGraffiti(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, slot types.Slot) ([]byte, error) {
+	idx := &proposerIndex{client: v.validatorClient, pubKey: pubKey}
+	g, err := v.graffitiForPubKey(ctx, idx)
+	if err != nil || !graffiti.IsTemplate(string(g)) {
+		return g, err
+	}
+	fields, err := v.graffitiTemplateFields(ctx, idx, slot)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get graffiti template fields")
+	}
+	rendered, err := graffiti.ExecuteTemplate(string(g), fields)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(rendered), nil
+}
+
+// Gets the graffiti from the proposer settings, cli or file for the validator public key.
+func (v *validator) graffitiForPubKey(ctx context.Context, idx *proposerIndex) ([]byte, error) {
+	// When specified, the graffiti of the validator in the proposer settings takes the first priority,
+	// followed by the graffiti of the validator in the graffiti file and then by the default graffiti
+	// of the proposer settings. They all override the command line graffiti.
+	if settings := v.ProposerSettings(); settings != nil {
+		if option, ok := settings.ProposeConfig[idx.pubKey]; ok && option.Graffiti != "" {
+			return []byte(option.Graffiti), nil
+		}
+		if settings.DefaultConfig != nil && settings.DefaultConfig.Graffiti != "" {
+			if v.graffitiStruct != nil && len(v.graffitiStruct.Specific) != 0 {
+				index, err := idx.get(ctx)
+				if err != nil {
+					return []byte{}, err
+				}
+				if g, ok := v.graffitiStruct.Specific[index]; ok {
+					return []byte(g), nil
+				}
+			}
+			return []byte(settings.DefaultConfig.Graffiti), nil
+		}
+	}
+
+	// When specified, default graffiti from the command line takes the next priority.
 	if len(v.graffiti) != 0 {
 		return v.graffiti, nil
 	}
@@ -339,17 +427,17 @@ func (v *validator) getGraffiti(ctx context.Context, pubKey [fieldparams.BLSPubk
 		return nil, errors.New("graffitiStruct can't be nil")
 	}
 
-	// When specified, individual validator specified graffiti takes the second priority.
-	idx, err := v.validatorClient.ValidatorIndex(ctx, &ethpb.ValidatorIndexRequest{PublicKey: pubKey[:]})
+	// When specified, individual validator specified graffiti takes the next priority.
+	index, err := idx.get(ctx)
 	if err != nil {
 		return []byte{}, err
 	}
-	g, ok := v.graffitiStruct.Specific[idx.Index]
+	g, ok := v.graffitiStruct.Specific[index]
 	if ok {
 		return []byte(g), nil
 	}
 
-	// When specified, a graffiti from the ordered list in the file take third priority.
+	// When specified, a graffiti from the ordered list in the file take the next priority.
 	if v.graffitiOrderedIndex < uint64(len(v.graffitiStruct.Ordered)) {
 		graffiti := v.graffitiStruct.Ordered[v.graffitiOrderedIndex]
 		v.graffitiOrderedIndex = v.graffitiOrderedIndex + 1
@@ -360,7 +448,7 @@ func (v *validator) getGraffiti(ctx context.Context, pubKey [fieldparams.BLSPubk
 		return []byte(graffiti), nil
 	}
 
-	// When specified, a graffiti from the random list in the file take fourth priority.
+	// When specified, a graffiti from the random list in the file take the next priority.
 	if len(v.graffitiStruct.Random) != 0 {
 		r := rand.NewGenerator()
 		r.Seed(time.Now().Unix())
@@ -375,3 +463,116 @@ func (v *validator) getGraffiti(ctx context.Context, pubKey [fieldparams.BLSPubk
 
 	return []byte{}, nil
 }
+
+// Gets the fields of a proposal which can be used in graffiti templates. The client names and
+// versions are left empty if the version of the beacon node is not known yet.
+func (v *validator) graffitiTemplateFields(
+	ctx context.Context, idx *proposerIndex, slot types.Slot,
+) (*graffiti.TemplateFields, error) {
+	index, err := idx.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	fields := &graffiti.TemplateFields{
+		Index:  index,
+		PubKey: fmt.Sprintf("%#x", idx.pubKey[:4]),
+		Slot:   slot,
+		Epoch:  slots.ToEpoch(slot),
+	}
+	nodeVersion := v.cachedNodeVersion()
+	if nodeVersion == nil {
+		return fields, nil
+	}
+	fields.CL, fields.CLVersion = graffiti.ParseClientVersion(nodeVersion.Version)
+	if nodeVersion.Metadata != "" {
+		fields.EL, fields.ELVersion = graffiti.ParseClientVersion(nodeVersion.Metadata)
+	}
+	return fields, nil
+}
+
+// proposerIndex looks up the index of a proposing validator at most once per proposal.
+type proposerIndex struct {
+	client  ethpb.BeaconNodeValidatorClient
+	pubKey  [fieldparams.BLSPubkeyLength]byte
+	index   types.ValidatorIndex
+	fetched bool
+}
+
+func (p *proposerIndex) get(ctx context.Context) (types.ValidatorIndex, error) {
+	if p.fetched {
+		return p.index, nil
+	}
+	resp, err := p.client.ValidatorIndex(ctx, &ethpb.ValidatorIndexRequest{PublicKey: p.pubKey[:]})
+	if err != nil {
+		return 0, err
+	}
+	p.index, p.fetched = resp.Index, true
+	return p.index, nil
+}
+
+// nodeVersionCache holds the version of the beacon node used in graffiti templates, so that
+// block proposals do not wait on a request to the beacon node.
+type nodeVersionCache struct {
+	sync.Mutex
+	version    *ethpb.Version
+	updated    time.Time
+	refreshing bool
+}
+
+// cachedNodeVersion returns the last known version of the beacon node, or nil if it is not
+// known yet. A stale version is refreshed in the background.
+func (v *validator) cachedNodeVersion() *ethpb.Version {
+	v.refreshNodeVersion()
+	v.nodeVersion.Lock()
+	defer v.nodeVersion.Unlock()
+	return v.nodeVersion.version
+}
+
+// refreshNodeVersion requests the version of the beacon node in the background if the cached
+// version is older than nodeVersionRefreshPeriod.
+func (v *validator) refreshNodeVersion() {
+	if v.node == nil {
+		return
+	}
+	v.nodeVersion.Lock()
+	defer v.nodeVersion.Unlock()
+	if v.nodeVersion.refreshing || (v.nodeVersion.version != nil && prysmTime.Since(v.nodeVersion.updated) < nodeVersionRefreshPeriod) {
+		return
+	}
+	v.nodeVersion.refreshing = true
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), nodeVersionRefreshTimeout)
+		defer cancel()
+		nodeVersion, err := v.node.GetVersion(ctx, &emptypb.Empty{})
+		if err != nil {
+			log.WithError(err).Debug("Could not get beacon node version for graffiti")
+		}
+		v.nodeVersion.Lock()
+		defer v.nodeVersion.Unlock()
+		v.nodeVersion.refreshing = false
+		if err == nil {
+			v.nodeVersion.version = nodeVersion
+			v.nodeVersion.updated = prysmTime.Now()
+		}
+	}()
+}
+
+// usesGraffitiTemplate returns true if the graffiti of any validator is a template.
+func (v *validator) usesGraffitiTemplate() bool {
+	if graffiti.IsTemplate(string(v.graffiti)) {
+		return true
+	}
+	settings := v.ProposerSettings()
+	if settings == nil {
+		return false
+	}
+	if settings.DefaultConfig != nil && graffiti.IsTemplate(settings.DefaultConfig.Graffiti) {
+		return true
+	}
+	for _, option := range settings.ProposeConfig {
+		if option != nil && graffiti.IsTemplate(option.Graffiti) {
+			return true
+		}
+	}
+	return false
+}
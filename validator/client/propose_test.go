@@ -13,6 +13,7 @@ import (
 	lruwrpr "github.com/prysmaticlabs/prysm/v3/cache/lru"
 	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v3/config/params"
+	validatorserviceconfig "github.com/prysmaticlabs/prysm/v3/config/validator/service"
 	"github.com/prysmaticlabs/prysm/v3/consensus-types/blocks"
 	blocktest "github.com/prysmaticlabs/prysm/v3/consensus-types/blocks/testing"
 	"github.com/prysmaticlabs/prysm/v3/consensus-types/interfaces"
@@ -25,10 +26,12 @@ import (
 	"github.com/prysmaticlabs/prysm/v3/testing/mock"
 	"github.com/prysmaticlabs/prysm/v3/testing/require"
 	"github.com/prysmaticlabs/prysm/v3/testing/util"
+	"github.com/prysmaticlabs/prysm/v3/time/slots"
 	testing2 "github.com/prysmaticlabs/prysm/v3/validator/db/testing"
 	"github.com/prysmaticlabs/prysm/v3/validator/graffiti"
 	logTest "github.com/sirupsen/logrus/hooks/test"
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -796,6 +799,44 @@ func TestProposeExit_BroadcastsBlock(t *testing.T) {
 	))
 }
 
+func TestCreateSignedVoluntaryExit(t *testing.T) {
+	_, m, validatorKey, finish := setup(t)
+	defer finish()
+
+	m.validatorClient.EXPECT().
+		ValidatorIndex(gomock.Any(), gomock.Any()).
+		Return(&ethpb.ValidatorIndexResponse{Index: 1}, nil)
+	m.nodeClient.EXPECT().
+		GetGenesis(gomock.Any(), gomock.Any()).
+		Return(&ethpb.Genesis{GenesisTime: timestamppb.Now()}, nil)
+	exitDomain := bytesutil.PadTo([]byte("exit domain"), 32)
+	m.validatorClient.EXPECT().
+		DomainData(gomock.Any(), &ethpb.DomainRequest{Epoch: 5, Domain: params.BeaconConfig().DomainVoluntaryExit[:]}).
+		Return(&ethpb.DomainResponse{SignatureDomain: exitDomain}, nil)
+
+	// The exit is signed with the domain at the exit epoch, also by remote signers deriving it from the slot.
+	var signReq *validatorpb.SignRequest
+	signedExit, err := CreateSignedVoluntaryExit(
+		context.Background(),
+		m.validatorClient,
+		m.nodeClient,
+		func(ctx context.Context, req *validatorpb.SignRequest) (bls.Signature, error) {
+			signReq = req
+			return m.signfunc(ctx, req)
+		},
+		validatorKey.PublicKey().Marshal(),
+		5,
+	)
+	require.NoError(t, err)
+	exitSlot, err := slots.EpochStart(5)
+	require.NoError(t, err)
+	assert.Equal(t, exitSlot, signReq.SigningSlot)
+	assert.DeepEqual(t, exitDomain, signReq.SignatureDomain)
+	assert.Equal(t, types.Epoch(5), signedExit.Exit.Epoch)
+	assert.Equal(t, types.ValidatorIndex(1), signedExit.Exit.ValidatorIndex)
+	assert.DeepEqual(t, mockSignature{}.Marshal(), signedExit.Signature)
+}
+
 func TestSignBlock(t *testing.T) {
 	validator, m, _, finish := setup(t)
 	defer finish()
@@ -983,13 +1024,154 @@ func TestGetGraffiti_Ok(t *testing.T) {
 					ValidatorIndex(gomock.Any(), &ethpb.ValidatorIndexRequest{PublicKey: pubKey[:]}).
 					Return(&ethpb.ValidatorIndexResponse{Index: 2}, nil)
 			}
-			got, err := tt.v.getGraffiti(context.Background(), pubKey)
+			got, err := tt.v.getGraffiti(context.Background(), pubKey, 0)
 			require.NoError(t, err)
 			require.DeepEqual(t, tt.want, got)
 		})
 	}
 }
 
+func TestGetGraffiti_ProposerSettingsTemplate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	m := &mocks{
+		validatorClient: mock.NewMockBeaconNodeValidatorClient(ctrl),
+		nodeClient:      mock.NewMockNodeClient(ctrl),
+	}
+	pubKey := [fieldparams.BLSPubkeyLength]byte{0xaf, 0x2e, 0x7b, 0xa2}
+	otherPubKey := [fieldparams.BLSPubkeyLength]byte{'b'}
+	v := &validator{
+		validatorClient: m.validatorClient,
+		node:            m.nodeClient,
+		graffiti:        []byte("cli graffiti"),
+		proposerSettings: &validatorserviceconfig.ProposerSettings{
+			ProposeConfig: map[[fieldparams.BLSPubkeyLength]byte]*validatorserviceconfig.ProposerOption{
+				pubKey: {Graffiti: "{{.CL}}-{{.EL}} #{{.Index}} {{.PubKey}} e{{.Epoch}}"},
+			},
+			DefaultConfig: &validatorserviceconfig.ProposerOption{Graffiti: "default graffiti"},
+		},
+	}
+	v.nodeVersion.version = &ethpb.Version{
+		Version:  "Prysm/v3.1.2/3b6b5d7",
+		Metadata: "Geth/v1.10.26-stable/linux-amd64/go1.18.5",
+	}
+	v.nodeVersion.updated = time.Now()
+	m.validatorClient.EXPECT().
+		ValidatorIndex(gomock.Any(), &ethpb.ValidatorIndexRequest{PublicKey: pubKey[:]}).
+		Return(&ethpb.ValidatorIndexResponse{Index: 2}, nil)
+
+	got, err := v.getGraffiti(context.Background(), pubKey, params.BeaconConfig().SlotsPerEpoch*3)
+	require.NoError(t, err)
+	require.DeepEqual(t, []byte("Prysm-Geth #2 0xaf2e7ba2 e3"), got)
+
+	got, err = v.getGraffiti(context.Background(), otherPubKey, 0)
+	require.NoError(t, err)
+	require.DeepEqual(t, []byte("default graffiti"), got)
+}
+
+func TestGetGraffiti_FileSpecificOverridesProposerSettingsDefault(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	m := &mocks{
+		validatorClient: mock.NewMockBeaconNodeValidatorClient(ctrl),
+	}
+	pubKey := [fieldparams.BLSPubkeyLength]byte{'a'}
+	otherPubKey := [fieldparams.BLSPubkeyLength]byte{'b'}
+	configuredPubKey := [fieldparams.BLSPubkeyLength]byte{'c'}
+	v := &validator{
+		validatorClient: m.validatorClient,
+		graffiti:        []byte("cli graffiti"),
+		graffitiStruct: &graffiti.Graffiti{
+			Default: "file default",
+			Specific: map[types.ValidatorIndex]string{
+				2: "file specific",
+				4: "file specific of configured",
+			},
+		},
+		proposerSettings: &validatorserviceconfig.ProposerSettings{
+			ProposeConfig: map[[fieldparams.BLSPubkeyLength]byte]*validatorserviceconfig.ProposerOption{
+				configuredPubKey: {Graffiti: "settings specific"},
+			},
+			DefaultConfig: &validatorserviceconfig.ProposerOption{Graffiti: "settings default"},
+		},
+	}
+	m.validatorClient.EXPECT().
+		ValidatorIndex(gomock.Any(), &ethpb.ValidatorIndexRequest{PublicKey: pubKey[:]}).
+		Return(&ethpb.ValidatorIndexResponse{Index: 2}, nil)
+	m.validatorClient.EXPECT().
+		ValidatorIndex(gomock.Any(), &ethpb.ValidatorIndexRequest{PublicKey: otherPubKey[:]}).
+		Return(&ethpb.ValidatorIndexResponse{Index: 3}, nil)
+
+	got, err := v.getGraffiti(context.Background(), pubKey, 0)
+	require.NoError(t, err)
+	require.DeepEqual(t, []byte("file specific"), got)
+
+	got, err = v.getGraffiti(context.Background(), otherPubKey, 0)
+	require.NoError(t, err)
+	require.DeepEqual(t, []byte("settings default"), got)
+
+	got, err = v.getGraffiti(context.Background(), configuredPubKey, 0)
+	require.NoError(t, err)
+	require.DeepEqual(t, []byte("settings specific"), got)
+}
+
+func TestGetGraffiti_TemplateRefreshesNodeVersionInBackground(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	m := &mocks{
+		validatorClient: mock.NewMockBeaconNodeValidatorClient(ctrl),
+		nodeClient:      mock.NewMockNodeClient(ctrl),
+	}
+	pubKey := [fieldparams.BLSPubkeyLength]byte{0xaf, 0x2e, 0x7b, 0xa2}
+	v := &validator{
+		validatorClient: m.validatorClient,
+		node:            m.nodeClient,
+		graffiti:        []byte("{{.CL}} #{{.Index}}"),
+	}
+	m.validatorClient.EXPECT().
+		ValidatorIndex(gomock.Any(), &ethpb.ValidatorIndexRequest{PublicKey: pubKey[:]}).
+		Times(2).
+		Return(&ethpb.ValidatorIndexResponse{Index: 2}, nil)
+	release := make(chan struct{})
+	m.nodeClient.EXPECT().GetVersion(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ *emptypb.Empty, _ ...grpc.CallOption) (*ethpb.Version, error) {
+			<-release
+			return &ethpb.Version{Version: "Prysm/v3.1.2/3b6b5d7"}, nil
+		})
+
+	// The proposal does not wait for the version of the beacon node.
+	got, err := v.getGraffiti(context.Background(), pubKey, 0)
+	require.NoError(t, err)
+	require.DeepEqual(t, []byte(" #2"), got)
+
+	close(release)
+	for i := 0; i < 100 && v.cachedNodeVersion() == nil; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	got, err = v.getGraffiti(context.Background(), pubKey, 0)
+	require.NoError(t, err)
+	require.DeepEqual(t, []byte("Prysm #2"), got)
+}
+
+func TestGetGraffiti_TemplateFetchesIndexOnce(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	m := &mocks{
+		validatorClient: mock.NewMockBeaconNodeValidatorClient(ctrl),
+	}
+	pubKey := [fieldparams.BLSPubkeyLength]byte{'a'}
+	v := &validator{
+		validatorClient: m.validatorClient,
+		graffitiStruct: &graffiti.Graffiti{
+			Specific: map[types.ValidatorIndex]string{2: "validator {{.Index}}"},
+		},
+	}
+	m.validatorClient.EXPECT().
+		ValidatorIndex(gomock.Any(), &ethpb.ValidatorIndexRequest{PublicKey: pubKey[:]}).
+		Times(1).
+		Return(&ethpb.ValidatorIndexResponse{Index: 2}, nil)
+
+	got, err := v.getGraffiti(context.Background(), pubKey, 0)
+	require.NoError(t, err)
+	require.DeepEqual(t, []byte("validator 2"), got)
+}
+
 func TestGetGraffitiOrdered_Ok(t *testing.T) {
 	pubKey := [fieldparams.BLSPubkeyLength]byte{'a'}
 	valDB := testing2.SetupDB(t, [][fieldparams.BLSPubkeyLength]byte{pubKey})
@@ -1011,7 +1193,7 @@ func TestGetGraffitiOrdered_Ok(t *testing.T) {
 		},
 	}
 	for _, want := range [][]byte{{'a'}, {'b'}, {'c'}, {'d'}, {'d'}} {
-		got, err := v.getGraffiti(context.Background(), pubKey)
+		got, err := v.getGraffiti(context.Background(), pubKey, 0)
 		require.NoError(t, err)
 		require.DeepEqual(t, want, got)
 	}
@@ -71,6 +71,15 @@ func signValidatorRegistration(ctx context.Context, signer iface.SigningFunc, re
 	return sig.Marshal(), nil
 }
 
+// SignValidatorRegistration signs a validator registration with the builder domain.
+func SignValidatorRegistration(ctx context.Context, signer iface.SigningFunc, reg *ethpb.ValidatorRegistrationV1) (*ethpb.SignedValidatorRegistrationV1, error) {
+	sig, err := signValidatorRegistration(ctx, signer, reg)
+	if err != nil {
+		return nil, err
+	}
+	return &ethpb.SignedValidatorRegistrationV1{Message: reg, Signature: sig}, nil
+}
+
 // SignValidatorRegistrationRequest compares and returns either the cached validator registration request or signs a new one.
 func (v *validator) SignValidatorRegistrationRequest(ctx context.Context, signer iface.SigningFunc, newValidatorRegistration *ethpb.ValidatorRegistrationV1) (*ethpb.SignedValidatorRegistrationV1, error) {
 	signedReg, ok := v.signedValidatorRegistrations[bytesutil.ToBytes48(newValidatorRegistration.Pubkey)]
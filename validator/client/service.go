@@ -20,6 +20,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v3/consensus-types/interfaces"
 	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
 	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	validatorpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1/validator-client"
 	"github.com/prysmaticlabs/prysm/v3/validator/accounts/wallet"
 	"github.com/prysmaticlabs/prysm/v3/validator/client/iface"
 	"github.com/prysmaticlabs/prysm/v3/validator/db"
@@ -52,12 +53,14 @@ type ValidatorService struct {
 	emitAccountMetrics    bool
 	logValidatorBalances  bool
 	interopKeysConfig     *local.InteropKeymanagerConfig
-	conn                  *grpc.ClientConn
+	conn                  *beaconNodeConn
+	broadcast             bool
 	grpcRetryDelay        time.Duration
 	grpcRetries           uint
 	maxCallRecvMsgSize    int
 	cancel                context.CancelFunc
 	walletInitializedFeed *event.Feed
+	dutyEventFeed         *event.Feed
 	wallet                *wallet.Wallet
 	graffitiStruct        *graffiti.Graffiti
 	dataDir               string
@@ -70,6 +73,17 @@ type ValidatorService struct {
 	graffiti              []byte
 	Web3SignerConfig      *remoteweb3signer.SetupConfig
 	proposerSettings      *validatorserviceconfig.ProposerSettings
+	proposerSettingsStore ProposerSettingsStore
+	maxBlockWait          time.Duration
+	aggregationSpread     time.Duration
+	dutyDelayHook         DutyDelayHook
+}
+
+// ProposerSettingsStore persists the proposer settings changed through the keymanager API, and
+// reports the proposer settings reloaded from the proposer settings file or URL.
+type ProposerSettingsStore interface {
+	Save(settings *validatorserviceconfig.ProposerSettings) error
+	SubscribeUpdates(ch chan<- *validatorserviceconfig.ProposerSettings) event.Subscription
 }
 
 // Config for the validator service.
@@ -91,8 +105,13 @@ type Config struct {
 	GrpcHeadersFlag            string
 	GraffitiFlag               string
 	Endpoint                   string
+	BroadcastToBeaconNodes     bool
 	Web3SignerConfig           *remoteweb3signer.SetupConfig
 	ProposerSettings           *validatorserviceconfig.ProposerSettings
+	ProposerSettingsStore      ProposerSettingsStore
+	AttestationMaxBlockWait    time.Duration
+	AggregationSpread          time.Duration
+	DutyDelayHook              DutyDelayHook
 }
 
 // NewValidatorService creates a new validator service for the service
@@ -103,6 +122,7 @@ func NewValidatorService(ctx context.Context, cfg *Config) (*ValidatorService, e
 		ctx:                   ctx,
 		cancel:                cancel,
 		endpoint:              cfg.Endpoint,
+		broadcast:             cfg.BroadcastToBeaconNodes,
 		withCert:              cfg.CertFlag,
 		dataDir:               cfg.DataDir,
 		graffiti:              []byte(cfg.GraffitiFlag),
@@ -116,11 +136,16 @@ func NewValidatorService(ctx context.Context, cfg *Config) (*ValidatorService, e
 		db:                    cfg.ValDB,
 		wallet:                cfg.Wallet,
 		walletInitializedFeed: cfg.WalletInitializedFeed,
+		dutyEventFeed:         new(event.Feed),
 		useWeb:                cfg.UseWeb,
 		interopKeysConfig:     cfg.InteropKeysConfig,
 		graffitiStruct:        cfg.GraffitiStruct,
 		Web3SignerConfig:      cfg.Web3SignerConfig,
 		proposerSettings:      cfg.ProposerSettings,
+		proposerSettingsStore: cfg.ProposerSettingsStore,
+		maxBlockWait:          cfg.AttestationMaxBlockWait,
+		aggregationSpread:     cfg.AggregationSpread,
+		dutyDelayHook:         cfg.DutyDelayHook,
 	}
 
 	dialOpts := ConstructDialOptions(
@@ -135,10 +160,23 @@ func NewValidatorService(ctx context.Context, cfg *Config) (*ValidatorService, e
 
 	s.ctx = grpcutil.AppendHeaders(ctx, s.grpcHeaders)
 
-	conn, err := grpc.DialContext(ctx, s.endpoint, dialOpts...)
+	// Each comma-separated endpoint is a separate beacon node, which duties fail over to.
+	endpoints := make([]string, 0)
+	for _, endpoint := range strings.Split(s.endpoint, ",") {
+		if endpoint = strings.TrimSpace(endpoint); endpoint != "" {
+			endpoints = append(endpoints, endpoint)
+		}
+	}
+	if len(endpoints) == 0 {
+		endpoints = append(endpoints, s.endpoint)
+	}
+	conn, err := dialBeaconNodes(ctx, endpoints, dialOpts...)
 	if err != nil {
 		return s, err
 	}
+	if s.broadcast && len(endpoints) == 1 {
+		log.Warn("Broadcasting to beacon nodes is enabled, but only one beacon node endpoint is configured")
+	}
 	if s.withCert != "" {
 		log.Info("Established secure gRPC connection")
 	}
@@ -177,9 +215,14 @@ func (v *ValidatorService) Start() {
 		return
 	}
 
+	var validatorClient ethpb.BeaconNodeValidatorClient = ethpb.NewBeaconNodeValidatorClient(v.conn)
+	if v.broadcast && len(v.conn.conns) > 1 {
+		validatorClient = newBroadcastValidatorClient(v.conn)
+	}
+
 	valStruct := &validator{
 		db:                             v.db,
-		validatorClient:                ethpb.NewBeaconNodeValidatorClient(v.conn),
+		validatorClient:                validatorClient,
 		beaconClient:                   ethpb.NewBeaconChainClient(v.conn),
 		slashingProtectionClient:       ethpb.NewSlasherClient(v.conn),
 		node:                           ethpb.NewNodeClient(v.conn),
@@ -200,12 +243,16 @@ func (v *ValidatorService) Start() {
 		wallet:                         v.wallet,
 		walletInitializedFeed:          v.walletInitializedFeed,
 		blockFeed:                      new(event.Feed),
+		dutyEventFeed:                  v.dutyEventFeed,
 		graffitiStruct:                 v.graffitiStruct,
 		graffitiOrderedIndex:           graffitiOrderedIndex,
 		eipImportBlacklistedPublicKeys: slashablePublicKeys,
 		Web3SignerConfig:               v.Web3SignerConfig,
 		proposerSettings:               v.proposerSettings,
 		walletInitializedChannel:       make(chan *wallet.Wallet, 1),
+		maxBlockWait:                   v.maxBlockWait,
+		aggregationSpread:              v.aggregationSpread,
+		dutyDelayHook:                  v.dutyDelayHook,
 	}
 	// To resolve a race condition at startup due to the interface
 	// nature of the abstracted block type. We initialize
@@ -217,10 +264,42 @@ func (v *ValidatorService) Start() {
 	sub.Unsubscribe()
 	close(tempChan)
 
+	valStruct.logGraffitiOverride()
+
 	v.validator = valStruct
+	if len(v.conn.conns) > 1 {
+		go v.conn.run(v.ctx)
+	}
+	if v.proposerSettingsStore != nil {
+		go v.applyProposerSettingsUpdates()
+	}
 	go run(v.ctx, v.validator)
 }
 
+// Applies the proposer settings reloaded from the proposer settings file or URL. They are pushed
+// to the beacon node at the start of the next epoch.
+func (v *ValidatorService) applyProposerSettingsUpdates() {
+	updates := make(chan *validatorserviceconfig.ProposerSettings, 1)
+	sub := v.proposerSettingsStore.SubscribeUpdates(updates)
+	defer sub.Unsubscribe()
+	for {
+		select {
+		case settings := <-updates:
+			// The settings are only assigned to the validator, which guards them against concurrent
+			// readers in the proposal and registration paths.
+			v.validator.SetProposerSettings(settings)
+			log.Info("Reloaded proposer settings")
+		case err := <-sub.Err():
+			if err != nil {
+				log.WithError(err).Error("Proposer settings subscription failed")
+			}
+			return
+		case <-v.ctx.Done():
+			return
+		}
+	}
+}
+
 // Stop the validator service.
 func (v *ValidatorService) Stop() error {
 	v.cancel()
@@ -239,6 +318,28 @@ func (v *ValidatorService) Status() error {
 	return nil
 }
 
+// BeaconNodeStatuses returns the health of each beacon node the validator client is configured with.
+func (v *ValidatorService) BeaconNodeStatuses() []*validatorpb.BeaconNodeStatus {
+	if v.conn == nil {
+		return nil
+	}
+	return v.conn.Statuses()
+}
+
+// PrimaryBeaconNodeEndpoint returns the endpoint of the beacon node duties are currently sent to.
+func (v *ValidatorService) PrimaryBeaconNodeEndpoint() string {
+	if v.conn == nil {
+		return ""
+	}
+	return v.conn.PrimaryEndpoint()
+}
+
+// DutyEventFeed returns the feed of the outcomes of the duties performed by the validator client.
+// Events are sent as *validatorpb.DutyEvent.
+func (v *ValidatorService) DutyEventFeed() *event.Feed {
+	return v.dutyEventFeed
+}
+
 // InteropKeysConfig returns the useInteropKeys flag.
 func (v *ValidatorService) InteropKeysConfig() *local.InteropKeymanagerConfig {
 	return v.interopKeysConfig
@@ -252,9 +353,15 @@ func (v *ValidatorService) ProposerSettings() *validatorserviceconfig.ProposerSe
 	return v.validator.ProposerSettings()
 }
 
-func (v *ValidatorService) SetProposerSettings(settings *validatorserviceconfig.ProposerSettings) {
+// SetProposerSettings sets the proposer settings of the validator, and writes them back to the
+// proposer settings file if proposer settings reloading is enabled.
+func (v *ValidatorService) SetProposerSettings(settings *validatorserviceconfig.ProposerSettings) error {
 	v.proposerSettings = settings
 	v.validator.SetProposerSettings(settings)
+	if v.proposerSettingsStore != nil {
+		return v.proposerSettingsStore.Save(settings)
+	}
+	return nil
 }
 
 // ConstructDialOptions constructs a list of grpc dial options
@@ -5,9 +5,12 @@ import (
 	"testing"
 	"time"
 
+	"github.com/prysmaticlabs/prysm/v3/async/event"
+	validatorserviceconfig "github.com/prysmaticlabs/prysm/v3/config/validator/service"
 	"github.com/prysmaticlabs/prysm/v3/runtime"
 	"github.com/prysmaticlabs/prysm/v3/testing/assert"
 	"github.com/prysmaticlabs/prysm/v3/testing/require"
+	"github.com/prysmaticlabs/prysm/v3/validator/client/testutil"
 	logTest "github.com/sirupsen/logrus/hooks/test"
 	"google.golang.org/grpc/metadata"
 )
@@ -72,3 +75,62 @@ func TestStart_GrpcHeaders(t *testing.T) {
 		}
 	}
 }
+
+type mockProposerSettingsStore struct {
+	feed  *event.Feed
+	saved []*validatorserviceconfig.ProposerSettings
+}
+
+func (m *mockProposerSettingsStore) Save(settings *validatorserviceconfig.ProposerSettings) error {
+	m.saved = append(m.saved, settings)
+	return nil
+}
+
+func (m *mockProposerSettingsStore) SubscribeUpdates(ch chan<- *validatorserviceconfig.ProposerSettings) event.Subscription {
+	return m.feed.Subscribe(ch)
+}
+
+func TestProposerSettingsStore(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	store := &mockProposerSettingsStore{feed: new(event.Feed)}
+	v := &testutil.FakeValidator{}
+	vs := &ValidatorService{
+		ctx:                   ctx,
+		cancel:                cancel,
+		validator:             v,
+		proposerSettingsStore: store,
+	}
+
+	// Changes from the keymanager API are saved.
+	settings := &validatorserviceconfig.ProposerSettings{
+		DefaultConfig: &validatorserviceconfig.ProposerOption{Graffiti: "api"},
+	}
+	require.NoError(t, vs.SetProposerSettings(settings))
+	require.Equal(t, 1, len(store.saved))
+	assert.Equal(t, settings, v.ProposerSettings())
+
+	// Reloaded settings are applied, without being saved again.
+	hook := logTest.NewGlobal()
+	go vs.applyProposerSettingsUpdates()
+	reloaded := &validatorserviceconfig.ProposerSettings{
+		DefaultConfig: &validatorserviceconfig.ProposerOption{Graffiti: "reloaded"},
+	}
+	for store.feed.Send(reloaded) == 0 {
+		time.Sleep(10 * time.Millisecond)
+	}
+	for !logsContain(hook, "Reloaded proposer settings") {
+		time.Sleep(10 * time.Millisecond)
+	}
+	assert.Equal(t, reloaded, vs.ProposerSettings())
+	assert.Equal(t, 1, len(store.saved))
+}
+
+func logsContain(hook *logTest.Hook, msg string) bool {
+	for _, entry := range hook.AllEntries() {
+		if entry.Message == msg {
+			return true
+		}
+	}
+	return false
+}
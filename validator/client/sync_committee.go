@@ -17,6 +17,7 @@ import (
 	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
 	validatorpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1/validator-client"
 	"github.com/prysmaticlabs/prysm/v3/time/slots"
+	"github.com/prysmaticlabs/prysm/v3/validator/client/iface"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 )
@@ -27,30 +28,35 @@ func (v *validator) SubmitSyncCommitteeMessage(ctx context.Context, slot types.S
 	defer span.End()
 	span.AddAttributes(trace.StringAttribute("validator", fmt.Sprintf("%#x", pubKey)))
 
-	v.waitOneThirdOrValidBlock(ctx, slot)
+	v.waitInjectedDutyDelay(ctx, iface.RoleSyncCommittee, slot, pubKey)
+	v.waitOneThirdOrValidBlock(ctx, slot, v.attestationOffset(pubKey))
 
 	res, err := v.validatorClient.GetSyncMessageBlockRoot(ctx, &emptypb.Empty{})
 	if err != nil {
 		log.WithError(err).Error("Could not request sync message block root to sign")
 		tracing.AnnotateError(span, err)
+		v.sendDutyEvent(validatorpb.DutyEvent_SYNC_COMMITTEE_MESSAGE, slot, pubKey, err)
 		return
 	}
 
 	duty, err := v.duty(pubKey)
 	if err != nil {
 		log.WithError(err).Error("Could not fetch validator assignment")
+		v.sendDutyEvent(validatorpb.DutyEvent_SYNC_COMMITTEE_MESSAGE, slot, pubKey, err)
 		return
 	}
 
 	d, err := v.domainData(ctx, slots.ToEpoch(slot), params.BeaconConfig().DomainSyncCommittee[:])
 	if err != nil {
 		log.WithError(err).Error("Could not get sync committee domain data")
+		v.sendDutyEvent(validatorpb.DutyEvent_SYNC_COMMITTEE_MESSAGE, slot, pubKey, err)
 		return
 	}
 	sszRoot := types.SSZBytes(res.Root)
 	r, err := signing.ComputeSigningRoot(&sszRoot, d.SignatureDomain)
 	if err != nil {
 		log.WithError(err).Error("Could not get sync committee message signing root")
+		v.sendDutyEvent(validatorpb.DutyEvent_SYNC_COMMITTEE_MESSAGE, slot, pubKey, err)
 		return
 	}
 
@@ -65,6 +71,7 @@ func (v *validator) SubmitSyncCommitteeMessage(ctx context.Context, slot types.S
 	})
 	if err != nil {
 		log.WithError(err).Error("Could not sign sync committee message")
+		v.sendDutyEvent(validatorpb.DutyEvent_SYNC_COMMITTEE_MESSAGE, slot, pubKey, err)
 		return
 	}
 
@@ -76,8 +83,10 @@ func (v *validator) SubmitSyncCommitteeMessage(ctx context.Context, slot types.S
 	}
 	if _, err := v.validatorClient.SubmitSyncMessage(ctx, msg); err != nil {
 		log.WithError(err).Error("Could not submit sync committee message")
+		v.sendDutyEvent(validatorpb.DutyEvent_SYNC_COMMITTEE_MESSAGE, slot, pubKey, err)
 		return
 	}
+	v.sendDutyEvent(validatorpb.DutyEvent_SYNC_COMMITTEE_MESSAGE, slot, pubKey, nil)
 
 	msgSlot := msg.Slot
 	slotTime := time.Unix(int64(v.genesisTime+uint64(msgSlot)*params.BeaconConfig().SecondsPerSlot), 0)
@@ -97,9 +106,12 @@ func (v *validator) SubmitSignedContributionAndProof(ctx context.Context, slot t
 	defer span.End()
 	span.AddAttributes(trace.StringAttribute("validator", fmt.Sprintf("%#x", pubKey)))
 
+	v.waitInjectedDutyDelay(ctx, iface.RoleSyncCommitteeAggregator, slot, pubKey)
+
 	duty, err := v.duty(pubKey)
 	if err != nil {
 		log.WithError(err).Error("Could not fetch validator assignment")
+		v.sendDutyEvent(validatorpb.DutyEvent_SYNC_COMMITTEE_CONTRIBUTION, slot, pubKey, err)
 		return
 	}
 
@@ -109,6 +121,7 @@ func (v *validator) SubmitSignedContributionAndProof(ctx context.Context, slot t
 	})
 	if err != nil {
 		log.WithError(err).Error("Could not get sync subcommittee index")
+		v.sendDutyEvent(validatorpb.DutyEvent_SYNC_COMMITTEE_CONTRIBUTION, slot, pubKey, err)
 		return
 	}
 	if len(indexRes.Indices) == 0 {
@@ -119,15 +132,17 @@ func (v *validator) SubmitSignedContributionAndProof(ctx context.Context, slot t
 	selectionProofs, err := v.selectionProofs(ctx, slot, pubKey, indexRes)
 	if err != nil {
 		log.WithError(err).Error("Could not get selection proofs")
+		v.sendDutyEvent(validatorpb.DutyEvent_SYNC_COMMITTEE_CONTRIBUTION, slot, pubKey, err)
 		return
 	}
 
-	v.waitToSlotTwoThirds(ctx, slot)
+	v.waitToSlotTwoThirds(ctx, slot, v.aggregationOffset(pubKey))
 
 	for i, comIdx := range indexRes.Indices {
 		isAggregator, err := altair.IsSyncCommitteeAggregator(selectionProofs[i])
 		if err != nil {
 			log.WithError(err).Error("Could check in aggregator")
+			v.sendDutyEvent(validatorpb.DutyEvent_SYNC_COMMITTEE_CONTRIBUTION, slot, pubKey, err)
 			return
 		}
 		if !isAggregator {
@@ -142,6 +157,7 @@ func (v *validator) SubmitSignedContributionAndProof(ctx context.Context, slot t
 		})
 		if err != nil {
 			log.WithError(err).Error("Could not get sync committee contribution")
+			v.sendDutyEvent(validatorpb.DutyEvent_SYNC_COMMITTEE_CONTRIBUTION, slot, pubKey, err)
 			return
 		}
 		if contribution.AggregationBits.Count() == 0 {
@@ -161,6 +177,7 @@ func (v *validator) SubmitSignedContributionAndProof(ctx context.Context, slot t
 		sig, err := v.signContributionAndProof(ctx, pubKey, contributionAndProof, slot)
 		if err != nil {
 			log.WithError(err).Error("Could not sign contribution and proof")
+			v.sendDutyEvent(validatorpb.DutyEvent_SYNC_COMMITTEE_CONTRIBUTION, slot, pubKey, err)
 			return
 		}
 
@@ -169,8 +186,10 @@ func (v *validator) SubmitSignedContributionAndProof(ctx context.Context, slot t
 			Signature: sig,
 		}); err != nil {
 			log.WithError(err).Error("Could not submit signed contribution and proof")
+			v.sendDutyEvent(validatorpb.DutyEvent_SYNC_COMMITTEE_CONTRIBUTION, slot, pubKey, err)
 			return
 		}
+		v.sendDutyEvent(validatorpb.DutyEvent_SYNC_COMMITTEE_CONTRIBUTION, slot, pubKey, nil)
 
 		contributionSlot := contributionAndProof.Contribution.Slot
 		slotTime := time.Unix(int64(v.genesisTime+uint64(contributionSlot)*params.BeaconConfig().SecondsPerSlot), 0)
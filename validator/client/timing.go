@@ -0,0 +1,103 @@
+package client
+
+import (
+	"context"
+	"encoding/binary"
+	"time"
+
+	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
+	validatorserviceconfig "github.com/prysmaticlabs/prysm/v3/config/validator/service"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v3/crypto/hash"
+	prysmTime "github.com/prysmaticlabs/prysm/v3/time"
+	"github.com/prysmaticlabs/prysm/v3/time/slots"
+	"github.com/prysmaticlabs/prysm/v3/validator/client/iface"
+)
+
+// DutyDelayHook returns an extra delay a validator waits for before performing the duty of its role
+// at the slot. It is meant for testing only, to simulate slow or clock-skewed validator clients.
+type DutyDelayHook func(role iface.ValidatorRole, slot types.Slot, pubKey [fieldparams.BLSPubkeyLength]byte) time.Duration
+
+// ConstantDutyDelay returns a duty delay hook delaying every duty by the same duration.
+func ConstantDutyDelay(delay time.Duration) DutyDelayHook {
+	return func(_ iface.ValidatorRole, _ types.Slot, _ [fieldparams.BLSPubkeyLength]byte) time.Duration {
+		return delay
+	}
+}
+
+// timingConfig returns the timing config of the validator in the proposer settings, falling back
+// to the default timing config.
+func (v *validator) timingConfig(pubKey [fieldparams.BLSPubkeyLength]byte) *validatorserviceconfig.TimingConfig {
+	settings := v.ProposerSettings()
+	if settings == nil {
+		return nil
+	}
+	if option, ok := settings.ProposeConfig[pubKey]; ok && option.Timing != nil {
+		return option.Timing
+	}
+	if settings.DefaultConfig != nil {
+		return settings.DefaultConfig.Timing
+	}
+	return nil
+}
+
+// attestationOffset returns how much the validator shifts its attestations and sync committee messages.
+func (v *validator) attestationOffset(pubKey [fieldparams.BLSPubkeyLength]byte) time.Duration {
+	if timing := v.timingConfig(pubKey); timing != nil {
+		return time.Duration(timing.AttestationOffsetMs) * time.Millisecond
+	}
+	return 0
+}
+
+// proposalDelay returns how long after the start of the slot the validator proposes its blocks.
+func (v *validator) proposalDelay(pubKey [fieldparams.BLSPubkeyLength]byte) time.Duration {
+	if timing := v.timingConfig(pubKey); timing != nil {
+		return time.Duration(timing.ProposalDelayMs) * time.Millisecond
+	}
+	return 0
+}
+
+// blockWait returns how long into the slot attesters wait for the block of the slot.
+func (v *validator) blockWait() time.Duration {
+	if v.maxBlockWait > 0 {
+		return v.maxBlockWait
+	}
+	return slots.DivideSlotBy(3 /* a third of the slot duration */)
+}
+
+// aggregationOffset returns the offset of the validator in the aggregation spread window. It is derived
+// from the public key, so the submissions of a large key set are spread evenly over the window, and
+// every validator keeps the same offset across slots.
+func (v *validator) aggregationOffset(pubKey [fieldparams.BLSPubkeyLength]byte) time.Duration {
+	if v.aggregationSpread <= 0 {
+		return 0
+	}
+	h := hash.Hash(pubKey[:])
+	return time.Duration(binary.LittleEndian.Uint64(h[:8]) % uint64(v.aggregationSpread))
+}
+
+// waitInjectedDutyDelay waits for the delay injected by the duty delay hook before the duty of the role.
+func (v *validator) waitInjectedDutyDelay(ctx context.Context, role iface.ValidatorRole, slot types.Slot, pubKey [fieldparams.BLSPubkeyLength]byte) {
+	if v.dutyDelayHook == nil {
+		return
+	}
+	waitFor(ctx, v.dutyDelayHook(role, slot, pubKey))
+}
+
+// waitUntil waits until the given time, or until the context is done.
+func waitUntil(ctx context.Context, t time.Time) {
+	waitFor(ctx, prysmTime.Until(t))
+}
+
+// waitFor waits for the given duration, or until the context is done.
+func waitFor(ctx context.Context, d time.Duration) {
+	if d <= 0 {
+		return
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+	case <-timer.C:
+	}
+}
@@ -0,0 +1,112 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v3/async/event"
+	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v3/config/params"
+	validatorserviceconfig "github.com/prysmaticlabs/prysm/v3/config/validator/service"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+	"github.com/prysmaticlabs/prysm/v3/time/slots"
+	"github.com/prysmaticlabs/prysm/v3/validator/client/iface"
+)
+
+func TestValidator_TimingConfig(t *testing.T) {
+	key1 := [fieldparams.BLSPubkeyLength]byte{1}
+	key2 := [fieldparams.BLSPubkeyLength]byte{2}
+	key3 := [fieldparams.BLSPubkeyLength]byte{3}
+	v := &validator{}
+	assert.Equal(t, time.Duration(0), v.attestationOffset(key1))
+	assert.Equal(t, time.Duration(0), v.proposalDelay(key1))
+
+	v.proposerSettings = &validatorserviceconfig.ProposerSettings{
+		ProposeConfig: map[[fieldparams.BLSPubkeyLength]byte]*validatorserviceconfig.ProposerOption{
+			key1: {Timing: &validatorserviceconfig.TimingConfig{AttestationOffsetMs: -300, ProposalDelayMs: 200}},
+			key2: {},
+		},
+		DefaultConfig: &validatorserviceconfig.ProposerOption{
+			Timing: &validatorserviceconfig.TimingConfig{AttestationOffsetMs: 500},
+		},
+	}
+	assert.Equal(t, -300*time.Millisecond, v.attestationOffset(key1))
+	assert.Equal(t, 200*time.Millisecond, v.proposalDelay(key1))
+	// Validators without their own timing use the default timing.
+	assert.Equal(t, 500*time.Millisecond, v.attestationOffset(key2))
+	assert.Equal(t, time.Duration(0), v.proposalDelay(key2))
+	assert.Equal(t, 500*time.Millisecond, v.attestationOffset(key3))
+}
+
+func TestValidator_BlockWait(t *testing.T) {
+	v := &validator{}
+	assert.Equal(t, slots.DivideSlotBy(3), v.blockWait())
+	v.maxBlockWait = 2 * time.Second
+	assert.Equal(t, 2*time.Second, v.blockWait())
+}
+
+func TestValidator_AggregationOffset(t *testing.T) {
+	key1 := [fieldparams.BLSPubkeyLength]byte{1}
+	key2 := [fieldparams.BLSPubkeyLength]byte{2}
+	v := &validator{}
+	assert.Equal(t, time.Duration(0), v.aggregationOffset(key1))
+
+	v.aggregationSpread = time.Second
+	offset1 := v.aggregationOffset(key1)
+	offset2 := v.aggregationOffset(key2)
+	assert.Equal(t, true, offset1 >= 0 && offset1 < time.Second)
+	assert.Equal(t, true, offset2 >= 0 && offset2 < time.Second)
+	assert.NotEqual(t, offset1, offset2)
+	// The offset of a validator is the same in every slot.
+	assert.Equal(t, offset1, v.aggregationOffset(key1))
+}
+
+func TestValidator_WaitInjectedDutyDelay(t *testing.T) {
+	key := [fieldparams.BLSPubkeyLength]byte{1}
+	var gotRole iface.ValidatorRole
+	var gotSlot types.Slot
+	v := &validator{
+		dutyDelayHook: func(role iface.ValidatorRole, slot types.Slot, pubKey [fieldparams.BLSPubkeyLength]byte) time.Duration {
+			gotRole = role
+			gotSlot = slot
+			require.Equal(t, key, pubKey)
+			return 100 * time.Millisecond
+		},
+	}
+	start := time.Now()
+	v.waitInjectedDutyDelay(context.Background(), iface.RoleAggregator, 5, key)
+	assert.Equal(t, true, time.Since(start) >= 100*time.Millisecond)
+	assert.Equal(t, iface.RoleAggregator, gotRole)
+	assert.Equal(t, types.Slot(5), gotSlot)
+
+	// The delay ends with the context.
+	v.dutyDelayHook = ConstantDutyDelay(time.Hour)
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	v.waitInjectedDutyDelay(ctx, iface.RoleAttester, 5, key)
+	require.ErrorIs(t, ctx.Err(), context.DeadlineExceeded)
+}
+
+func TestServer_WaitToSlotOneThird_MaxBlockWaitAndOffset(t *testing.T) {
+	currentTime := uint64(time.Now().Unix())
+	currentSlot := types.Slot(4)
+	genesisTime := currentTime - uint64(currentSlot.Mul(params.BeaconConfig().SecondsPerSlot))
+
+	v := &validator{
+		genesisTime:  genesisTime,
+		blockFeed:    new(event.Feed),
+		maxBlockWait: 2 * time.Second,
+	}
+	// A negative offset shortens the wait for the block.
+	v.waitOneThirdOrValidBlock(context.Background(), currentSlot, -time.Second)
+	assert.Equal(t, currentTime+1, uint64(time.Now().Unix()))
+
+	// A positive offset delays the validator once the wait for the block ended.
+	v.highestValidSlot = currentSlot
+	start := time.Now()
+	v.waitOneThirdOrValidBlock(context.Background(), currentSlot, 200*time.Millisecond)
+	assert.Equal(t, true, time.Since(start) >= 200*time.Millisecond)
+}
@@ -32,6 +32,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v3/crypto/hash"
 	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
 	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	validatorpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1/validator-client"
 	"github.com/prysmaticlabs/prysm/v3/time/slots"
 	accountsiface "github.com/prysmaticlabs/prysm/v3/validator/accounts/iface"
 	"github.com/prysmaticlabs/prysm/v3/validator/accounts/wallet"
@@ -72,6 +73,7 @@ type validator struct {
 	highestValidSlotLock               sync.Mutex
 	prevBalanceLock                    sync.RWMutex
 	slashableKeysLock                  sync.RWMutex
+	proposerSettingsLock               sync.RWMutex
 	eipImportBlacklistedPublicKeys     map[[fieldparams.BLSPubkeyLength]byte]bool
 	walletInitializedFeed              *event.Feed
 	attLogs                            map[[32]byte]*attSubmitted
@@ -86,6 +88,9 @@ type validator struct {
 	highestValidSlot                   types.Slot
 	genesisTime                        uint64
 	blockFeed                          *event.Feed
+	dutyEventFeed                      *event.Feed
+	dutyEventsOnce                     sync.Once
+	dutyEventQueue                     chan *validatorpb.DutyEvent
 	interopKeysConfig                  *local.InteropKeymanagerConfig
 	wallet                             *wallet.Wallet
 	graffitiStruct                     *graffiti.Graffiti
@@ -97,11 +102,15 @@ type validator struct {
 	ticker                             slots.Ticker
 	validatorClient                    ethpb.BeaconNodeValidatorClient
 	graffiti                           []byte
+	nodeVersion                        nodeVersionCache
 	voteStats                          voteStats
 	syncCommitteeStats                 syncCommitteeStats
 	Web3SignerConfig                   *remoteweb3signer.SetupConfig
 	proposerSettings                   *validatorserviceconfig.ProposerSettings
 	walletInitializedChannel           chan *wallet.Wallet
+	maxBlockWait                       time.Duration
+	aggregationSpread                  time.Duration
+	dutyDelayHook                      DutyDelayHook
 }
 
 type validatorStatus struct {
@@ -573,6 +582,10 @@ func (v *validator) UpdateDuties(ctx context.Context, slot types.Slot) error {
 	ctx, cancel := context.WithDeadline(ctx, v.SlotDeadline(ss))
 	defer cancel()
 	ctx, span := trace.StartSpan(ctx, "validator.UpdateAssignments")
+	// Keep the version of the beacon node used in graffiti templates fresh ahead of proposals.
+	if v.usesGraffitiTemplate() {
+		v.refreshNodeVersion()
+	}
 	defer span.End()
 
 	validatingKeys, err := v.keyManager.FetchValidatingPublicKeys(ctx)
@@ -965,11 +978,37 @@ func (v *validator) logDuties(slot types.Slot, duties []*ethpb.DutiesResponse_Du
 }
 
 func (v *validator) ProposerSettings() *validatorserviceconfig.ProposerSettings {
+	v.proposerSettingsLock.RLock()
+	defer v.proposerSettingsLock.RUnlock()
 	return v.proposerSettings
 }
 
 func (v *validator) SetProposerSettings(settings *validatorserviceconfig.ProposerSettings) {
+	v.proposerSettingsLock.Lock()
 	v.proposerSettings = settings
+	v.proposerSettingsLock.Unlock()
+	v.logGraffitiOverride()
+}
+
+// Warns when graffiti in the proposer settings takes precedence over the graffiti from the command line.
+func (v *validator) logGraffitiOverride() {
+	settings := v.ProposerSettings()
+	if len(v.graffiti) == 0 || settings == nil {
+		return
+	}
+	if settings.DefaultConfig != nil && settings.DefaultConfig.Graffiti != "" {
+		log.Warn("Default graffiti of the proposer settings overrides the --graffiti flag for all validators")
+		return
+	}
+	overridden := 0
+	for _, option := range settings.ProposeConfig {
+		if option != nil && option.Graffiti != "" {
+			overridden++
+		}
+	}
+	if overridden > 0 {
+		log.WithField("validators", overridden).Warn("Graffiti of the proposer settings overrides the --graffiti flag")
+	}
 }
 
 // PushProposerSettings calls the prepareBeaconProposer RPC to set the fee recipient and also the register validator API if using a custom builder.
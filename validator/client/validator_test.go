@@ -33,6 +33,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v3/validator/accounts/wallet"
 	"github.com/prysmaticlabs/prysm/v3/validator/client/iface"
 	dbTest "github.com/prysmaticlabs/prysm/v3/validator/db/testing"
+	"github.com/prysmaticlabs/prysm/v3/validator/graffiti"
 	"github.com/prysmaticlabs/prysm/v3/validator/keymanager"
 	"github.com/prysmaticlabs/prysm/v3/validator/keymanager/local"
 	remoteweb3signer "github.com/prysmaticlabs/prysm/v3/validator/keymanager/remote-web3signer"
@@ -1996,3 +1997,34 @@ func TestValidator_PushProposerSettings(t *testing.T) {
 		})
 	}
 }
+
+func TestValidator_SetProposerSettings_ConcurrentReads(t *testing.T) {
+	pubKey := [fieldparams.BLSPubkeyLength]byte{'a'}
+	v := &validator{
+		graffitiStruct: &graffiti.Graffiti{},
+		proposerSettings: &validatorserviceconfig.ProposerSettings{
+			DefaultConfig: &validatorserviceconfig.ProposerOption{Graffiti: "initial"},
+		},
+	}
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			v.SetProposerSettings(&validatorserviceconfig.ProposerSettings{
+				DefaultConfig: &validatorserviceconfig.ProposerOption{
+					Graffiti: fmt.Sprintf("graffiti %d", i),
+					Timing:   &validatorserviceconfig.TimingConfig{},
+				},
+			})
+		}
+	}()
+	for i := 0; i < 100; i++ {
+		v.timingConfig(pubKey)
+		v.usesGraffitiTemplate()
+		_, err := v.graffitiForPubKey(context.Background(), &proposerIndex{pubKey: pubKey})
+		require.NoError(t, err)
+	}
+	wg.Wait()
+	require.Equal(t, "graffiti 99", v.ProposerSettings().DefaultConfig.Graffiti)
+}
@@ -0,0 +1,54 @@
+package db
+
+import (
+	"context"
+	"path"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v3/cmd"
+	"github.com/prysmaticlabs/prysm/v3/io/file"
+	"github.com/prysmaticlabs/prysm/v3/validator/db/kv"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+// Compact a validator database into the watermark-only storage mode.
+func Compact(cliCtx *cli.Context) error {
+	dataDir := cliCtx.String(cmd.DataDirFlag.Name)
+
+	if !file.FileExists(path.Join(dataDir, kv.ProtectionDbFileName)) {
+		return errors.New("No validator db found at path, nothing to compact")
+	}
+
+	ctx := context.Background()
+	log.Info("Opening DB")
+	validatorDB, err := kv.NewKVStore(ctx, dataDir, &kv.Config{})
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := validatorDB.Close(); err != nil {
+			log.WithError(err).Error("Could not close database")
+		}
+	}()
+	if err := validatorDB.RunUpMigrations(ctx); err != nil {
+		return errors.Wrap(err, "could not run database migrations")
+	}
+	sizeBefore, err := validatorDB.Size()
+	if err != nil {
+		return err
+	}
+	log.Info("Compacting DB")
+	if err := validatorDB.Compact(ctx); err != nil {
+		return err
+	}
+	sizeAfter, err := validatorDB.Size()
+	if err != nil {
+		return err
+	}
+	log.WithFields(logrus.Fields{
+		"sizeBefore": sizeBefore,
+		"sizeAfter":  sizeAfter,
+	}).Info("Compaction completed successfully, the database now keeps only the slashing protection watermarks")
+	return nil
+}
@@ -36,6 +36,7 @@ type ValidatorDB interface {
 	ProposalHistoryForSlot(ctx context.Context, publicKey [fieldparams.BLSPubkeyLength]byte, slot types.Slot) ([32]byte, bool, error)
 	SaveProposalHistoryForSlot(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, slot types.Slot, signingRoot []byte) error
 	ProposedPublicKeys(ctx context.Context) ([][fieldparams.BLSPubkeyLength]byte, error)
+	RaiseLowestSignedProposal(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, slot types.Slot) error
 
 	// Attester protection related methods.
 	// Methods to store and read blacklisted public keys from EIP-3076
@@ -46,6 +47,7 @@ type ValidatorDB interface {
 	LowestSignedTargetEpoch(ctx context.Context, publicKey [fieldparams.BLSPubkeyLength]byte) (types.Epoch, bool, error)
 	LowestSignedSourceEpoch(ctx context.Context, publicKey [fieldparams.BLSPubkeyLength]byte) (types.Epoch, bool, error)
 	AttestedPublicKeys(ctx context.Context) ([][fieldparams.BLSPubkeyLength]byte, error)
+	RaiseLowestSignedEpochs(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, source, target types.Epoch) error
 	CheckSlashableAttestation(
 		ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, signingRoot [32]byte, att *ethpb.IndexedAttestation,
 	) (kv.SlashingKind, error)
@@ -83,6 +83,7 @@ const (
 	DoubleVote
 	SurroundingVote
 	SurroundedVote
+	BelowWatermark
 )
 
 var (
@@ -149,6 +150,14 @@ func (s *Store) CheckSlashableAttestation(
 			return nil
 		}
 
+		// Without the full attesting history, only attestations above the
+		// highest signed epochs can be signed safely.
+		if s.watermarkOnly {
+			var err error
+			slashKind, err = checkAttestationWatermarks(pkBucket, signingRoot, att)
+			return err
+		}
+
 		// First we check for double votes.
 		signingRootsBucket := pkBucket.Bucket(attestationSigningRootsBucket)
 		if signingRootsBucket != nil {
@@ -415,48 +424,12 @@ func (s *Store) saveAttestationRecords(ctx context.Context, atts []*AttestationR
 			if err != nil {
 				return errors.Wrap(err, "could not create public key bucket")
 			}
-			sourceEpochBytes := bytesutil.EpochToBytesBigEndian(att.Source)
-			targetEpochBytes := bytesutil.EpochToBytesBigEndian(att.Target)
-
-			signingRootsBucket, err := pkBucket.CreateBucketIfNotExists(attestationSigningRootsBucket)
-			if err != nil {
-				return errors.Wrap(err, "could not create signing roots bucket")
-			}
-			if err := signingRootsBucket.Put(targetEpochBytes, att.SigningRoot[:]); err != nil {
-				return errors.Wrapf(err, "could not save signing signing root for epoch %d", att.Target)
-			}
-			sourceEpochsBucket, err := pkBucket.CreateBucketIfNotExists(attestationSourceEpochsBucket)
-			if err != nil {
-				return errors.Wrap(err, "could not create source epochs bucket")
-			}
-
-			// There can be multiple attested target epochs per source epoch.
-			// If a previous list exists, we append to that list with the incoming target epoch.
-			// Otherwise, we initialize it using the incoming target epoch.
-			var existingAttestedTargetsBytes []byte
-			if existing := sourceEpochsBucket.Get(sourceEpochBytes); existing != nil {
-				existingAttestedTargetsBytes = append(existing, targetEpochBytes...)
-			} else {
-				existingAttestedTargetsBytes = targetEpochBytes
-			}
-
-			if err := sourceEpochsBucket.Put(sourceEpochBytes, existingAttestedTargetsBytes); err != nil {
-				return errors.Wrapf(err, "could not save source epoch %d for epoch %d", att.Source, att.Target)
-			}
-
-			targetEpochsBucket, err := pkBucket.CreateBucketIfNotExists(attestationTargetEpochsBucket)
-			if err != nil {
-				return errors.Wrap(err, "could not create target epochs bucket")
-			}
-			var existingAttestedSourceBytes []byte
-			if existing := targetEpochsBucket.Get(targetEpochBytes); existing != nil {
-				existingAttestedSourceBytes = append(existing, sourceEpochBytes...)
-			} else {
-				existingAttestedSourceBytes = sourceEpochBytes
-			}
-
-			if err := targetEpochsBucket.Put(targetEpochBytes, existingAttestedSourceBytes); err != nil {
-				return errors.Wrapf(err, "could not save target epoch %d for epoch %d", att.Target, att.Source)
+			if s.watermarkOnly {
+				if err := saveAttestationWatermarks(pkBucket, att); err != nil {
+					return err
+				}
+			} else if err := saveAttestationHistory(pkBucket, att); err != nil {
+				return err
 			}
 
 			// If the incoming source epoch is lower than the lowest signed source epoch, override.
@@ -491,6 +464,54 @@ func (s *Store) saveAttestationRecords(ctx context.Context, atts []*AttestationR
 	})
 }
 
+// Appends an attestation record to the attesting history of a public key.
+func saveAttestationHistory(pkBucket *bolt.Bucket, att *AttestationRecord) error {
+	sourceEpochBytes := bytesutil.EpochToBytesBigEndian(att.Source)
+	targetEpochBytes := bytesutil.EpochToBytesBigEndian(att.Target)
+
+	signingRootsBucket, err := pkBucket.CreateBucketIfNotExists(attestationSigningRootsBucket)
+	if err != nil {
+		return errors.Wrap(err, "could not create signing roots bucket")
+	}
+	if err := signingRootsBucket.Put(targetEpochBytes, att.SigningRoot[:]); err != nil {
+		return errors.Wrapf(err, "could not save signing signing root for epoch %d", att.Target)
+	}
+	sourceEpochsBucket, err := pkBucket.CreateBucketIfNotExists(attestationSourceEpochsBucket)
+	if err != nil {
+		return errors.Wrap(err, "could not create source epochs bucket")
+	}
+
+	// There can be multiple attested target epochs per source epoch.
+	// If a previous list exists, we append to that list with the incoming target epoch.
+	// Otherwise, we initialize it using the incoming target epoch.
+	var existingAttestedTargetsBytes []byte
+	if existing := sourceEpochsBucket.Get(sourceEpochBytes); existing != nil {
+		existingAttestedTargetsBytes = append(existing, targetEpochBytes...)
+	} else {
+		existingAttestedTargetsBytes = targetEpochBytes
+	}
+
+	if err := sourceEpochsBucket.Put(sourceEpochBytes, existingAttestedTargetsBytes); err != nil {
+		return errors.Wrapf(err, "could not save source epoch %d for epoch %d", att.Source, att.Target)
+	}
+
+	targetEpochsBucket, err := pkBucket.CreateBucketIfNotExists(attestationTargetEpochsBucket)
+	if err != nil {
+		return errors.Wrap(err, "could not create target epochs bucket")
+	}
+	var existingAttestedSourceBytes []byte
+	if existing := targetEpochsBucket.Get(targetEpochBytes); existing != nil {
+		existingAttestedSourceBytes = append(existing, sourceEpochBytes...)
+	} else {
+		existingAttestedSourceBytes = sourceEpochBytes
+	}
+
+	if err := targetEpochsBucket.Put(targetEpochBytes, existingAttestedSourceBytes); err != nil {
+		return errors.Wrapf(err, "could not save target epoch %d for epoch %d", att.Target, att.Source)
+	}
+	return nil
+}
+
 // AttestedPublicKeys retrieves all public keys that have attested.
 func (s *Store) AttestedPublicKeys(ctx context.Context) ([][fieldparams.BLSPubkeyLength]byte, error) {
 	ctx, span := trace.StartSpan(ctx, "Validator.AttestedPublicKeys")
@@ -577,3 +598,41 @@ func (s *Store) LowestSignedTargetEpoch(ctx context.Context, publicKey [fieldpar
 	})
 	return lowestSignedTargetEpoch, exists, err
 }
+
+// RaiseLowestSignedEpochs raises the lowest signed source and target epochs for a validator public key
+// to the given epochs, unless they are already higher. Attestations with a source epoch lower than the
+// lowest signed source epoch, or a target epoch lower than or equal to the lowest signed target epoch,
+// are refused, so this is used to carry over the watermarks of another slashing protection history
+// without its individual attestations.
+func (s *Store) RaiseLowestSignedEpochs(
+	ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, source, target types.Epoch,
+) error {
+	ctx, span := trace.StartSpan(ctx, "Validator.RaiseLowestSignedEpochs")
+	defer span.End()
+
+	return s.update(func(tx *bolt.Tx) error {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		for _, watermark := range []struct {
+			bucket []byte
+			epoch  types.Epoch
+		}{
+			{bucket: lowestSignedSourceBucket, epoch: source},
+			{bucket: lowestSignedTargetBucket, epoch: target},
+		} {
+			bucket, err := tx.CreateBucketIfNotExists(watermark.bucket)
+			if err != nil {
+				return err
+			}
+			existing := bucket.Get(pubKey[:])
+			if len(existing) >= 8 && bytesutil.BytesToEpochBigEndian(existing) >= watermark.epoch {
+				continue
+			}
+			if err := bucket.Put(pubKey[:], bytesutil.EpochToBytesBigEndian(watermark.epoch)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
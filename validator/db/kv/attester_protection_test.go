@@ -329,6 +329,33 @@ func TestLowestSignedTargetEpoch_SaveRetrieveReplace(t *testing.T) {
 	require.Equal(t, types.Epoch(199), got)
 }
 
+func TestStore_RaiseLowestSignedEpochs(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [fieldparams.BLSPubkeyLength]byte{1}
+	validatorDB := setupDB(t, [][fieldparams.BLSPubkeyLength]byte{pubKey})
+
+	require.NoError(
+		t,
+		validatorDB.SaveAttestationForPubKey(ctx, pubKey, [32]byte{}, createAttestation(10, 11)),
+	)
+	require.NoError(t, validatorDB.RaiseLowestSignedEpochs(ctx, pubKey, 20, 21))
+	source, _, err := validatorDB.LowestSignedSourceEpoch(ctx, pubKey)
+	require.NoError(t, err)
+	require.Equal(t, types.Epoch(20), source)
+	target, _, err := validatorDB.LowestSignedTargetEpoch(ctx, pubKey)
+	require.NoError(t, err)
+	require.Equal(t, types.Epoch(21), target)
+
+	// The watermarks are never lowered, but each is raised on its own.
+	require.NoError(t, validatorDB.RaiseLowestSignedEpochs(ctx, pubKey, 15, 30))
+	source, _, err = validatorDB.LowestSignedSourceEpoch(ctx, pubKey)
+	require.NoError(t, err)
+	require.Equal(t, types.Epoch(20), source)
+	target, _, err = validatorDB.LowestSignedTargetEpoch(ctx, pubKey)
+	require.NoError(t, err)
+	require.Equal(t, types.Epoch(30), target)
+}
+
 func TestStore_SaveAttestationsForPubKey(t *testing.T) {
 	ctx := context.Background()
 	numValidators := 1
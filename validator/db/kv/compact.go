@@ -0,0 +1,135 @@
+package kv
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+const compactedDbFileSuffix = ".compact"
+
+// Compact rewrites the slashing protection history of every validator public key into the
+// watermark-only layout, keeping only the attestation with the highest signed source and target
+// epochs and the last signed proposal, and switches the database to the watermark-only storage mode.
+// As boltDB does not return the space of deleted data to the file system, the database is then
+// copied into a new file which replaces the current one. Compact must not be called while the
+// database is used by a running validator client.
+func (s *Store) Compact(ctx context.Context) error {
+	ctx, span := trace.StartSpan(ctx, "Validator.Compact")
+	defer span.End()
+
+	attestedPublicKeys, err := s.AttestedPublicKeys(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get attested public keys")
+	}
+	for _, pubKey := range attestedPublicKeys {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if err := s.update(func(tx *bolt.Tx) error {
+			pkBucket := tx.Bucket(pubKeysBucket).Bucket(pubKey[:])
+			if pkBucket == nil {
+				return nil
+			}
+			return compactAttestingHistory(pkBucket)
+		}); err != nil {
+			return errors.Wrapf(err, "could not compact attesting history of public key %#x", pubKey)
+		}
+	}
+
+	proposedPublicKeys, err := s.ProposedPublicKeys(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get proposed public keys")
+	}
+	for _, pubKey := range proposedPublicKeys {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if err := s.update(func(tx *bolt.Tx) error {
+			valBucket := tx.Bucket(historicProposalsBucket).Bucket(pubKey[:])
+			if valBucket == nil {
+				return nil
+			}
+			lastSlotBytes, _ := valBucket.Cursor().Last()
+			if lastSlotBytes == nil {
+				return nil
+			}
+			return pruneProposalHistoryToSlot(valBucket, bytesutil.BytesToSlotBigEndian(lastSlotBytes))
+		}); err != nil {
+			return errors.Wrapf(err, "could not compact proposal history of public key %#x", pubKey)
+		}
+	}
+
+	if err := s.initStorageMode(true /* watermark only */); err != nil {
+		return errors.Wrap(err, "could not switch to the watermark-only storage mode")
+	}
+	return s.rewriteDatabaseFile()
+}
+
+// Replaces the attesting history of a public key with its highest signed source and target epochs.
+// The signing root is only kept if a single attestation was signed at the highest target epoch and
+// it has the highest source epoch, as it identifies the last signed attestation.
+func compactAttestingHistory(pkBucket *bolt.Bucket) error {
+	source, target, exists := highestSignedEpochs(pkBucket)
+	if !exists {
+		return nil
+	}
+	var signingRoot [32]byte
+	sourceEpochs := pkBucket.Bucket(attestationTargetEpochsBucket).Get(bytesutil.EpochToBytesBigEndian(target))
+	if len(sourceEpochs) == 8 && bytesutil.BytesToEpochBigEndian(sourceEpochs) == source {
+		signingRoot = signingRootAtTarget(pkBucket, target)
+	}
+	return writeAttestationWatermarks(pkBucket, source, target, signingRoot)
+}
+
+// Copies the database into a new file, replaces the current database file with it and reopens it.
+func (s *Store) rewriteDatabaseFile() error {
+	datafile := filepath.Join(s.databasePath, ProtectionDbFileName)
+	compactedFile := datafile + compactedDbFileSuffix
+	copyDB, err := openBoltDB(compactedFile)
+	if err != nil {
+		return errors.Wrap(err, "could not create compacted database")
+	}
+	if err := s.db.View(func(tx *bolt.Tx) error {
+		return tx.ForEach(func(name []byte, b *bolt.Bucket) error {
+			return copyDB.Update(func(tx2 *bolt.Tx) error {
+				b2, err := tx2.CreateBucketIfNotExists(name)
+				if err != nil {
+					return err
+				}
+				return b.ForEach(createNestedBuckets(b, b2, b2.Put))
+			})
+		})
+	}); err != nil {
+		if closeErr := copyDB.Close(); closeErr != nil {
+			log.WithError(closeErr).Error("Failed to close compacted database")
+		}
+		if removeErr := os.Remove(compactedFile); removeErr != nil {
+			log.WithError(removeErr).Error("Failed to remove compacted database")
+		}
+		return errors.Wrap(err, "could not copy database")
+	}
+	if err := copyDB.Close(); err != nil {
+		return errors.Wrap(err, "could not close compacted database")
+	}
+
+	prometheus.Unregister(createBoltCollector(s.db))
+	if err := s.db.Close(); err != nil {
+		return errors.Wrap(err, "could not close database")
+	}
+	if err := os.Rename(compactedFile, datafile); err != nil {
+		return errors.Wrap(err, "could not replace database with compacted database")
+	}
+	boltDB, err := openBoltDB(datafile)
+	if err != nil {
+		return errors.Wrap(err, "could not reopen compacted database")
+	}
+	s.db = boltDB
+	return prometheus.Register(createBoltCollector(s.db))
+}
@@ -0,0 +1,76 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+)
+
+func TestStore_Compact(t *testing.T) {
+	ctx := context.Background()
+	pubKeys := [][fieldparams.BLSPubkeyLength]byte{{1}, {2}}
+	validatorDB := setupDB(t, pubKeys)
+	numEpochs := types.Epoch(2000)
+	for _, pubKey := range pubKeys {
+		signingRoots := make([][32]byte, 0, numEpochs)
+		atts := make([]*ethpb.IndexedAttestation, 0, numEpochs)
+		for epoch := types.Epoch(1); epoch <= numEpochs; epoch++ {
+			signingRoots = append(signingRoots, [32]byte{byte(epoch), byte(epoch >> 8)})
+			atts = append(atts, createAttestation(epoch-1, epoch))
+		}
+		require.NoError(t, validatorDB.SaveAttestationsForPubKey(ctx, pubKey, signingRoots, atts))
+		for _, slot := range []types.Slot{100, 200, 300} {
+			require.NoError(t, validatorDB.SaveProposalHistoryForSlot(ctx, pubKey, slot, []byte{byte(slot)}))
+		}
+	}
+	// The second key signed two attestations at its highest target epoch.
+	require.NoError(t, validatorDB.SaveAttestationForPubKey(ctx, pubKeys[1], [32]byte{}, createAttestation(numEpochs-2, numEpochs)))
+
+	sizeBefore, err := validatorDB.Size()
+	require.NoError(t, err)
+	require.NoError(t, validatorDB.Compact(ctx))
+	require.Equal(t, true, validatorDB.WatermarkOnly())
+	sizeAfter, err := validatorDB.Size()
+	require.NoError(t, err)
+	assert.Equal(t, true, sizeAfter < sizeBefore, "database size %d not reduced from %d", sizeAfter, sizeBefore)
+
+	for i, pubKey := range pubKeys {
+		history, err := validatorDB.AttestationHistoryForPubKey(ctx, pubKey)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(history))
+		assert.Equal(t, numEpochs-1, history[0].Source)
+		assert.Equal(t, numEpochs, history[0].Target)
+		if i == 0 {
+			assert.Equal(t, [32]byte{byte(numEpochs), byte(numEpochs >> 8)}, history[0].SigningRoot)
+		} else {
+			assert.Equal(t, [32]byte{}, history[0].SigningRoot)
+		}
+		lowestSource, _, err := validatorDB.LowestSignedSourceEpoch(ctx, pubKey)
+		require.NoError(t, err)
+		assert.Equal(t, types.Epoch(0), lowestSource)
+
+		proposals, err := validatorDB.ProposalHistoryForPubKey(ctx, pubKey)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(proposals))
+		assert.Equal(t, types.Slot(300), proposals[0].Slot)
+
+		// Attestations are only signed above the watermarks.
+		slashable, err := validatorDB.CheckSlashableAttestation(ctx, pubKey, [32]byte{1}, createAttestation(10, 11))
+		require.NotNil(t, err)
+		assert.Equal(t, BelowWatermark, slashable)
+		slashable, err = validatorDB.CheckSlashableAttestation(ctx, pubKey, [32]byte{1}, createAttestation(numEpochs, numEpochs+1))
+		require.NoError(t, err)
+		assert.Equal(t, NotSlashable, slashable)
+	}
+
+	// The compacted database can still be written to.
+	require.NoError(t, validatorDB.SaveAttestationForPubKey(ctx, pubKeys[0], [32]byte{1}, createAttestation(numEpochs, numEpochs+1)))
+	root, err := validatorDB.SigningRootAtTargetEpoch(ctx, pubKeys[0], numEpochs+1)
+	require.NoError(t, err)
+	assert.Equal(t, [32]byte{1}, root)
+}
@@ -67,6 +67,7 @@ type Store struct {
 	batchedAttestationsChan            chan *AttestationRecord
 	batchAttestationsFlushedFeed       *event.Feed
 	batchedAttestationsFlushInProgress abool.AtomicBool
+	watermarkOnly                      bool
 }
 
 // Close closes the underlying boltdb database.
@@ -118,15 +119,8 @@ func NewKVStore(ctx context.Context, dirPath string, config *Config) (*Store, er
 			return nil, err
 		}
 	}
-	datafile := filepath.Join(dirPath, ProtectionDbFileName)
-	boltDB, err := bolt.Open(datafile, params.BeaconIoConfig().ReadWritePermissions, &bolt.Options{
-		Timeout:         params.BeaconIoConfig().BoltTimeout,
-		InitialMmapSize: mmapSize,
-	})
+	boltDB, err := openBoltDB(filepath.Join(dirPath, ProtectionDbFileName))
 	if err != nil {
-		if errors.Is(err, bolt.ErrTimeout) {
-			return nil, errors.New("cannot obtain database lock, database may be in use by another process")
-		}
 		return nil, err
 	}
 
@@ -152,11 +146,18 @@ func NewKVStore(ctx context.Context, dirPath string, config *Config) (*Store, er
 			pubKeysBucket,
 			migrationsBucket,
 			graffitiBucket,
+			storageModeBucket,
 		)
 	}); err != nil {
 		return nil, err
 	}
 
+	// Once enabled, the watermark-only storage mode is persisted, as the
+	// slashing protection history it drops cannot be recovered.
+	if err := kv.initStorageMode(features.Get().EnableSlashingProtectionWatermarks); err != nil {
+		return nil, errors.Wrap(err, "could not initialize storage mode")
+	}
+
 	// Initialize the required public keys into the DB to ensure they're not empty.
 	if config != nil {
 		if err := kv.UpdatePublicKeysBuckets(config.PubKeys); err != nil {
@@ -178,6 +179,20 @@ func NewKVStore(ctx context.Context, dirPath string, config *Config) (*Store, er
 	return kv, prometheus.Register(createBoltCollector(kv.db))
 }
 
+func openBoltDB(datafile string) (*bolt.DB, error) {
+	boltDB, err := bolt.Open(datafile, params.BeaconIoConfig().ReadWritePermissions, &bolt.Options{
+		Timeout:         params.BeaconIoConfig().BoltTimeout,
+		InitialMmapSize: mmapSize,
+	})
+	if err != nil {
+		if errors.Is(err, bolt.ErrTimeout) {
+			return nil, errors.New("cannot obtain database lock, database may be in use by another process")
+		}
+		return nil, err
+	}
+	return boltDB, nil
+}
+
 // UpdatePublicKeysBuckets for a specified list of keys.
 func (s *Store) UpdatePublicKeysBuckets(pubKeys [][fieldparams.BLSPubkeyLength]byte) error {
 	return s.update(func(tx *bolt.Tx) error {
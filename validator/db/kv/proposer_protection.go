@@ -46,6 +46,8 @@ func (s *Store) ProposedPublicKeys(ctx context.Context) ([][fieldparams.BLSPubke
 // ProposalHistoryForSlot accepts a validator public key and returns the corresponding signing root as well
 // as a boolean that tells us if we have a proposal history stored at the slot. It is possible we have proposed
 // a slot but stored a nil signing root, so the boolean helps give full information.
+// In the watermark-only storage mode, a proposal with an empty signing root is reported for every slot below
+// the last signed proposal, as the history of those slots is not kept.
 func (s *Store) ProposalHistoryForSlot(ctx context.Context, publicKey [fieldparams.BLSPubkeyLength]byte, slot types.Slot) ([32]byte, bool, error) {
 	ctx, span := trace.StartSpan(ctx, "Validator.ProposalHistoryForSlot")
 	defer span.End()
@@ -61,6 +63,11 @@ func (s *Store) ProposalHistoryForSlot(ctx context.Context, publicKey [fieldpara
 		}
 		signingRootBytes := valBucket.Get(bytesutil.SlotToBytesBigEndian(slot))
 		if signingRootBytes == nil {
+			if s.watermarkOnly {
+				highestSignedProposalBytes := tx.Bucket(highestSignedProposalsBucket).Get(publicKey[:])
+				proposalExists = len(highestSignedProposalBytes) >= 8 &&
+					slot < bytesutil.BytesToSlotBigEndian(highestSignedProposalBytes)
+			}
 			return nil
 		}
 		proposalExists = true
@@ -134,11 +141,16 @@ func (s *Store) SaveProposalHistoryForSlot(ctx context.Context, pubKey [fieldpar
 			if err := highestSignedBkt.Put(pubKey[:], bytesutil.SlotToBytesBigEndian(slot)); err != nil {
 				return err
 			}
+			highestSignedProposalSlot = slot
 		}
 
 		if err := valBucket.Put(bytesutil.SlotToBytesBigEndian(slot), signingRoot); err != nil {
 			return err
 		}
+		// Only the last signed proposal is kept in the watermark-only storage mode.
+		if s.watermarkOnly {
+			return pruneProposalHistoryToSlot(valBucket, highestSignedProposalSlot)
+		}
 		return pruneProposalHistoryBySlot(valBucket, slot)
 	})
 	return err
@@ -190,6 +202,27 @@ func (s *Store) HighestSignedProposal(ctx context.Context, publicKey [fieldparam
 	return highestSignedProposalSlot, exists, err
 }
 
+// RaiseLowestSignedProposal raises the lowest signed proposal slot for a validator public key to the
+// given slot, unless it is already higher. Blocks with a slot lower than or equal to the lowest signed
+// proposal slot are refused, so this is used to carry over the watermark of another slashing protection
+// history without its individual proposals.
+func (s *Store) RaiseLowestSignedProposal(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, slot types.Slot) error {
+	ctx, span := trace.StartSpan(ctx, "Validator.RaiseLowestSignedProposal")
+	defer span.End()
+
+	return s.update(func(tx *bolt.Tx) error {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		bucket := tx.Bucket(lowestSignedProposalsBucket)
+		lowestSignedProposalBytes := bucket.Get(pubKey[:])
+		if len(lowestSignedProposalBytes) >= 8 && bytesutil.BytesToSlotBigEndian(lowestSignedProposalBytes) >= slot {
+			return nil
+		}
+		return bucket.Put(pubKey[:], bytesutil.SlotToBytesBigEndian(slot))
+	})
+}
+
 func pruneProposalHistoryBySlot(valBucket *bolt.Bucket, newestSlot types.Slot) error {
 	c := valBucket.Cursor()
 	for k, _ := c.First(); k != nil; k, _ = c.First() {
@@ -282,3 +282,31 @@ func TestStore_HighestSignedProposal(t *testing.T) {
 	require.Equal(t, true, exists)
 	assert.Equal(t, types.Slot(3), slot)
 }
+
+func TestStore_RaiseLowestSignedProposal(t *testing.T) {
+	ctx := context.Background()
+	pubkey := [fieldparams.BLSPubkeyLength]byte{3}
+	dummySigningRoot := [32]byte{}
+	validatorDB := setupDB(t, [][fieldparams.BLSPubkeyLength]byte{pubkey})
+
+	// The watermark is set when there is no proposal history.
+	require.NoError(t, validatorDB.RaiseLowestSignedProposal(ctx, pubkey, 5))
+	slot, exists, err := validatorDB.LowestSignedProposal(ctx, pubkey)
+	require.NoError(t, err)
+	require.Equal(t, true, exists)
+	assert.Equal(t, types.Slot(5), slot)
+
+	// The watermark is never lowered.
+	require.NoError(t, validatorDB.RaiseLowestSignedProposal(ctx, pubkey, 4))
+	slot, _, err = validatorDB.LowestSignedProposal(ctx, pubkey)
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(5), slot)
+
+	// The watermark is raised above existing proposals.
+	err = validatorDB.SaveProposalHistoryForSlot(ctx, pubkey, 6 /* slot */, dummySigningRoot[:])
+	require.NoError(t, err)
+	require.NoError(t, validatorDB.RaiseLowestSignedProposal(ctx, pubkey, 10))
+	slot, _, err = validatorDB.LowestSignedProposal(ctx, pubkey)
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(10), slot)
+}
@@ -37,4 +37,8 @@ var (
 	// Graffiti ordered index and hash keys
 	graffitiOrderedIndexKey = []byte("graffiti-ordered-index")
 	graffitiFileHashKey     = []byte("graffiti-file-hash")
+
+	// Storage mode of the slashing protection history.
+	storageModeBucket = []byte("storage-mode")
+	watermarkOnlyKey  = []byte("watermark-only")
 )
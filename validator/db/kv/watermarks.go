@@ -0,0 +1,177 @@
+package kv
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1/slashings"
+	bolt "go.etcd.io/bbolt"
+)
+
+var belowWatermarkMessage = "attestation with (source %d, target %d) is below the highest signed (source %d, target %d)"
+
+// WatermarkOnly returns true if the database keeps only the signing watermarks and the last
+// signed attestation and proposal per validator public key, instead of the full slashing
+// protection history.
+func (s *Store) WatermarkOnly() bool {
+	return s.watermarkOnly
+}
+
+// Loads the persisted storage mode of the database, switching to the watermark-only
+// storage mode first if requested.
+func (s *Store) initStorageMode(watermarkOnly bool) error {
+	return s.update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(storageModeBucket)
+		if watermarkOnly {
+			if err := bkt.Put(watermarkOnlyKey, []byte{1}); err != nil {
+				return err
+			}
+		}
+		enabled := bkt.Get(watermarkOnlyKey)
+		s.watermarkOnly = len(enabled) == 1 && enabled[0] == 1
+		return nil
+	})
+}
+
+// Returns the highest signed source and target epochs in the attesting history of a public key.
+func highestSignedEpochs(pkBucket *bolt.Bucket) (types.Epoch, types.Epoch, bool) {
+	sourceEpochsBucket := pkBucket.Bucket(attestationSourceEpochsBucket)
+	targetEpochsBucket := pkBucket.Bucket(attestationTargetEpochsBucket)
+	if sourceEpochsBucket == nil || targetEpochsBucket == nil {
+		return 0, 0, false
+	}
+	sourceBytes, _ := sourceEpochsBucket.Cursor().Last()
+	targetBytes, _ := targetEpochsBucket.Cursor().Last()
+	if sourceBytes == nil || targetBytes == nil {
+		return 0, 0, false
+	}
+	return bytesutil.BytesToEpochBigEndian(sourceBytes), bytesutil.BytesToEpochBigEndian(targetBytes), true
+}
+
+// Returns the signing root stored for a target epoch, or the zero hash if there is none.
+func signingRootAtTarget(pkBucket *bolt.Bucket, target types.Epoch) [32]byte {
+	var signingRoot [32]byte
+	signingRootsBucket := pkBucket.Bucket(attestationSigningRootsBucket)
+	if signingRootsBucket == nil {
+		return signingRoot
+	}
+	copy(signingRoot[:], signingRootsBucket.Get(bytesutil.EpochToBytesBigEndian(target)))
+	return signingRoot
+}
+
+// In the watermark-only storage mode, an attestation is only safe to sign if its source epoch
+// is at least the highest signed source epoch and its target epoch is above the highest signed
+// target epoch. Such an attestation can neither surround nor be surrounded by any attestation
+// signed before. An attestation at the highest signed target epoch is only allowed if it has the
+// signing root of the last signed attestation.
+func checkAttestationWatermarks(
+	pkBucket *bolt.Bucket, signingRoot [32]byte, att *ethpb.IndexedAttestation,
+) (SlashingKind, error) {
+	source, target, exists := highestSignedEpochs(pkBucket)
+	if !exists {
+		return NotSlashable, nil
+	}
+	if att.Data.Target.Epoch == target {
+		existing := signingRootAtTarget(pkBucket, target)
+		if slashings.SigningRootsDiffer(existing, signingRoot) {
+			return DoubleVote, fmt.Errorf(doubleVoteMessage, target, existing)
+		}
+		return NotSlashable, nil
+	}
+	if att.Data.Target.Epoch < target || att.Data.Source.Epoch < source {
+		return BelowWatermark, fmt.Errorf(
+			belowWatermarkMessage,
+			att.Data.Source.Epoch,
+			att.Data.Target.Epoch,
+			source,
+			target,
+		)
+	}
+	return NotSlashable, nil
+}
+
+// Records an attestation in the watermark-only storage mode. Only the attestation with the highest
+// signed source and target epochs is kept. If an attestation conflicts with the recorded one, the
+// highest epochs of both are kept without a signing root, so that nothing can be signed at or
+// below them anymore.
+func saveAttestationWatermarks(pkBucket *bolt.Bucket, att *AttestationRecord) error {
+	source, target, exists := highestSignedEpochs(pkBucket)
+	switch {
+	case !exists, att.Target > target && att.Source >= source:
+		return writeAttestationWatermarks(pkBucket, att.Source, att.Target, att.SigningRoot)
+	case att.Target < target && att.Source <= source:
+		return nil
+	case att.Target == target && att.Source == source &&
+		!slashings.SigningRootsDiffer(signingRootAtTarget(pkBucket, target), att.SigningRoot):
+		return nil
+	}
+	if att.Source > source {
+		source = att.Source
+	}
+	if att.Target > target {
+		target = att.Target
+	}
+	return writeAttestationWatermarks(pkBucket, source, target, [32]byte{})
+}
+
+// Replaces the attesting history of a public key with a single attestation.
+func writeAttestationWatermarks(pkBucket *bolt.Bucket, source, target types.Epoch, signingRoot [32]byte) error {
+	for _, name := range [][]byte{
+		attestationSigningRootsBucket, attestationSourceEpochsBucket, attestationTargetEpochsBucket,
+	} {
+		if pkBucket.Bucket(name) == nil {
+			continue
+		}
+		if err := pkBucket.DeleteBucket(name); err != nil {
+			return errors.Wrapf(err, "could not delete bucket %s", name)
+		}
+	}
+	sourceEpochBytes := bytesutil.EpochToBytesBigEndian(source)
+	targetEpochBytes := bytesutil.EpochToBytesBigEndian(target)
+	signingRootsBucket, err := pkBucket.CreateBucket(attestationSigningRootsBucket)
+	if err != nil {
+		return errors.Wrap(err, "could not create signing roots bucket")
+	}
+	if err := signingRootsBucket.Put(targetEpochBytes, signingRoot[:]); err != nil {
+		return errors.Wrapf(err, "could not save signing root for epoch %d", target)
+	}
+	sourceEpochsBucket, err := pkBucket.CreateBucket(attestationSourceEpochsBucket)
+	if err != nil {
+		return errors.Wrap(err, "could not create source epochs bucket")
+	}
+	if err := sourceEpochsBucket.Put(sourceEpochBytes, targetEpochBytes); err != nil {
+		return errors.Wrapf(err, "could not save source epoch %d for epoch %d", source, target)
+	}
+	targetEpochsBucket, err := pkBucket.CreateBucket(attestationTargetEpochsBucket)
+	if err != nil {
+		return errors.Wrap(err, "could not create target epochs bucket")
+	}
+	if err := targetEpochsBucket.Put(targetEpochBytes, sourceEpochBytes); err != nil {
+		return errors.Wrapf(err, "could not save target epoch %d for epoch %d", target, source)
+	}
+	return nil
+}
+
+// Deletes every proposal of a public key except the one at the given slot.
+func pruneProposalHistoryToSlot(valBucket *bolt.Bucket, slot types.Slot) error {
+	keep := bytesutil.SlotToBytesBigEndian(slot)
+	var prunable [][]byte
+	if err := valBucket.ForEach(func(k, _ []byte) error {
+		if !bytes.Equal(k, keep) {
+			prunable = append(prunable, append([]byte{}, k...))
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	for _, k := range prunable {
+		if err := valBucket.Delete(k); err != nil {
+			return errors.Wrapf(err, "could not prune slot %d in proposal history", bytesutil.BytesToSlotBigEndian(k))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,144 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v3/config/features"
+	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+)
+
+func setupWatermarkOnlyDB(t testing.TB, pubkeys [][fieldparams.BLSPubkeyLength]byte) *Store {
+	resetCfg := features.InitWithReset(&features.Flags{
+		EnableSlashingProtectionWatermarks: true,
+	})
+	defer resetCfg()
+	validatorDB := setupDB(t, pubkeys)
+	require.Equal(t, true, validatorDB.WatermarkOnly())
+	return validatorDB
+}
+
+func TestStore_WatermarkOnly_Persisted(t *testing.T) {
+	ctx := context.Background()
+	resetCfg := features.InitWithReset(&features.Flags{
+		EnableSlashingProtectionWatermarks: true,
+	})
+	dir := t.TempDir()
+	validatorDB, err := NewKVStore(ctx, dir, &Config{})
+	require.NoError(t, err)
+	require.Equal(t, true, validatorDB.WatermarkOnly())
+	require.NoError(t, validatorDB.Close())
+	resetCfg()
+
+	// The storage mode is kept without the feature flag.
+	validatorDB, err = NewKVStore(ctx, dir, &Config{})
+	require.NoError(t, err)
+	assert.Equal(t, true, validatorDB.WatermarkOnly())
+	require.NoError(t, validatorDB.Close())
+}
+
+func TestStore_WatermarkOnly_CheckSlashableAttestation(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [fieldparams.BLSPubkeyLength]byte{1}
+	validatorDB := setupWatermarkOnlyDB(t, [][fieldparams.BLSPubkeyLength]byte{pubKey})
+
+	for epoch := types.Epoch(1); epoch <= 10; epoch++ {
+		require.NoError(t, validatorDB.SaveAttestationForPubKey(ctx, pubKey, [32]byte{byte(epoch)}, createAttestation(epoch-1, epoch)))
+	}
+	// Only the last attestation is kept.
+	history, err := validatorDB.AttestationHistoryForPubKey(ctx, pubKey)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(history))
+	assert.Equal(t, types.Epoch(9), history[0].Source)
+	assert.Equal(t, types.Epoch(10), history[0].Target)
+	assert.Equal(t, [32]byte{10}, history[0].SigningRoot)
+	lowestTarget, _, err := validatorDB.LowestSignedTargetEpoch(ctx, pubKey)
+	require.NoError(t, err)
+	assert.Equal(t, types.Epoch(1), lowestTarget)
+
+	tests := []struct {
+		name        string
+		signingRoot [32]byte
+		att         *AttestationRecord
+		want        SlashingKind
+	}{
+		{name: "same attestation", signingRoot: [32]byte{10}, att: &AttestationRecord{Source: 9, Target: 10}, want: NotSlashable},
+		{name: "double vote", signingRoot: [32]byte{11}, att: &AttestationRecord{Source: 9, Target: 10}, want: DoubleVote},
+		{name: "next attestation", signingRoot: [32]byte{11}, att: &AttestationRecord{Source: 10, Target: 11}, want: NotSlashable},
+		{name: "same source", signingRoot: [32]byte{11}, att: &AttestationRecord{Source: 9, Target: 12}, want: NotSlashable},
+		{name: "surrounding", signingRoot: [32]byte{11}, att: &AttestationRecord{Source: 8, Target: 11}, want: BelowWatermark},
+		// Not slashable against the full history, but it can no longer be checked.
+		{name: "below target", signingRoot: [32]byte{11}, att: &AttestationRecord{Source: 9, Target: 9}, want: BelowWatermark},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			slashable, err := validatorDB.CheckSlashableAttestation(ctx, pubKey, tt.signingRoot, createAttestation(tt.att.Source, tt.att.Target))
+			assert.Equal(t, tt.want, slashable)
+			if tt.want == NotSlashable {
+				require.NoError(t, err)
+			} else {
+				require.NotNil(t, err)
+			}
+		})
+	}
+}
+
+func TestStore_WatermarkOnly_ConflictingAttestations(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [fieldparams.BLSPubkeyLength]byte{1}
+	validatorDB := setupWatermarkOnlyDB(t, [][fieldparams.BLSPubkeyLength]byte{pubKey})
+
+	// An older attestation, as from an import, does not change the last signed attestation.
+	require.NoError(t, validatorDB.SaveAttestationsForPubKey(ctx, pubKey, [][32]byte{{1}, {2}}, []*ethpb.IndexedAttestation{
+		createAttestation(5, 6),
+		createAttestation(1, 2),
+	}))
+	root, err := validatorDB.SigningRootAtTargetEpoch(ctx, pubKey, 6)
+	require.NoError(t, err)
+	assert.Equal(t, [32]byte{1}, root)
+
+	// A conflicting attestation keeps the highest epochs of both without a signing root.
+	require.NoError(t, validatorDB.SaveAttestationsForPubKey(ctx, pubKey, [][32]byte{{3}}, []*ethpb.IndexedAttestation{
+		createAttestation(7, 5),
+	}))
+	history, err := validatorDB.AttestationHistoryForPubKey(ctx, pubKey)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(history))
+	assert.Equal(t, types.Epoch(7), history[0].Source)
+	assert.Equal(t, types.Epoch(6), history[0].Target)
+	assert.Equal(t, [32]byte{}, history[0].SigningRoot)
+	slashable, err := validatorDB.CheckSlashableAttestation(ctx, pubKey, [32]byte{1}, createAttestation(5, 6))
+	require.NotNil(t, err)
+	assert.Equal(t, DoubleVote, slashable)
+}
+
+func TestStore_WatermarkOnly_ProposalHistory(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [fieldparams.BLSPubkeyLength]byte{1}
+	validatorDB := setupWatermarkOnlyDB(t, [][fieldparams.BLSPubkeyLength]byte{pubKey})
+
+	for _, slot := range []types.Slot{10, 20, 30} {
+		require.NoError(t, validatorDB.SaveProposalHistoryForSlot(ctx, pubKey, slot, []byte{byte(slot)}))
+	}
+	proposals, err := validatorDB.ProposalHistoryForPubKey(ctx, pubKey)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(proposals))
+	assert.Equal(t, types.Slot(30), proposals[0].Slot)
+
+	// Slots below the last signed proposal are reported as proposed without a signing root.
+	root, exists, err := validatorDB.ProposalHistoryForSlot(ctx, pubKey, 25)
+	require.NoError(t, err)
+	assert.Equal(t, true, exists)
+	assert.Equal(t, [32]byte{}, root)
+	root, exists, err = validatorDB.ProposalHistoryForSlot(ctx, pubKey, 30)
+	require.NoError(t, err)
+	assert.Equal(t, true, exists)
+	assert.Equal(t, [32]byte{30}, root)
+	_, exists, err = validatorDB.ProposalHistoryForSlot(ctx, pubKey, 31)
+	require.NoError(t, err)
+	assert.Equal(t, false, exists)
+}
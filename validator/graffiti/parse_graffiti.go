@@ -5,6 +5,7 @@ import (
 	"os"
 	"strings"
 
+	"github.com/pkg/errors"
 	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v3/crypto/hash"
 	"gopkg.in/yaml.v2"
@@ -54,9 +55,26 @@ func ParseGraffitiFile(f string) (*Graffiti, error) {
 	g.Default = ParseHexGraffiti(g.Default)
 	g.Hash = hash.Hash(yamlFile)
 
+	for _, graffiti := range g.all() {
+		if err := ValidateTemplate(graffiti); err != nil {
+			return nil, errors.Wrapf(err, "invalid graffiti %q", graffiti)
+		}
+	}
+
 	return g, nil
 }
 
+// Returns every graffiti of the file.
+func (g *Graffiti) all() []string {
+	all := []string{g.Default}
+	all = append(all, g.Ordered...)
+	all = append(all, g.Random...)
+	for _, graffiti := range g.Specific {
+		all = append(all, graffiti)
+	}
+	return all
+}
+
 // ParseHexGraffiti checks if a graffiti input is being represented in hex and converts it to ASCII if so
 func ParseHexGraffiti(rawGraffiti string) string {
 	splitGraffiti := strings.SplitN(rawGraffiti, ":", 2)
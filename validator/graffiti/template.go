@@ -0,0 +1,98 @@
+package graffiti
+
+import (
+	"bytes"
+	"strings"
+	"text/template"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+)
+
+// graffitiLength is the maximum length in bytes of the graffiti of a block.
+const graffitiLength = 32
+
+// TemplateFields are the fields of a proposal which can be used in a graffiti template,
+// for example "{{.CL}}-{{.EL}} #{{.Index}}".
+type TemplateFields struct {
+	// Index of the proposing validator.
+	Index types.ValidatorIndex
+	// PubKey is the hex prefix of the public key of the proposing validator.
+	PubKey string
+	// Slot and Epoch of the proposal.
+	Slot  types.Slot
+	Epoch types.Epoch
+	// CL and CLVersion are the name and version of the beacon node.
+	CL        string
+	CLVersion string
+	// EL and ELVersion are the name and version of the execution client of the beacon node.
+	EL        string
+	ELVersion string
+}
+
+var templateFuncs = template.FuncMap{
+	"lower": strings.ToLower,
+	"upper": strings.ToUpper,
+	// trunc shortens a string to at most n bytes, such as {{trunc 2 .EL}}.
+	"trunc": func(n int, s string) string {
+		if n < 0 {
+			return s
+		}
+		return truncate(s, n)
+	},
+}
+
+// truncate shortens a string to at most n bytes without splitting a multi-byte character.
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n]
+}
+
+// IsTemplate returns true if a graffiti contains template expressions.
+func IsTemplate(graffiti string) bool {
+	return strings.Contains(graffiti, "{{")
+}
+
+// ValidateTemplate checks that a graffiti template can be parsed.
+func ValidateTemplate(graffiti string) error {
+	_, err := parseTemplate(graffiti)
+	return err
+}
+
+// ExecuteTemplate renders a graffiti template with the fields of a proposal. The result is
+// truncated to the length of the graffiti of a block.
+func ExecuteTemplate(graffiti string, fields *TemplateFields) (string, error) {
+	tmpl, err := parseTemplate(graffiti)
+	if err != nil {
+		return "", err
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, fields); err != nil {
+		return "", errors.Wrap(err, "could not execute graffiti template")
+	}
+	return truncate(buf.String(), graffitiLength), nil
+}
+
+// ParseClientVersion splits a client version in the <name>/<version>/... format, such as
+// Prysm/v3.1.2/3b6b5d7 or Geth/v1.10.26-stable/linux-amd64/go1.18.5, into its name and version.
+func ParseClientVersion(clientVersion string) (string, string) {
+	parts := strings.SplitN(clientVersion, "/", 3)
+	if len(parts) < 2 {
+		return clientVersion, ""
+	}
+	return parts[0], parts[1]
+}
+
+func parseTemplate(graffiti string) (*template.Template, error) {
+	tmpl, err := template.New("graffiti").Funcs(templateFuncs).Parse(graffiti)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not parse graffiti template")
+	}
+	return tmpl, nil
+}
@@ -0,0 +1,100 @@
+package graffiti
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+)
+
+func TestIsTemplate(t *testing.T) {
+	assert.Equal(t, true, IsTemplate("{{.CL}} #{{.Index}}"))
+	assert.Equal(t, false, IsTemplate("Mr T was here"))
+}
+
+func TestValidateTemplate(t *testing.T) {
+	require.NoError(t, ValidateTemplate("{{upper .EL}}-{{trunc 4 .PubKey}}"))
+	require.ErrorContains(t, "could not parse graffiti template", ValidateTemplate("{{.CL"))
+	require.ErrorContains(t, "could not parse graffiti template", ValidateTemplate("{{unknown .CL}}"))
+}
+
+func TestExecuteTemplate(t *testing.T) {
+	fields := &TemplateFields{
+		Index:     12345,
+		PubKey:    "0xaf2e7ba2",
+		Slot:      64,
+		Epoch:     2,
+		CL:        "Prysm",
+		CLVersion: "v3.1.2",
+		EL:        "Geth",
+		ELVersion: "v1.10.26-stable",
+	}
+	tests := []struct {
+		name     string
+		template string
+		want     string
+	}{
+		{
+			name:     "plain graffiti",
+			template: "Mr T was here",
+			want:     "Mr T was here",
+		},
+		{
+			name:     "client names and index",
+			template: "{{.CL}}/{{.EL}} #{{.Index}}",
+			want:     "Prysm/Geth #12345",
+		},
+		{
+			name:     "functions",
+			template: "{{lower .CL}}{{upper (trunc 2 .EL)}} {{.PubKey}} e{{.Epoch}}",
+			want:     "prysmGE 0xaf2e7ba2 e2",
+		},
+		{
+			name:     "truncated to 32 bytes",
+			template: "{{.CL}} {{.CLVersion}} {{.EL}} {{.ELVersion}} {{.Slot}}",
+			want:     "Prysm v3.1.2 Geth v1.10.26-stabl",
+		},
+		{
+			name:     "truncated on a character boundary",
+			template: "{{.CL}} 🦄🦄🦄🦄🦄🦄🦄",
+			want:     "Prysm 🦄🦄🦄🦄🦄🦄",
+		},
+		{
+			name:     "trunc on a character boundary",
+			template: "{{trunc 2 \"é\"}}{{trunc 3 \"éé\"}}",
+			want:     "éé",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ExecuteTemplate(tt.template, fields)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	got, err := ExecuteTemplate(strings.Repeat("a", 40), fields)
+	require.NoError(t, err)
+	assert.Equal(t, 32, len(got))
+
+	got, err = ExecuteTemplate("a"+strings.Repeat("é", 20), fields)
+	require.NoError(t, err)
+	assert.Equal(t, true, utf8.ValidString(got))
+	assert.Equal(t, 31, len(got))
+}
+
+func TestParseClientVersion(t *testing.T) {
+	name, version := ParseClientVersion("Geth/v1.10.26-stable/linux-amd64/go1.18.5")
+	assert.Equal(t, "Geth", name)
+	assert.Equal(t, "v1.10.26-stable", version)
+
+	name, version = ParseClientVersion("Prysm/v3.1.2/3b6b5d7ee8a0ab27f1c7ddb0dd2a6e1d6b2d4c8e")
+	assert.Equal(t, "Prysm", name)
+	assert.Equal(t, "v3.1.2", version)
+
+	name, version = ParseClientVersion("Nethermind")
+	assert.Equal(t, "Nethermind", name)
+	assert.Equal(t, "", version)
+}
@@ -24,6 +24,9 @@ const (
 	// keys for Prysm Ethereum validators. According to EIP-2334, the format is as follows:
 	// m / purpose / coin_type / account_index / withdrawal_key / validating_key
 	ValidatingKeyDerivationPathTemplate = "m/12381/3600/%d/0/0"
+	// WithdrawalKeyDerivationPathTemplate defining the hierarchical path for the BLS withdrawal
+	// keys of Prysm Ethereum validators, the parent of their validating keys according to EIP-2334.
+	WithdrawalKeyDerivationPathTemplate = "m/12381/3600/%d/0"
 )
 
 // SetupConfig includes configuration values for initializing
@@ -80,6 +83,29 @@ func (km *Keymanager) RecoverAccountsFromMnemonic(
 	return km.localKM.ImportKeypairs(ctx, privKeys, pubKeys)
 }
 
+// WithdrawalKeysFromMnemonic derives the BLS withdrawal secret keys of numAccounts accounts,
+// starting at account index startIndex, from a mnemonic phrase.
+func WithdrawalKeysFromMnemonic(mnemonic, mnemonicPassphrase string, startIndex, numAccounts int) ([]bls.SecretKey, error) {
+	seed, err := seedFromMnemonic(mnemonic, mnemonicPassphrase)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not derive seed from mnemonic")
+	}
+	keys := make([]bls.SecretKey, numAccounts)
+	for i := 0; i < numAccounts; i++ {
+		privKey, err := util.PrivateKeyFromSeedAndPath(
+			seed, fmt.Sprintf(WithdrawalKeyDerivationPathTemplate, startIndex+i),
+		)
+		if err != nil {
+			return nil, err
+		}
+		keys[i], err = bls.SecretKeyFromBytes(privKey.Marshal())
+		if err != nil {
+			return nil, err
+		}
+	}
+	return keys, nil
+}
+
 // ExtractKeystores retrieves the secret keys for specified public keys
 // in the function input, encrypts them using the specified password,
 // and returns their respective EIP-2335 keystores.
@@ -154,6 +154,22 @@ func TestDerivedKeymanager_FetchValidatingPrivateKeys(t *testing.T) {
 	}
 }
 
+func TestWithdrawalKeysFromMnemonic(t *testing.T) {
+	derivedSeed, err := seedFromMnemonic(constant.TestMnemonic, "")
+	require.NoError(t, err)
+	keys, err := WithdrawalKeysFromMnemonic(constant.TestMnemonic, "", 2, 3)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(keys))
+	for i, key := range keys {
+		privKey, err := util.PrivateKeyFromSeedAndPath(derivedSeed, fmt.Sprintf(WithdrawalKeyDerivationPathTemplate, 2+i))
+		require.NoError(t, err)
+		assert.DeepEqual(t, privKey.Marshal(), key.Marshal())
+	}
+
+	_, err = WithdrawalKeysFromMnemonic("invalid mnemonic", "", 0, 1)
+	require.ErrorContains(t, "could not derive seed from mnemonic", err)
+}
+
 func TestDerivedKeymanager_Sign(t *testing.T) {
 	wallet := &mock.Wallet{
 		Files:            make(map[string]map[string][]byte),
@@ -25,8 +25,18 @@ import (
 
 const (
 	ethApiNamespace = "/api/v1/eth2/sign/"
+
+	// SlashingProtectionHealthCheckID is the identifier of the web3signer health check reporting on
+	// its slashing protection database. The check is absent if slashing protection is disabled.
+	SlashingProtectionHealthCheckID = "slashing-protection-db-health-check"
+	// HealthStatusUp is the status reported by a passing web3signer health check.
+	HealthStatusUp = "UP"
 )
 
+// ErrSlashingProtection is returned when web3signer refuses to sign a message because of its
+// slashing protection rules.
+var ErrSlashingProtection = errors.New("signing operation failed due to slashing protection rules")
+
 type SignRequestJson []byte
 
 // SignatureResponse is the struct representing the signing request response in json format
@@ -34,10 +44,34 @@ type SignatureResponse struct {
 	Signature hexutil.Bytes `json:"signature"`
 }
 
+// HealthCheck is a single check of the web3signer health check response.
+type HealthCheck struct {
+	ID     string `json:"id"`
+	Status string `json:"status"`
+}
+
+// HealthCheckResponse is the struct representing the web3signer health check response in json format.
+type HealthCheckResponse struct {
+	Status  string         `json:"status"`
+	Checks  []*HealthCheck `json:"checks"`
+	Outcome string         `json:"outcome"`
+}
+
+// SlashingProtectionUp returns true if the web3signer slashing protection database is enabled and healthy.
+func (r *HealthCheckResponse) SlashingProtectionUp() bool {
+	for _, c := range r.Checks {
+		if c != nil && c.ID == SlashingProtectionHealthCheckID {
+			return c.Status == HealthStatusUp
+		}
+	}
+	return false
+}
+
 // HttpSignerClient defines the interface for interacting with a remote web3signer.
 type HttpSignerClient interface {
 	Sign(ctx context.Context, pubKey string, request SignRequestJson) (bls.Signature, error)
 	GetPublicKeys(ctx context.Context, url string) ([][48]byte, error)
+	GetHealthCheck(ctx context.Context) (*HealthCheckResponse, error)
 }
 
 // ApiClient a wrapper object around web3signer APIs. Please refer to the docs from Consensys' web3signer project.
@@ -72,7 +106,7 @@ func (client *ApiClient) Sign(ctx context.Context, pubKey string, request SignRe
 		return nil, fmt.Errorf("public key not found")
 	}
 	if resp.StatusCode == http.StatusPreconditionFailed {
-		return nil, fmt.Errorf("signing operation failed due to slashing protection rules,  Signing Request URL: %v, Status: %v", client.BaseURL.String()+requestPath, resp.StatusCode)
+		return nil, errors.Wrapf(ErrSlashingProtection, "Signing Request URL: %v, Status: %v", client.BaseURL.String()+requestPath, resp.StatusCode)
 	}
 	contentType := resp.Header.Get("Content-Type")
 	if strings.HasPrefix(contentType, "application/json") {
@@ -135,6 +169,21 @@ func (client *ApiClient) GetServerStatus(ctx context.Context) (string, error) {
 	return status, nil
 }
 
+// GetHealthCheck is a wrapper method around the web3signer healthcheck api.
+func (client *ApiClient) GetHealthCheck(ctx context.Context) (*HealthCheckResponse, error) {
+	const requestPath = "/healthcheck"
+	resp, err := client.doRequest(ctx, http.MethodGet, client.BaseURL.String()+requestPath, nil /* no body needed on get request */)
+	if err != nil {
+		return nil, err
+	}
+	// A failing health check is reported with a 503 status code, along with the same response body.
+	health := &HealthCheckResponse{}
+	if err := unmarshalResponse(resp.Body, health); err != nil {
+		return nil, err
+	}
+	return health, nil
+}
+
 // doRequest is a utility method for requests.
 func (client *ApiClient) doRequest(ctx context.Context, httpMethod, fullPath string, body io.Reader) (*http.Response, error) {
 	var requestDump []byte
@@ -107,13 +107,14 @@ func TestClient_Sign_412(t *testing.T) {
 		StatusCode: 412,
 		Body:       r,
 	}}
-	u, err := url.Parse("example.com")
+	u, err := url.Parse("http://example.com")
 	assert.NoError(t, err)
 	cl := internal.ApiClient{BaseURL: u, RestClient: &http.Client{Transport: mock}}
 	jsonRequest, err := json.Marshal(`{message: "hello"}`)
 	assert.NoError(t, err)
 	resp, err := cl.Sign(context.Background(), "a2b5aaad9c6efefe7bb9b1243a043404f3362937cfb6b31833929833173f476630ea2cfeb0d9ddf15f97ca8685948820", jsonRequest)
 	assert.NotNil(t, err)
+	assert.ErrorIs(t, err, internal.ErrSlashingProtection)
 	assert.Nil(t, resp)
 
 }
@@ -201,3 +202,45 @@ func TestClient_GetServerStatus_HappyPath(t *testing.T) {
 	assert.NotNil(t, resp)
 	assert.Nil(t, err)
 }
+
+func TestClient_GetHealthCheck(t *testing.T) {
+	tests := []struct {
+		name         string
+		statusCode   int
+		body         string
+		protectionUp bool
+	}{
+		{
+			name:         "slashing protection up",
+			statusCode:   200,
+			body:         `{"status":"UP","checks":[{"id":"disk-space-health-check","status":"UP"},{"id":"slashing-protection-db-health-check","status":"UP"}],"outcome":"UP"}`,
+			protectionUp: true,
+		},
+		{
+			name:         "slashing protection down",
+			statusCode:   503,
+			body:         `{"status":"DOWN","checks":[{"id":"disk-space-health-check","status":"UP"},{"id":"slashing-protection-db-health-check","status":"DOWN"}],"outcome":"DOWN"}`,
+			protectionUp: false,
+		},
+		{
+			name:         "slashing protection disabled",
+			statusCode:   200,
+			body:         `{"status":"UP","checks":[{"id":"disk-space-health-check","status":"UP"}],"outcome":"UP"}`,
+			protectionUp: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := &mockTransport{mockResponse: &http.Response{
+				StatusCode: tt.statusCode,
+				Body:       io.NopCloser(bytes.NewReader([]byte(tt.body))),
+			}}
+			u, err := url.Parse("http://example.com")
+			require.NoError(t, err)
+			cl := internal.ApiClient{BaseURL: u, RestClient: &http.Client{Transport: mock}}
+			resp, err := cl.GetHealthCheck(context.Background())
+			require.NoError(t, err)
+			assert.Equal(t, tt.protectionUp, resp.SlashingProtectionUp())
+		})
+	}
+}
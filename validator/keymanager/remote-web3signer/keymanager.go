@@ -6,6 +6,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"path/filepath"
+	"sync"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/go-playground/validator/v10"
@@ -40,21 +42,45 @@ type SetupConfig struct {
 	// a static list of public keys to be passed by the user to determine what accounts should sign.
 	// This will provide a layer of safety against slashing if the web3signer is shared across validators.
 	ProvidedPublicKeys [][48]byte
+
+	// PublicKeysRefreshInterval defines how often the public keys are polled from PublicKeysURL.
+	// Keys added or removed on the remote server are then published to account change subscribers.
+	// Zero disables polling, in which case the URL is only called once.
+	PublicKeysRefreshInterval time.Duration
+
+	// DelegateSlashingProtection requires the web3signer to enforce its own slashing protection.
+	// Signing is refused while the web3signer does not report a healthy slashing protection database.
+	// The local slashing protection history of the validator client is still checked in addition.
+	DelegateSlashingProtection bool
 }
 
+// slashingProtectionCheckInterval is how often the slashing protection health of the web3signer
+// is checked when slashing protection is delegated to it.
+const slashingProtectionCheckInterval = time.Minute
+
+// ErrSignerSlashingProtectionUnavailable is returned when signing is refused because slashing
+// protection is delegated to the web3signer and it is not enforcing it.
+var ErrSignerSlashingProtectionUnavailable = errors.New("web3signer slashing protection is not enabled or not healthy")
+
 // Keymanager defines the web3signer keymanager.
 type Keymanager struct {
 	client                internal.HttpSignerClient
 	genesisValidatorsRoot []byte
 	publicKeysURL         string
 	providedPublicKeys    [][48]byte
+	addedPublicKeys       [][48]byte // Keys imported through AddPublicKeys, kept when the keys are polled again.
 	accountsChangedFeed   *event.Feed
 	validator             *validator.Validate
 	publicKeysUrlCalled   bool
+	refreshInterval       time.Duration
+	delegateProtection    bool
+	signerProtectionUp    bool
+	signerProtectionCheck time.Time
+	lock                  sync.RWMutex
 }
 
 // NewKeymanager instantiates a new web3signer key manager.
-func NewKeymanager(_ context.Context, cfg *SetupConfig) (*Keymanager, error) {
+func NewKeymanager(ctx context.Context, cfg *SetupConfig) (*Keymanager, error) {
 	if cfg.BaseEndpoint == "" || !bytesutil.IsValidRoot(cfg.GenesisValidatorsRoot) {
 		return nil, fmt.Errorf("invalid setup config, one or more configs are empty: BaseEndpoint: %v, GenesisValidatorsRoot: %#x", cfg.BaseEndpoint, cfg.GenesisValidatorsRoot)
 	}
@@ -62,7 +88,7 @@ func NewKeymanager(_ context.Context, cfg *SetupConfig) (*Keymanager, error) {
 	if err != nil {
 		return nil, errors.Wrap(err, "could not create apiClient")
 	}
-	return &Keymanager{
+	km := &Keymanager{
 		client:                internal.HttpSignerClient(client),
 		genesisValidatorsRoot: cfg.GenesisValidatorsRoot,
 		accountsChangedFeed:   new(event.Feed),
@@ -70,22 +96,156 @@ func NewKeymanager(_ context.Context, cfg *SetupConfig) (*Keymanager, error) {
 		providedPublicKeys:    cfg.ProvidedPublicKeys,
 		validator:             validator.New(),
 		publicKeysUrlCalled:   false,
-	}, nil
+		refreshInterval:       cfg.PublicKeysRefreshInterval,
+		delegateProtection:    cfg.DelegateSlashingProtection,
+	}
+	if km.publicKeysURL != "" && km.refreshInterval > 0 {
+		go km.pollPublicKeys(ctx)
+	}
+	return km, nil
+}
+
+// pollPublicKeys periodically refreshes the public keys from the public keys URL until the context is done.
+func (km *Keymanager) pollPublicKeys(ctx context.Context) {
+	ticker := time.NewTicker(km.refreshInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := km.refreshPublicKeys(ctx); err != nil {
+				log.WithError(err).Error("Could not refresh public keys from web3signer")
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// refreshPublicKeys fetches the public keys from the public keys URL, and notifies account change
+// subscribers if keys were added or removed since the last fetch.
+func (km *Keymanager) refreshPublicKeys(ctx context.Context) error {
+	fetchedKeys, err := km.client.GetPublicKeys(ctx, km.publicKeysURL)
+	if err != nil {
+		erroredResponsesTotal.Inc()
+		return errors.Wrap(err, fmt.Sprintf("could not get public keys from remote server url: %v", km.publicKeysURL))
+	}
+	km.lock.Lock()
+	keys := mergePublicKeys(fetchedKeys, km.addedPublicKeys)
+	added, removed := diffPublicKeys(km.providedPublicKeys, keys)
+	km.publicKeysUrlCalled = true
+	km.providedPublicKeys = keys
+	km.lock.Unlock()
+	if added == 0 && removed == 0 {
+		return nil
+	}
+	log.WithFields(log.Fields{
+		"added":   added,
+		"removed": removed,
+		"total":   len(keys),
+	}).Info("Public keys changed on web3signer")
+	km.accountsChangedFeed.Send(keys)
+	return nil
+}
+
+// mergePublicKeys returns the keys fetched from the public keys URL followed by the keys imported
+// through AddPublicKeys which were not fetched.
+func mergePublicKeys(fetched, added [][fieldparams.BLSPubkeyLength]byte) [][fieldparams.BLSPubkeyLength]byte {
+	keys := make([][fieldparams.BLSPubkeyLength]byte, 0, len(fetched)+len(added))
+	seen := make(map[[fieldparams.BLSPubkeyLength]byte]bool, len(fetched))
+	for _, k := range fetched {
+		seen[k] = true
+		keys = append(keys, k)
+	}
+	for _, k := range added {
+		if !seen[k] {
+			keys = append(keys, k)
+		}
+	}
+	return keys
+}
+
+// diffPublicKeys returns the number of keys in next which are not in prev, and the number of keys in prev
+// which are not in next.
+func diffPublicKeys(prev, next [][fieldparams.BLSPubkeyLength]byte) (added, removed int) {
+	prevSet := make(map[[fieldparams.BLSPubkeyLength]byte]bool, len(prev))
+	for _, k := range prev {
+		prevSet[k] = true
+	}
+	nextSet := make(map[[fieldparams.BLSPubkeyLength]byte]bool, len(next))
+	for _, k := range next {
+		nextSet[k] = true
+		if !prevSet[k] {
+			added++
+		}
+	}
+	for k := range prevSet {
+		if !nextSet[k] {
+			removed++
+		}
+	}
+	return added, removed
+}
+
+// checkSignerSlashingProtection verifies the web3signer reports a healthy slashing protection database.
+// The result of the health check is cached for slashingProtectionCheckInterval.
+func (km *Keymanager) checkSignerSlashingProtection(ctx context.Context) error {
+	km.lock.RLock()
+	up, checkedAt := km.signerProtectionUp, km.signerProtectionCheck
+	km.lock.RUnlock()
+	if time.Since(checkedAt) < slashingProtectionCheckInterval {
+		if !up {
+			return ErrSignerSlashingProtectionUnavailable
+		}
+		return nil
+	}
+	health, err := km.client.GetHealthCheck(ctx)
+	if err != nil {
+		erroredResponsesTotal.Inc()
+		return errors.Wrap(err, "could not get web3signer health check")
+	}
+	up = health.SlashingProtectionUp()
+	km.lock.Lock()
+	if up != km.signerProtectionUp || km.signerProtectionCheck.IsZero() {
+		if up {
+			log.Info("Web3signer slashing protection is enabled and healthy")
+		} else {
+			log.Error("Web3signer slashing protection is not enabled or not healthy, signing is refused until it is")
+		}
+	}
+	km.signerProtectionUp = up
+	km.signerProtectionCheck = time.Now()
+	km.lock.Unlock()
+	if !up {
+		return ErrSignerSlashingProtectionUnavailable
+	}
+	return nil
 }
 
 // FetchValidatingPublicKeys fetches the validating public keys
 // from the remote server or from the provided keys if there are no existing public keys set
 // or provides the existing keys in the keymanager.
 func (km *Keymanager) FetchValidatingPublicKeys(ctx context.Context) ([][fieldparams.BLSPubkeyLength]byte, error) {
-	if km.publicKeysURL != "" && !km.publicKeysUrlCalled {
-		providedPublicKeys, err := km.client.GetPublicKeys(ctx, km.publicKeysURL)
-		if err != nil {
-			erroredResponsesTotal.Inc()
-			return nil, errors.Wrap(err, fmt.Sprintf("could not get public keys from remote server url: %v", km.publicKeysURL))
-		}
-		// makes sure that if the public keys are deleted the validator does not call URL again.
+	km.lock.RLock()
+	urlCalled := km.publicKeysUrlCalled
+	km.lock.RUnlock()
+	if km.publicKeysURL == "" || urlCalled {
+		km.lock.RLock()
+		defer km.lock.RUnlock()
+		return km.providedPublicKeys, nil
+	}
+	// The keys are fetched without holding the lock, so that a slow web3signer does not block signing.
+	providedPublicKeys, err := km.client.GetPublicKeys(ctx, km.publicKeysURL)
+	if err != nil {
+		erroredResponsesTotal.Inc()
+		return nil, errors.Wrap(err, fmt.Sprintf("could not get public keys from remote server url: %v", km.publicKeysURL))
+	}
+	km.lock.Lock()
+	defer km.lock.Unlock()
+	// makes sure that if the public keys are deleted the validator does not call URL again.
+	// The keys may have been polled in the meantime, in which case they are more recent.
+	if !km.publicKeysUrlCalled {
 		km.publicKeysUrlCalled = true
-		km.providedPublicKeys = providedPublicKeys
+		km.providedPublicKeys = mergePublicKeys(providedPublicKeys, km.addedPublicKeys)
 	}
 	return km.providedPublicKeys, nil
 }
@@ -98,9 +258,20 @@ func (km *Keymanager) Sign(ctx context.Context, request *validatorpb.SignRequest
 		return nil, err
 	}
 
+	if km.delegateProtection {
+		if err := km.checkSignerSlashingProtection(ctx); err != nil {
+			erroredResponsesTotal.Inc()
+			return nil, err
+		}
+	}
+
 	signRequestsTotal.Inc()
 
-	return km.client.Sign(ctx, hexutil.Encode(request.PublicKey), signRequest)
+	sig, err := km.client.Sign(ctx, hexutil.Encode(request.PublicKey), signRequest)
+	if errors.Is(err, internal.ErrSlashingProtection) {
+		slashingProtectionRejectionsTotal.Inc()
+	}
+	return sig, err
 }
 
 // getSignRequestJson returns a json request based on the SignRequest type.
@@ -302,6 +473,7 @@ func (km *Keymanager) AddPublicKeys(ctx context.Context, pubKeys [][fieldparams.
 	if ctx == nil {
 		return nil, errors.New("context is nil")
 	}
+	km.lock.Lock()
 	importedRemoteKeysStatuses := make([]*ethpbservice.ImportedRemoteKeysStatus, len(pubKeys))
 	for i, pubKey := range pubKeys {
 		found := false
@@ -319,13 +491,16 @@ func (km *Keymanager) AddPublicKeys(ctx context.Context, pubKeys [][fieldparams.
 			continue
 		}
 		km.providedPublicKeys = append(km.providedPublicKeys, pubKey)
+		km.addedPublicKeys = append(km.addedPublicKeys, pubKey)
 		importedRemoteKeysStatuses[i] = &ethpbservice.ImportedRemoteKeysStatus{
 			Status:  ethpbservice.ImportedRemoteKeysStatus_IMPORTED,
 			Message: fmt.Sprintf("Successfully added pubkey: %v", hexutil.Encode(pubKey[:])),
 		}
 		log.Debug("Added pubkey to keymanager for web3signer", "pubkey", hexutil.Encode(pubKey[:]))
 	}
-	km.accountsChangedFeed.Send(km.providedPublicKeys)
+	keys := km.providedPublicKeys
+	km.lock.Unlock()
+	km.accountsChangedFeed.Send(keys)
 	return importedRemoteKeysStatuses, nil
 }
 
@@ -334,6 +509,7 @@ func (km *Keymanager) DeletePublicKeys(ctx context.Context, pubKeys [][fieldpara
 	if ctx == nil {
 		return nil, errors.New("context is nil")
 	}
+	km.lock.Lock()
 	deletedRemoteKeysStatuses := make([]*ethpbservice.DeletedRemoteKeysStatus, len(pubKeys))
 	if len(km.providedPublicKeys) == 0 {
 		for i := range deletedRemoteKeysStatuses {
@@ -342,12 +518,14 @@ func (km *Keymanager) DeletePublicKeys(ctx context.Context, pubKeys [][fieldpara
 				Message: "No pubkeys are set in validator",
 			}
 		}
+		km.lock.Unlock()
 		return deletedRemoteKeysStatuses, nil
 	}
 	for i, pubkey := range pubKeys {
 		for in, key := range km.providedPublicKeys {
 			if bytes.Equal(key[:], pubkey[:]) {
 				km.providedPublicKeys = append(km.providedPublicKeys[:in], km.providedPublicKeys[in+1:]...)
+				km.addedPublicKeys = removePublicKey(km.addedPublicKeys, pubkey)
 				deletedRemoteKeysStatuses[i] = &ethpbservice.DeletedRemoteKeysStatus{
 					Status:  ethpbservice.DeletedRemoteKeysStatus_DELETED,
 					Message: fmt.Sprintf("Successfully deleted pubkey: %v", hexutil.Encode(pubkey[:])),
@@ -363,6 +541,19 @@ func (km *Keymanager) DeletePublicKeys(ctx context.Context, pubKeys [][fieldpara
 			}
 		}
 	}
-	km.accountsChangedFeed.Send(km.providedPublicKeys)
+	keys := km.providedPublicKeys
+	km.lock.Unlock()
+	km.accountsChangedFeed.Send(keys)
 	return deletedRemoteKeysStatuses, nil
 }
+
+// removePublicKey returns the keys without the given key.
+func removePublicKey(keys [][fieldparams.BLSPubkeyLength]byte, key [fieldparams.BLSPubkeyLength]byte) [][fieldparams.BLSPubkeyLength]byte {
+	filtered := make([][fieldparams.BLSPubkeyLength]byte, 0, len(keys))
+	for _, k := range keys {
+		if k != key {
+			filtered = append(filtered, k)
+		}
+	}
+	return filtered
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
@@ -23,6 +24,9 @@ type MockClient struct {
 	Signature       string
 	PublicKeys      []string
 	isThrowingError bool
+	Health          *internal.HealthCheckResponse
+	// publicKeysReleased blocks GetPublicKeys until it is closed, when set.
+	publicKeysReleased chan struct{}
 }
 
 func (mc *MockClient) Sign(_ context.Context, _ string, _ internal.SignRequestJson) (bls.Signature, error) {
@@ -33,6 +37,9 @@ func (mc *MockClient) Sign(_ context.Context, _ string, _ internal.SignRequestJs
 	return bls.SignatureFromBytes(decoded)
 }
 func (mc *MockClient) GetPublicKeys(_ context.Context, _ string) ([][48]byte, error) {
+	if mc.publicKeysReleased != nil {
+		<-mc.publicKeysReleased
+	}
 	var keys [][48]byte
 	for _, pk := range mc.PublicKeys {
 		decoded, err := hex.DecodeString(strings.TrimPrefix(pk, "0x"))
@@ -47,6 +54,13 @@ func (mc *MockClient) GetPublicKeys(_ context.Context, _ string) ([][48]byte, er
 	return keys, nil
 }
 
+func (mc *MockClient) GetHealthCheck(_ context.Context) (*internal.HealthCheckResponse, error) {
+	if mc.Health == nil {
+		return nil, fmt.Errorf("mock error")
+	}
+	return mc.Health, nil
+}
+
 func TestKeymanager_Sign(t *testing.T) {
 	client := &MockClient{
 		Signature: "0xb3baa751d0a9132cfe93e4e3d5ff9075111100e3789dca219ade5a24d27e19d16b3353149da1833e9b691bb38634e8dc04469be7032132906c927d7e1a49b414730612877bc6b2810c8f202daf793d1ab0d6b5cb21d52f9e52e883859887a5d9",
@@ -345,3 +359,148 @@ func TestKeymanager_DeletePublicKeys(t *testing.T) {
 		require.Equal(t, ethpbservice.DeletedRemoteKeysStatus_NOT_FOUND, status.Status)
 	}
 }
+
+func TestKeymanager_RefreshPublicKeys(t *testing.T) {
+	ctx := context.Background()
+	root, err := hexutil.Decode("0x270d43e74ce340de4bca2b1936beca0f4f5408d9e78aec4850920baf659d5b69")
+	require.NoError(t, err)
+	key1 := "0xa2b5aaad9c6efefe7bb9b1243a043404f3362937cfb6b31833929833173f476630ea2cfeb0d9ddf15f97ca8685948820"
+	key2 := "0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c"
+	client := &MockClient{PublicKeys: []string{key1}}
+	km, err := NewKeymanager(ctx, &SetupConfig{
+		BaseEndpoint:          "http://example.com",
+		GenesisValidatorsRoot: root,
+		PublicKeysURL:         "http://example2.com/api/v1/eth2/publicKeys",
+	})
+	require.NoError(t, err)
+	km.client = client
+	keys, err := km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(keys))
+
+	keysChan := make(chan [][fieldparams.BLSPubkeyLength]byte, 1)
+	sub := km.SubscribeAccountChanges(keysChan)
+	defer sub.Unsubscribe()
+
+	// Unchanged keys are not published.
+	require.NoError(t, km.refreshPublicKeys(ctx))
+	require.Equal(t, 0, len(keysChan))
+
+	// Added keys are published.
+	client.PublicKeys = []string{key1, key2}
+	require.NoError(t, km.refreshPublicKeys(ctx))
+	published := <-keysChan
+	require.Equal(t, 2, len(published))
+	keys, err = km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	require.DeepEqual(t, published, keys)
+
+	// Removed keys are published.
+	client.PublicKeys = []string{key2}
+	require.NoError(t, km.refreshPublicKeys(ctx))
+	published = <-keysChan
+	decoded, err := hexutil.Decode(key2)
+	require.NoError(t, err)
+	require.DeepEqual(t, [][fieldparams.BLSPubkeyLength]byte{bytesutil.ToBytes48(decoded)}, published)
+
+	// Errors keep the previous keys.
+	client.isThrowingError = true
+	require.ErrorContains(t, "mock error", km.refreshPublicKeys(ctx))
+	keys, err = km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	require.DeepEqual(t, published, keys)
+	client.isThrowingError = false
+
+	// Keys imported through the API are kept when the keys are polled again.
+	decoded, err = hexutil.Decode(key1)
+	require.NoError(t, err)
+	imported := bytesutil.ToBytes48(decoded)
+	_, err = km.AddPublicKeys(ctx, [][fieldparams.BLSPubkeyLength]byte{imported})
+	require.NoError(t, err)
+	<-keysChan
+	require.NoError(t, km.refreshPublicKeys(ctx))
+	require.Equal(t, 0, len(keysChan))
+	keys, err = km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	require.DeepEqual(t, append(published, imported), keys)
+
+	// Keys deleted through the API are not kept anymore.
+	_, err = km.DeletePublicKeys(ctx, [][fieldparams.BLSPubkeyLength]byte{imported})
+	require.NoError(t, err)
+	<-keysChan
+	require.NoError(t, km.refreshPublicKeys(ctx))
+	require.Equal(t, 0, len(keysChan))
+	keys, err = km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	require.DeepEqual(t, published, keys)
+}
+
+func TestKeymanager_FetchValidatingPublicKeys_DoesNotBlockWhileFetching(t *testing.T) {
+	ctx := context.Background()
+	root, err := hexutil.Decode("0x270d43e74ce340de4bca2b1936beca0f4f5408d9e78aec4850920baf659d5b69")
+	require.NoError(t, err)
+	key := "0xa2b5aaad9c6efefe7bb9b1243a043404f3362937cfb6b31833929833173f476630ea2cfeb0d9ddf15f97ca8685948820"
+	client := &MockClient{PublicKeys: []string{key}, publicKeysReleased: make(chan struct{})}
+	km, err := NewKeymanager(ctx, &SetupConfig{
+		BaseEndpoint:          "http://example.com",
+		GenesisValidatorsRoot: root,
+		PublicKeysURL:         "http://example2.com/api/v1/eth2/publicKeys",
+	})
+	require.NoError(t, err)
+	km.client = client
+
+	fetched := make(chan [][fieldparams.BLSPubkeyLength]byte)
+	go func() {
+		keys, err := km.FetchValidatingPublicKeys(ctx)
+		require.NoError(t, err)
+		fetched <- keys
+	}()
+	// Keys are imported while the web3signer has not answered yet.
+	imported := [fieldparams.BLSPubkeyLength]byte{'a'}
+	statuses, err := km.AddPublicKeys(ctx, [][fieldparams.BLSPubkeyLength]byte{imported})
+	require.NoError(t, err)
+	require.Equal(t, ethpbservice.ImportedRemoteKeysStatus_IMPORTED, statuses[0].Status)
+
+	close(client.publicKeysReleased)
+	keys := <-fetched
+	decoded, err := hexutil.Decode(key)
+	require.NoError(t, err)
+	require.DeepEqual(t, [][fieldparams.BLSPubkeyLength]byte{bytesutil.ToBytes48(decoded), imported}, keys)
+}
+
+func TestKeymanager_Sign_DelegatedSlashingProtection(t *testing.T) {
+	ctx := context.Background()
+	root, err := hexutil.Decode("0x270d43e74ce340de4bca2b1936beca0f4f5408d9e78aec4850920baf659d5b69")
+	require.NoError(t, err)
+	client := &MockClient{
+		Signature: "0xb3baa751d0a9132cfe93e4e3d5ff9075111100e3789dca219ade5a24d27e19d16b3353149da1833e9b691bb38634e8dc04469be7032132906c927d7e1a49b414730612877bc6b2810c8f202daf793d1ab0d6b5cb21d52f9e52e883859887a5d9",
+		Health: &internal.HealthCheckResponse{
+			Status: internal.HealthStatusUp,
+			Checks: []*internal.HealthCheck{{ID: "disk-space-health-check", Status: internal.HealthStatusUp}},
+		},
+	}
+	km, err := NewKeymanager(ctx, &SetupConfig{
+		BaseEndpoint:               "http://example.com",
+		GenesisValidatorsRoot:      root,
+		PublicKeysURL:              "http://example2.com/api/v1/eth2/publicKeys",
+		DelegateSlashingProtection: true,
+	})
+	require.NoError(t, err)
+	km.client = client
+
+	// Signing is refused while web3signer has slashing protection disabled.
+	_, err = km.Sign(ctx, mock.GetMockSignRequest("AGGREGATION_SLOT"))
+	require.ErrorIs(t, err, ErrSignerSlashingProtectionUnavailable)
+
+	// The health check result is cached.
+	client.Health.Checks = append(client.Health.Checks, &internal.HealthCheck{
+		ID:     internal.SlashingProtectionHealthCheckID,
+		Status: internal.HealthStatusUp,
+	})
+	_, err = km.Sign(ctx, mock.GetMockSignRequest("AGGREGATION_SLOT"))
+	require.ErrorIs(t, err, ErrSignerSlashingProtectionUnavailable)
+
+	km.signerProtectionCheck = time.Time{}
+	_, err = km.Sign(ctx, mock.GetMockSignRequest("AGGREGATION_SLOT"))
+	require.NoError(t, err)
+}
@@ -14,6 +14,10 @@ var (
 		Name: "remote_web3signer_errored_responses_total",
 		Help: "Total number of errored responses when calling web3signer",
 	})
+	slashingProtectionRejectionsTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "remote_web3signer_slashing_protection_rejections_total",
+		Help: "Total number of sign requests rejected by web3signer slashing protection",
+	})
 	blockSignRequestsTotal = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "remote_web3signer_block_sign_requests_total",
 		Help: "Total number of block sign requests",
@@ -44,11 +44,19 @@ request either failed, was denied, or completed successfully.
      bytes signature = 1;
  }
 
+Additional remote signer replicas may be configured. Requests are sent to the active
+signer, and are retried against the other signers in order when it cannot be reached.
+Once the primary signer at remote_address is healthy again, the keymanager fails back to it.
+Client certificates and the certificate authority are reloaded from disk whenever they change,
+so they may be rotated without restarting the validator client.
+
 The remote keymanager can be customized via a keymanageropts.json file
 which requires the following schema:
 
  {
    "remote_address": "remoteserver.com:4000", // Remote gRPC server address.
+   "remote_addresses": ["replica.com:4000"], // Optional failover server addresses.
+   "key_refresh_interval_seconds": 384, // Optional interval to refresh public keys from the server.
    "remote_cert": {
      "crt_path": "/home/eth2/certs/client.crt", // Client certificate path.
      "ca_crt_path": "/home/eth2/certs/ca.crt",  // Certificate authority cert path.
@@ -3,15 +3,14 @@ package remote
 import (
 	"bytes"
 	"context"
-	"crypto/tls"
-	"crypto/x509"
 	"encoding/json"
 	"fmt"
 	"io"
-	"os"
 	"path/filepath"
 	"sort"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/logrusorgru/aurora"
@@ -47,6 +46,12 @@ type RemoteKeymanager interface {
 type KeymanagerOpts struct {
 	RemoteCertificate *CertificateConfig `json:"remote_cert"`
 	RemoteAddr        string             `json:"remote_address"`
+	// RemoteAddrs lists additional signer replicas, which are failed over to in order
+	// when the signer at RemoteAddr cannot be reached.
+	RemoteAddrs []string `json:"remote_addresses,omitempty"`
+	// KeyRefreshIntervalSeconds defines how often the list of public keys is refreshed
+	// from the remote signer in the background. Zero disables the background refresh.
+	KeyRefreshIntervalSeconds uint64 `json:"key_refresh_interval_seconds,omitempty"`
 }
 
 // CertificateConfig defines configuration options for
@@ -66,23 +71,31 @@ type SetupConfig struct {
 	MaxMessageSize int
 }
 
+// healthCheckInterval is how often the health of the remote signers is checked, and the
+// primary signer is failed back to.
+const healthCheckInterval = 12 * time.Second
+
 // Keymanager implementation using remote signing keys via gRPC.
 type Keymanager struct {
 	opts                *KeymanagerOpts
-	client              validatorpb.RemoteSignerClient
+	signers             *signerPool
 	orderedPubKeys      [][fieldparams.BLSPubkeyLength]byte
+	pubKeysLock         sync.Mutex
 	accountsChangedFeed *event.Feed
 }
 
 // NewKeymanager instantiates a new imported keymanager from configuration options.
-func NewKeymanager(_ context.Context, cfg *SetupConfig) (*Keymanager, error) {
+// The connections to the remote signers are closed once the provided context is done.
+func NewKeymanager(ctx context.Context, cfg *SetupConfig) (*Keymanager, error) {
 	// Load the client certificates.
 	if cfg.Opts.RemoteCertificate == nil {
 		return nil, errors.New("certificate configuration is missing")
 	}
 
-	var clientCreds credentials.TransportCredentials
-
+	grpcOpts := []grpc.DialOption{
+		// Receive large messages without erroring.
+		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(cfg.MaxMessageSize)),
+	}
 	if cfg.Opts.RemoteCertificate.RequireTls {
 		if cfg.Opts.RemoteCertificate.ClientCertPath == "" {
 			return nil, errors.New("client certificate is required")
@@ -90,56 +103,80 @@ func NewKeymanager(_ context.Context, cfg *SetupConfig) (*Keymanager, error) {
 		if cfg.Opts.RemoteCertificate.ClientKeyPath == "" {
 			return nil, errors.New("client key is required")
 		}
-		clientPair, err := tls.LoadX509KeyPair(cfg.Opts.RemoteCertificate.ClientCertPath, cfg.Opts.RemoteCertificate.ClientKeyPath)
+		certs, err := newCertReloader(cfg.Opts.RemoteCertificate)
 		if err != nil {
-			return nil, errors.Wrap(err, "failed to obtain client's certificate and/or key")
-		}
-
-		// Load the CA for the server certificate if present.
-		cp := x509.NewCertPool()
-		if cfg.Opts.RemoteCertificate.CACertPath != "" {
-			serverCA, err := os.ReadFile(cfg.Opts.RemoteCertificate.CACertPath)
-			if err != nil {
-				return nil, errors.Wrap(err, "failed to obtain server's CA certificate")
-			}
-			if !cp.AppendCertsFromPEM(serverCA) {
-				return nil, errors.Wrap(err, "failed to add server's CA certificate to pool")
-			}
-		}
-
-		tlsCfg := &tls.Config{
-			Certificates: []tls.Certificate{clientPair},
-			RootCAs:      cp,
-			MinVersion:   tls.VersionTLS13,
+			return nil, err
 		}
-		clientCreds = credentials.NewTLS(tlsCfg)
-	}
-
-	grpcOpts := []grpc.DialOption{
-		// Receive large messages without erroring.
-		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(cfg.MaxMessageSize)),
-	}
-	if cfg.Opts.RemoteCertificate.RequireTls {
 		// Require TLS with client certificate.
-		grpcOpts = append(grpcOpts, grpc.WithTransportCredentials(clientCreds))
+		grpcOpts = append(grpcOpts, grpc.WithTransportCredentials(credentials.NewTLS(certs.tlsConfig())))
 	} else {
 		grpcOpts = append(grpcOpts, grpc.WithInsecure())
 	}
 
-	conn, err := grpc.Dial(cfg.Opts.RemoteAddr, grpcOpts...)
-	if err != nil {
-		return nil, errors.New("failed to connect to remote wallet")
+	endpoints := make([]*signerEndpoint, 0)
+	for _, addr := range cfg.Opts.signerAddrs() {
+		conn, err := grpc.Dial(addr, grpcOpts...)
+		if err != nil {
+			newSignerPool(endpoints).close()
+			return nil, errors.Errorf("failed to connect to remote wallet at %s", addr)
+		}
+		endpoints = append(endpoints, &signerEndpoint{
+			addr:   addr,
+			conn:   conn,
+			client: validatorpb.NewRemoteSignerClient(conn),
+		})
 	}
-	client := validatorpb.NewRemoteSignerClient(conn)
 	k := &Keymanager{
 		opts:                cfg.Opts,
-		client:              client,
+		signers:             newSignerPool(endpoints),
 		orderedPubKeys:      make([][fieldparams.BLSPubkeyLength]byte, 0),
 		accountsChangedFeed: new(event.Feed),
 	}
+	go k.run(ctx)
 	return k, nil
 }
 
+// signerAddrs returns the addresses of the configured signers in failover order,
+// without duplicates.
+func (opts *KeymanagerOpts) signerAddrs() []string {
+	addrs := []string{opts.RemoteAddr}
+	seen := map[string]bool{opts.RemoteAddr: true}
+	for _, a := range opts.RemoteAddrs {
+		if seen[a] {
+			continue
+		}
+		seen[a] = true
+		addrs = append(addrs, a)
+	}
+	return addrs
+}
+
+// run checks the health of the remote signers and refreshes the public keys in the
+// background until the context is done.
+func (km *Keymanager) run(ctx context.Context) {
+	defer km.signers.close()
+	healthTicker := time.NewTicker(healthCheckInterval)
+	defer healthTicker.Stop()
+	var refresh <-chan time.Time
+	if km.opts.KeyRefreshIntervalSeconds > 0 {
+		refreshTicker := time.NewTicker(time.Duration(km.opts.KeyRefreshIntervalSeconds) * time.Second)
+		defer refreshTicker.Stop()
+		refresh = refreshTicker.C
+	}
+	for {
+		select {
+		case <-healthTicker.C:
+			km.signers.checkHealth(ctx)
+		case <-refresh:
+			if _, err := km.ReloadPublicKeys(ctx); err != nil {
+				log.WithError(err).Error("Could not refresh public keys from remote signer")
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
 // UnmarshalOptionsFile attempts to JSON unmarshal a keymanager
 // options file into a struct.
 func UnmarshalOptionsFile(r io.ReadCloser) (*KeymanagerOpts, error) {
@@ -175,6 +212,24 @@ func (opts *KeymanagerOpts) String() string {
 		log.Error(err)
 		return ""
 	}
+	if len(opts.RemoteAddrs) > 0 {
+		strAddrs := fmt.Sprintf(
+			"%s: %s\n", au.BrightMagenta("Failover gRPC addresses"), strings.Join(opts.RemoteAddrs, ", "),
+		)
+		if _, err := b.WriteString(strAddrs); err != nil {
+			log.Error(err)
+			return ""
+		}
+	}
+	if opts.KeyRefreshIntervalSeconds > 0 {
+		strRefresh := fmt.Sprintf(
+			"%s: %ds\n", au.BrightMagenta("Key refresh interval"), opts.KeyRefreshIntervalSeconds,
+		)
+		if _, err := b.WriteString(strRefresh); err != nil {
+			log.Error(err)
+			return ""
+		}
+	}
 	strRequireTls := fmt.Sprintf(
 		"%s: %t\n", au.BrightMagenta("Require TLS"), opts.RemoteCertificate.RequireTls,
 	)
@@ -219,6 +274,8 @@ func (km *Keymanager) ReloadPublicKeys(ctx context.Context) ([][fieldparams.BLSP
 	}
 
 	sort.Slice(pubKeys, func(i, j int) bool { return bytes.Compare(pubKeys[i][:], pubKeys[j][:]) == -1 })
+	km.pubKeysLock.Lock()
+	defer km.pubKeysLock.Unlock()
 	if len(km.orderedPubKeys) != len(pubKeys) {
 		log.Info(keymanager.KeysReloaded)
 		km.accountsChangedFeed.Send(pubKeys)
@@ -238,7 +295,12 @@ func (km *Keymanager) ReloadPublicKeys(ctx context.Context) ([][fieldparams.BLSP
 
 // FetchValidatingPublicKeys fetches the list of public keys that should be used to validate with.
 func (km *Keymanager) FetchValidatingPublicKeys(ctx context.Context) ([][fieldparams.BLSPubkeyLength]byte, error) {
-	resp, err := km.client.ListValidatingPublicKeys(ctx, &empty.Empty{})
+	var resp *validatorpb.ListPublicKeysResponse
+	err := km.signers.call(ctx, "ListValidatingPublicKeys", func(ctx context.Context, c validatorpb.RemoteSignerClient) error {
+		var err error
+		resp, err = c.ListValidatingPublicKeys(ctx, &empty.Empty{})
+		return err
+	})
 	if err != nil {
 		return nil, errors.Wrap(err, "could not list accounts from remote server")
 	}
@@ -251,7 +313,12 @@ func (km *Keymanager) FetchValidatingPublicKeys(ctx context.Context) ([][fieldpa
 
 // Sign signs a message for a validator key via a gRPC request.
 func (km *Keymanager) Sign(ctx context.Context, req *validatorpb.SignRequest) (bls.Signature, error) {
-	resp, err := km.client.Sign(ctx, req)
+	var resp *validatorpb.SignResponse
+	err := km.signers.call(ctx, "Sign", func(ctx context.Context, c validatorpb.RemoteSignerClient) error {
+		var err error
+		resp, err = c.Sign(ctx, req)
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
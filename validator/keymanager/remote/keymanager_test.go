@@ -212,7 +212,7 @@ func TestRemoteKeymanager_Sign(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	m := mock.NewMockRemoteSignerClient(ctrl)
 	k := &Keymanager{
-		client: m,
+		signers: newSignerPool([]*signerEndpoint{{client: m}}),
 	}
 
 	// Expect error handling to work.
@@ -272,7 +272,7 @@ func TestRemoteKeymanager_FetchValidatingPublicKeys(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	m := mock.NewMockRemoteSignerClient(ctrl)
 	k := &Keymanager{
-		client:              m,
+		signers:             newSignerPool([]*signerEndpoint{{client: m}}),
 		accountsChangedFeed: new(event.Feed),
 	}
 
@@ -344,7 +344,7 @@ func TestReloadPublicKeys(t *testing.T) {
 	m := mock.NewMockRemoteSignerClient(ctrl)
 
 	k := &Keymanager{
-		client:              m,
+		signers:             newSignerPool([]*signerEndpoint{{client: m}}),
 		accountsChangedFeed: new(event.Feed),
 		orderedPubKeys:      [][fieldparams.BLSPubkeyLength]byte{bytesutil.ToBytes48([]byte("100"))},
 	}
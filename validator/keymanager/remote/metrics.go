@@ -0,0 +1,28 @@
+package remote
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	signerLatency = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "remote_signer_request_latency_milliseconds",
+			Help:    "Round-trip latency of requests to a remote signer",
+			Buckets: []float64{1, 2, 5, 10, 25, 50, 100, 250, 500, 1000, 2500},
+		},
+		[]string{"signer", "method"},
+	)
+	signerHealthy = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "remote_signer_healthy",
+			Help: "Whether the connection to a remote signer is healthy (1) or not (0)",
+		},
+		[]string{"signer"},
+	)
+	signerFailoversTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "remote_signer_failovers_total",
+		Help: "Total number of times the active remote signer changed",
+	})
+)
@@ -0,0 +1,176 @@
+package remote
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/pkg/errors"
+	validatorpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1/validator-client"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/status"
+)
+
+// probeTimeout bounds the request made to a remote signer to check that it is reachable.
+const probeTimeout = 2 * time.Second
+
+// signerEndpoint is a single remote signer replica.
+type signerEndpoint struct {
+	addr   string
+	conn   *grpc.ClientConn
+	client validatorpb.RemoteSignerClient
+	// unreachable is set to 1 when the last probe of the signer failed.
+	unreachable int32
+}
+
+// healthy reports whether the signer is usable, based on the result of its last probe and
+// on the gRPC connectivity state of its connection, if any.
+func (e *signerEndpoint) healthy() bool {
+	if atomic.LoadInt32(&e.unreachable) == 1 {
+		return false
+	}
+	if e.conn == nil {
+		return true
+	}
+	s := e.conn.GetState()
+	return s != connectivity.TransientFailure && s != connectivity.Shutdown
+}
+
+// probe sends a request to the signer to check that it is reachable, as the connectivity
+// state of an idle connection does not reflect whether the signer is still up. A signer
+// answering with an error is reachable.
+func (e *signerEndpoint) probe(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, probeTimeout)
+	defer cancel()
+	_, err := e.client.ListValidatingPublicKeys(ctx, &empty.Empty{})
+	if err != nil && isUnavailable(err) {
+		log.WithError(err).WithField("signer", e.addr).Debug("Remote signer probe failed")
+		atomic.StoreInt32(&e.unreachable, 1)
+		return
+	}
+	atomic.StoreInt32(&e.unreachable, 0)
+}
+
+// signerPool dispatches requests to a list of remote signer replicas, failing over to
+// the next replica when the active one cannot be reached. The first endpoint is the
+// primary, which the pool fails back to once it becomes healthy again.
+type signerPool struct {
+	endpoints []*signerEndpoint
+	active    int
+	lock      sync.RWMutex
+}
+
+func newSignerPool(endpoints []*signerEndpoint) *signerPool {
+	return &signerPool{endpoints: endpoints}
+}
+
+// candidates returns the endpoints in the order they should be tried: starting from the
+// active endpoint, with healthy endpoints ahead of unhealthy ones.
+func (p *signerPool) candidates() []int {
+	p.lock.RLock()
+	active := p.active
+	p.lock.RUnlock()
+	healthy := make([]int, 0, len(p.endpoints))
+	unhealthy := make([]int, 0)
+	for i := 0; i < len(p.endpoints); i++ {
+		idx := (active + i) % len(p.endpoints)
+		if p.endpoints[idx].healthy() {
+			healthy = append(healthy, idx)
+		} else {
+			unhealthy = append(unhealthy, idx)
+		}
+	}
+	return append(healthy, unhealthy...)
+}
+
+func (p *signerPool) setActive(idx int) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if p.active == idx {
+		return
+	}
+	log.WithFields(logrus.Fields{
+		"previous": p.endpoints[p.active].addr,
+		"current":  p.endpoints[idx].addr,
+	}).Warn("Switched active remote signer")
+	p.active = idx
+	signerFailoversTotal.Inc()
+}
+
+// call invokes fn against the active signer, failing over to the other signers if it is
+// unreachable. Errors returned by a reachable signer are not retried elsewhere.
+func (p *signerPool) call(
+	ctx context.Context, method string, fn func(context.Context, validatorpb.RemoteSignerClient) error,
+) error {
+	if len(p.endpoints) == 0 {
+		return errors.New("no remote signer configured")
+	}
+	var lastErr error
+	for _, idx := range p.candidates() {
+		e := p.endpoints[idx]
+		start := time.Now()
+		err := fn(ctx, e.client)
+		signerLatency.WithLabelValues(e.addr, method).Observe(float64(time.Since(start).Milliseconds()))
+		if err == nil {
+			p.setActive(idx)
+			return nil
+		}
+		if ctx.Err() != nil || !isUnavailable(err) {
+			return err
+		}
+		log.WithError(err).WithField("signer", e.addr).Warn("Remote signer is unavailable")
+		lastErr = err
+	}
+	return lastErr
+}
+
+// checkHealth probes every signer, updates their health metric, and makes the primary
+// signer active again once it is healthy.
+func (p *signerPool) checkHealth(ctx context.Context) {
+	var wg sync.WaitGroup
+	for _, e := range p.endpoints {
+		wg.Add(1)
+		go func(e *signerEndpoint) {
+			defer wg.Done()
+			e.probe(ctx)
+		}(e)
+	}
+	wg.Wait()
+	for _, e := range p.endpoints {
+		if e.healthy() {
+			signerHealthy.WithLabelValues(e.addr).Set(1)
+		} else {
+			signerHealthy.WithLabelValues(e.addr).Set(0)
+		}
+	}
+	if len(p.endpoints) > 1 && p.endpoints[0].healthy() {
+		p.setActive(0)
+	}
+}
+
+func (p *signerPool) close() {
+	for _, e := range p.endpoints {
+		if e.conn == nil {
+			continue
+		}
+		if err := e.conn.Close(); err != nil {
+			log.WithError(err).WithField("signer", e.addr).Debug("Could not close remote signer connection")
+		}
+	}
+}
+
+// isUnavailable returns true for errors indicating the request could not be served by
+// the signer it was sent to, in which case another signer may be tried.
+func isUnavailable(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted:
+		return true
+	default:
+		return false
+	}
+}
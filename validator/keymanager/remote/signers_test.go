@@ -0,0 +1,167 @@
+package remote
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/prysmaticlabs/prysm/v3/crypto/bls"
+	validatorpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1/validator-client"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/mock"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRemoteKeymanager_Sign_FailsOverToReplica(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	primary := mock.NewMockRemoteSignerClient(ctrl)
+	replica := mock.NewMockRemoteSignerClient(ctrl)
+	k := &Keymanager{
+		signers: newSignerPool([]*signerEndpoint{
+			{addr: "primary", client: primary},
+			{addr: "replica", client: replica},
+		}),
+	}
+
+	randKey, err := bls.RandKey()
+	require.NoError(t, err)
+	sig := randKey.Sign([]byte("hello-world"))
+	primary.EXPECT().Sign(
+		gomock.Any(), // ctx
+		gomock.Any(), // req
+	).Return(nil, status.Error(codes.Unavailable, "connection refused"))
+	replica.EXPECT().Sign(
+		gomock.Any(), // ctx
+		gomock.Any(), // req
+	).Return(&validatorpb.SignResponse{
+		Status:    validatorpb.SignResponse_SUCCEEDED,
+		Signature: sig.Marshal(),
+	}, nil /*err*/)
+	resp, err := k.Sign(context.Background(), nil)
+	require.NoError(t, err)
+	assert.DeepEqual(t, sig.Marshal(), resp.Marshal())
+	assert.Equal(t, 1, k.signers.active)
+
+	// The replica stays active until the primary is failed back to.
+	replica.EXPECT().Sign(
+		gomock.Any(), // ctx
+		gomock.Any(), // req
+	).Return(&validatorpb.SignResponse{
+		Status: validatorpb.SignResponse_DENIED,
+	}, nil /*err*/)
+	_, err = k.Sign(context.Background(), nil)
+	assert.Equal(t, ErrSigningDenied, err)
+
+	primary.EXPECT().ListValidatingPublicKeys(
+		gomock.Any(), // ctx
+		gomock.Any(), // req
+	).Return(&validatorpb.ListPublicKeysResponse{}, nil /*err*/)
+	replica.EXPECT().ListValidatingPublicKeys(
+		gomock.Any(), // ctx
+		gomock.Any(), // req
+	).Return(&validatorpb.ListPublicKeysResponse{}, nil /*err*/)
+	k.signers.checkHealth(context.Background())
+	assert.Equal(t, 0, k.signers.active)
+}
+
+func TestSignerPool_CheckHealth_ProbesSigners(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	primary := mock.NewMockRemoteSignerClient(ctrl)
+	replica := mock.NewMockRemoteSignerClient(ctrl)
+	pool := newSignerPool([]*signerEndpoint{
+		{addr: "primary", client: primary},
+		{addr: "replica", client: replica},
+	})
+	pool.active = 1
+
+	// The primary is not failed back to while it does not answer probes.
+	primary.EXPECT().ListValidatingPublicKeys(
+		gomock.Any(), // ctx
+		gomock.Any(), // req
+	).Return(nil, status.Error(codes.Unavailable, "connection refused"))
+	replica.EXPECT().ListValidatingPublicKeys(
+		gomock.Any(), // ctx
+		gomock.Any(), // req
+	).Return(&validatorpb.ListPublicKeysResponse{}, nil /*err*/)
+	pool.checkHealth(context.Background())
+	assert.Equal(t, false, pool.endpoints[0].healthy())
+	assert.Equal(t, true, pool.endpoints[1].healthy())
+	assert.Equal(t, 1, pool.active)
+	assert.DeepEqual(t, []int{1, 0}, pool.candidates())
+
+	// A signer answering with an error is reachable.
+	primary.EXPECT().ListValidatingPublicKeys(
+		gomock.Any(), // ctx
+		gomock.Any(), // req
+	).Return(nil, status.Error(codes.Unimplemented, "not implemented"))
+	replica.EXPECT().ListValidatingPublicKeys(
+		gomock.Any(), // ctx
+		gomock.Any(), // req
+	).Return(&validatorpb.ListPublicKeysResponse{}, nil /*err*/)
+	pool.checkHealth(context.Background())
+	assert.Equal(t, true, pool.endpoints[0].healthy())
+	assert.Equal(t, 0, pool.active)
+}
+
+func TestRemoteKeymanager_Sign_DoesNotFailOverOnSignerError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	primary := mock.NewMockRemoteSignerClient(ctrl)
+	replica := mock.NewMockRemoteSignerClient(ctrl)
+	k := &Keymanager{
+		signers: newSignerPool([]*signerEndpoint{
+			{addr: "primary", client: primary},
+			{addr: "replica", client: replica},
+		}),
+	}
+
+	primary.EXPECT().Sign(
+		gomock.Any(), // ctx
+		gomock.Any(), // req
+	).Return(nil, status.Error(codes.InvalidArgument, "bad request"))
+	_, err := k.Sign(context.Background(), nil)
+	require.ErrorContains(t, "bad request", err)
+	assert.Equal(t, 0, k.signers.active)
+}
+
+func TestRemoteKeymanager_FetchValidatingPublicKeys_AllSignersUnavailable(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	primary := mock.NewMockRemoteSignerClient(ctrl)
+	replica := mock.NewMockRemoteSignerClient(ctrl)
+	k := &Keymanager{
+		signers: newSignerPool([]*signerEndpoint{
+			{addr: "primary", client: primary},
+			{addr: "replica", client: replica},
+		}),
+	}
+
+	primary.EXPECT().ListValidatingPublicKeys(
+		gomock.Any(), // ctx
+		gomock.Any(), // req
+	).Return(nil, status.Error(codes.Unavailable, "connection refused"))
+	replica.EXPECT().ListValidatingPublicKeys(
+		gomock.Any(), // ctx
+		gomock.Any(), // req
+	).Return(nil, status.Error(codes.DeadlineExceeded, "timed out"))
+	_, err := k.FetchValidatingPublicKeys(context.Background())
+	require.ErrorContains(t, "timed out", err)
+}
+
+func TestSignerPool_NoSigners(t *testing.T) {
+	p := newSignerPool(nil)
+	err := p.call(context.Background(), "Sign", func(context.Context, validatorpb.RemoteSignerClient) error {
+		return errors.New("unexpected call")
+	})
+	require.ErrorContains(t, "no remote signer configured", err)
+}
+
+func TestKeymanagerOpts_signerAddrs(t *testing.T) {
+	opts := &KeymanagerOpts{
+		RemoteAddr:  "localhost:4000",
+		RemoteAddrs: []string{"localhost:4001", "localhost:4000", "localhost:4002", "localhost:4001"},
+	}
+	assert.DeepEqual(t, []string{"localhost:4000", "localhost:4001", "localhost:4002"}, opts.signerAddrs())
+	assert.DeepEqual(t, []string{""}, (&KeymanagerOpts{}).signerAddrs())
+}
@@ -0,0 +1,131 @@
+package remote
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// certReloader holds the client certificate and certificate authority used for mTLS
+// connections to the remote signers. The files are checked for modifications whenever
+// a TLS handshake takes place, so rotated certificates are picked up without a restart.
+type certReloader struct {
+	certPath string
+	keyPath  string
+	caPath   string
+
+	lock     sync.Mutex
+	cert     *tls.Certificate
+	roots    *x509.CertPool
+	modTimes [3]time.Time
+}
+
+func newCertReloader(cfg *CertificateConfig) (*certReloader, error) {
+	r := &certReloader{
+		certPath: cfg.ClientCertPath,
+		keyPath:  cfg.ClientKeyPath,
+		caPath:   cfg.CACertPath,
+	}
+	if err := r.load(); err != nil {
+		return nil, err
+	}
+	r.modTimes = r.currentModTimes()
+	return r, nil
+}
+
+// load reads the client key pair and certificate authority from disk.
+func (r *certReloader) load() error {
+	clientPair, err := tls.LoadX509KeyPair(r.certPath, r.keyPath)
+	if err != nil {
+		return errors.Wrap(err, "failed to obtain client's certificate and/or key")
+	}
+	// Load the CA for the server certificate if present. The system roots are never trusted, so
+	// without it no server certificate is trusted.
+	roots := x509.NewCertPool()
+	if r.caPath != "" {
+		serverCA, err := os.ReadFile(r.caPath)
+		if err != nil {
+			return errors.Wrap(err, "failed to obtain server's CA certificate")
+		}
+		if !roots.AppendCertsFromPEM(serverCA) {
+			return errors.New("failed to add server's CA certificate to pool")
+		}
+	}
+	r.cert = &clientPair
+	r.roots = roots
+	return nil
+}
+
+func (r *certReloader) currentModTimes() [3]time.Time {
+	var modTimes [3]time.Time
+	for i, p := range []string{r.certPath, r.keyPath, r.caPath} {
+		if p == "" {
+			continue
+		}
+		if info, err := os.Stat(p); err == nil {
+			modTimes[i] = info.ModTime()
+		}
+	}
+	return modTimes
+}
+
+// maybeReload reloads the certificates from disk if any of the files changed since they
+// were last loaded. A failed reload keeps the previously loaded certificates in use.
+func (r *certReloader) maybeReload() {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	modTimes := r.currentModTimes()
+	if modTimes == r.modTimes {
+		return
+	}
+	if err := r.load(); err != nil {
+		log.WithError(err).Error("Could not reload remote signer TLS certificates, keeping the previous ones")
+		return
+	}
+	r.modTimes = modTimes
+	log.Info("Reloaded remote signer TLS certificates")
+}
+
+func (r *certReloader) clientCertificate(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	r.maybeReload()
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.cert, nil
+}
+
+// verifyConnection verifies the server certificate chain against the current certificate
+// authority, which may have been rotated since the connection was first dialed.
+func (r *certReloader) verifyConnection(cs tls.ConnectionState) error {
+	if len(cs.PeerCertificates) == 0 {
+		return errors.New("remote signer did not present a certificate")
+	}
+	r.maybeReload()
+	r.lock.Lock()
+	roots := r.roots
+	r.lock.Unlock()
+	opts := x509.VerifyOptions{
+		DNSName:       cs.ServerName,
+		Roots:         roots,
+		Intermediates: x509.NewCertPool(),
+	}
+	for _, c := range cs.PeerCertificates[1:] {
+		opts.Intermediates.AddCert(c)
+	}
+	_, err := cs.PeerCertificates[0].Verify(opts)
+	return err
+}
+
+func (r *certReloader) tlsConfig() *tls.Config {
+	return &tls.Config{
+		GetClientCertificate: r.clientCertificate,
+		// The default verification is replaced by verifyConnection, which checks the server
+		// certificate against the reloadable certificate authority instead of a fixed pool.
+		InsecureSkipVerify: true, // #nosec G402
+		VerifyConnection:   r.verifyConnection,
+		MinVersion:         tls.VersionTLS13,
+	}
+}
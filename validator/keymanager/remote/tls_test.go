@@ -0,0 +1,132 @@
+package remote
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v3/config/params"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+func TestCertReloader_ReloadsModifiedCertificates(t *testing.T) {
+	hook := logTest.NewGlobal()
+	dir := t.TempDir()
+	certPath := filepath.Join(dir, "client.crt")
+	keyPath := filepath.Join(dir, "client.key")
+	perms := params.BeaconIoConfig().ReadWritePermissions
+	require.NoError(t, os.WriteFile(certPath, []byte(validClientCert), perms))
+	require.NoError(t, os.WriteFile(keyPath, []byte(validClientKey), perms))
+
+	r, err := newCertReloader(&CertificateConfig{ClientCertPath: certPath, ClientKeyPath: keyPath})
+	require.NoError(t, err)
+	initial, err := r.clientCertificate(nil)
+	require.NoError(t, err)
+
+	// Unmodified files are not reloaded.
+	cert, err := r.clientCertificate(nil)
+	require.NoError(t, err)
+	assert.Equal(t, initial, cert)
+
+	// A rotated certificate is picked up on the next handshake.
+	later := time.Now().Add(time.Minute)
+	require.NoError(t, os.Chtimes(certPath, later, later))
+	cert, err = r.clientCertificate(nil)
+	require.NoError(t, err)
+	assert.NotEqual(t, initial, cert)
+	assert.DeepEqual(t, initial.Certificate, cert.Certificate)
+	assert.LogsContain(t, hook, "Reloaded remote signer TLS certificates")
+
+	// An invalid replacement keeps the previous certificate in use.
+	require.NoError(t, os.WriteFile(certPath, []byte("bad"), perms))
+	later = later.Add(time.Minute)
+	require.NoError(t, os.Chtimes(certPath, later, later))
+	reloaded, err := r.clientCertificate(nil)
+	require.NoError(t, err)
+	assert.Equal(t, cert, reloaded)
+	assert.LogsContain(t, hook, "Could not reload remote signer TLS certificates")
+}
+
+// testServerCertificate returns a certificate for the server name issued by a new certificate
+// authority, along with the PEM encoded certificate of the authority.
+func testServerCertificate(t *testing.T, serverName string) (*x509.Certificate, []byte) {
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	caTmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTmpl, caTmpl, &caKey.PublicKey, caKey)
+	require.NoError(t, err)
+	ca, err := x509.ParseCertificate(caDER)
+	require.NoError(t, err)
+
+	serverKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	serverTmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: serverName},
+		DNSNames:     []string{serverName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	serverDER, err := x509.CreateCertificate(rand.Reader, serverTmpl, ca, &serverKey.PublicKey, caKey)
+	require.NoError(t, err)
+	server, err := x509.ParseCertificate(serverDER)
+	require.NoError(t, err)
+	return server, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caDER})
+}
+
+func TestCertReloader_VerifyConnection(t *testing.T) {
+	dir := t.TempDir()
+	certPath := filepath.Join(dir, "client.crt")
+	keyPath := filepath.Join(dir, "client.key")
+	caPath := filepath.Join(dir, "ca.crt")
+	perms := params.BeaconIoConfig().ReadWritePermissions
+	require.NoError(t, os.WriteFile(certPath, []byte(validClientCert), perms))
+	require.NoError(t, os.WriteFile(keyPath, []byte(validClientKey), perms))
+	server, caPEM := testServerCertificate(t, "signer.local")
+	require.NoError(t, os.WriteFile(caPath, caPEM, perms))
+	cs := tls.ConnectionState{ServerName: "signer.local", PeerCertificates: []*x509.Certificate{server}}
+
+	t.Run("no trusted roots without ca", func(t *testing.T) {
+		// Without a certificate authority no server certificate is trusted, the system roots
+		// are never used.
+		r, err := newCertReloader(&CertificateConfig{ClientCertPath: certPath, ClientKeyPath: keyPath})
+		require.NoError(t, err)
+		err = r.verifyConnection(cs)
+		assert.ErrorContains(t, "certificate signed by unknown authority", err)
+	})
+	t.Run("configured ca", func(t *testing.T) {
+		r, err := newCertReloader(&CertificateConfig{ClientCertPath: certPath, ClientKeyPath: keyPath, CACertPath: caPath})
+		require.NoError(t, err)
+		require.NoError(t, r.verifyConnection(cs))
+
+		wrongName := cs
+		wrongName.ServerName = "other.local"
+		assert.ErrorContains(t, "certificate is valid for signer.local", r.verifyConnection(wrongName))
+	})
+	t.Run("no server certificate", func(t *testing.T) {
+		r, err := newCertReloader(&CertificateConfig{ClientCertPath: certPath, ClientKeyPath: keyPath, CACertPath: caPath})
+		require.NoError(t, err)
+		assert.ErrorContains(t, "did not present a certificate", r.verifyConnection(tls.ConnectionState{}))
+	})
+}
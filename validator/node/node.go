@@ -18,6 +18,7 @@ import (
 	"strings"
 	"sync"
 	"syscall"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -52,6 +53,7 @@ import (
 	g "github.com/prysmaticlabs/prysm/v3/validator/graffiti"
 	"github.com/prysmaticlabs/prysm/v3/validator/keymanager/local"
 	remoteweb3signer "github.com/prysmaticlabs/prysm/v3/validator/keymanager/remote-web3signer"
+	proposersettings "github.com/prysmaticlabs/prysm/v3/validator/proposer-settings"
 	"github.com/prysmaticlabs/prysm/v3/validator/rpc"
 	validatormiddleware "github.com/prysmaticlabs/prysm/v3/validator/rpc/apimiddleware"
 	"github.com/prysmaticlabs/prysm/v3/validator/web"
@@ -410,9 +412,33 @@ func (c *ValidatorClient) registerValidatorService(cliCtx *cli.Context) error {
 	if err != nil {
 		return err
 	}
+	var pss client.ProposerSettingsStore
+	if c.cliCtx.Bool(flags.ProposerSettingsReloadFlag.Name) {
+		store, err := proposerSettingsStore(c.cliCtx, bpc)
+		if err != nil {
+			return err
+		}
+		if err := c.services.RegisterService(store); err != nil {
+			return err
+		}
+		pss = store
+	}
+
+	maxBlockWait := c.cliCtx.Duration(flags.AttestationMaxBlockWaitFlag.Name)
+	aggregationSpread := c.cliCtx.Duration(flags.AggregationSpreadFlag.Name)
+	if err := validateDutyTiming(maxBlockWait, aggregationSpread); err != nil {
+		return err
+	}
+	var dutyDelayHook client.DutyDelayHook
+	if c.cliCtx.IsSet(flags.InjectDutyDelayFlag.Name) {
+		delay := c.cliCtx.Duration(flags.InjectDutyDelayFlag.Name)
+		log.WithField("delay", delay).Warn("Delaying every duty of the validator client, this is meant for testing only")
+		dutyDelayHook = client.ConstantDutyDelay(delay)
+	}
 
 	v, err := client.NewValidatorService(c.cliCtx.Context, &client.Config{
 		Endpoint:                   endpoint,
+		BroadcastToBeaconNodes:     c.cliCtx.Bool(flags.BeaconRPCBroadcastFlag.Name),
 		DataDir:                    dataDir,
 		LogValidatorBalances:       logValidatorBalances,
 		EmitAccountMetrics:         emitAccountMetrics,
@@ -430,6 +456,10 @@ func (c *ValidatorClient) registerValidatorService(cliCtx *cli.Context) error {
 		GraffitiStruct:             gStruct,
 		Web3SignerConfig:           wsc,
 		ProposerSettings:           bpc,
+		ProposerSettingsStore:      pss,
+		AttestationMaxBlockWait:    maxBlockWait,
+		AggregationSpread:          aggregationSpread,
+		DutyDelayHook:              dutyDelayHook,
 	})
 	if err != nil {
 		return errors.Wrap(err, "could not initialize validator service")
@@ -482,6 +512,14 @@ func Web3SignerConfig(cliCtx *cli.Context) (*remoteweb3signer.SetupConfig, error
 				web3signerConfig.ProvidedPublicKeys = validatorKeys
 			}
 		}
+		if cliCtx.IsSet(flags.Web3SignerKeyRefreshIntervalFlag.Name) {
+			if web3signerConfig.PublicKeysURL == "" {
+				log.Warnf("%s was provided without an external url for public keys and will be ignored", flags.Web3SignerKeyRefreshIntervalFlag.Name)
+			} else {
+				web3signerConfig.PublicKeysRefreshInterval = cliCtx.Duration(flags.Web3SignerKeyRefreshIntervalFlag.Name)
+			}
+		}
+		web3signerConfig.DelegateSlashingProtection = cliCtx.Bool(flags.Web3SignerSlashingProtectionFlag.Name)
 	}
 	return web3signerConfig, nil
 }
@@ -526,6 +564,34 @@ func proposerSettings(cliCtx *cli.Context) (*validatorServiceConfig.ProposerSett
 	if fileConfig == nil {
 		return nil, nil
 	}
+	return proposerSettingsFromPayload(cliCtx, fileConfig)
+}
+
+// proposerSettingsStore creates the store reloading the proposer settings from the proposer settings file or URL.
+func proposerSettingsStore(cliCtx *cli.Context, settings *validatorServiceConfig.ProposerSettings) (*proposersettings.Store, error) {
+	if !cliCtx.IsSet(flags.ProposerSettingsFlag.Name) && !cliCtx.IsSet(flags.ProposerSettingsURLFlag.Name) {
+		return nil, errors.New(flags.ProposerSettingsReloadFlag.Name + " requires " +
+			flags.ProposerSettingsFlag.Name + " or " + flags.ProposerSettingsURLFlag.Name)
+	}
+	return proposersettings.NewStore(cliCtx.Context, &proposersettings.Config{
+		File:            cliCtx.String(flags.ProposerSettingsFlag.Name),
+		URL:             cliCtx.String(flags.ProposerSettingsURLFlag.Name),
+		URLPollInterval: cliCtx.Duration(flags.ProposerSettingsURLPollIntervalFlag.Name),
+		AuditLogFile:    cliCtx.String(flags.ProposerSettingsAuditLogFlag.Name),
+		Parse: func(payload *validatorServiceConfig.ProposerSettingsPayload) (*validatorServiceConfig.ProposerSettings, error) {
+			if payload == nil {
+				return nil, errors.New("proposer settings are empty")
+			}
+			return proposerSettingsFromPayload(cliCtx, payload)
+		},
+	}, settings)
+}
+
+// proposerSettingsFromPayload validates the proposer settings payload of a file or URL and
+// converts it into proposer settings.
+func proposerSettingsFromPayload(
+	cliCtx *cli.Context, fileConfig *validatorServiceConfig.ProposerSettingsPayload,
+) (*validatorServiceConfig.ProposerSettings, error) {
 	// convert file config to proposer config for internal use
 	vpSettings := &validatorServiceConfig.ProposerSettings{}
 
@@ -539,9 +605,14 @@ func proposerSettings(cliCtx *cli.Context) (*validatorServiceConfig.ProposerSett
 	if err := warnNonChecksummedAddress(fileConfig.DefaultConfig.FeeRecipient); err != nil {
 		return nil, err
 	}
+	if err := validateTimingConfig(fileConfig.DefaultConfig.Timing); err != nil {
+		return nil, errors.Wrap(err, "invalid default fileConfig timing")
+	}
 	vpSettings.DefaultConfig = &validatorServiceConfig.ProposerOption{
 		FeeRecipient:  common.HexToAddress(fileConfig.DefaultConfig.FeeRecipient),
 		BuilderConfig: fileConfig.DefaultConfig.BuilderConfig,
+		Graffiti:      fileConfig.DefaultConfig.Graffiti,
+		Timing:        fileConfig.DefaultConfig.Timing,
 	}
 	if vpSettings.DefaultConfig.BuilderConfig == nil {
 		builderConfig, err := BuilderSettingsFromFlags(cliCtx)
@@ -574,6 +645,9 @@ func proposerSettings(cliCtx *cli.Context) (*validatorServiceConfig.ProposerSett
 			if err := warnNonChecksummedAddress(option.FeeRecipient); err != nil {
 				return nil, err
 			}
+			if err := validateTimingConfig(option.Timing); err != nil {
+				return nil, errors.Wrapf(err, "invalid timing for proposer %s", key)
+			}
 			if option.BuilderConfig != nil {
 				option.BuilderConfig.GasLimit = reviewGasLimit(option.BuilderConfig.GasLimit)
 			} else {
@@ -586,6 +660,8 @@ func proposerSettings(cliCtx *cli.Context) (*validatorServiceConfig.ProposerSett
 			vpSettings.ProposeConfig[bytesutil.ToBytes48(decodedKey)] = &validatorServiceConfig.ProposerOption{
 				FeeRecipient:  common.HexToAddress(option.FeeRecipient),
 				BuilderConfig: option.BuilderConfig,
+				Graffiti:      option.Graffiti,
+				Timing:        option.Timing,
 			}
 
 		}
@@ -628,6 +704,35 @@ func warnNonChecksummedAddress(feeRecipient string) error {
 	return nil
 }
 
+// validateDutyTiming checks that the maximum block wait of attesters and the aggregation spread window
+// keep the duties of the validators within the slot.
+func validateDutyTiming(maxBlockWait, aggregationSpread time.Duration) error {
+	slotDuration := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+	if maxBlockWait < 0 || maxBlockWait >= slotDuration {
+		return fmt.Errorf("--%s must be between 0 and %s", flags.AttestationMaxBlockWaitFlag.Name, slotDuration)
+	}
+	if aggregationSpread < 0 || aggregationSpread >= slotDuration/3 {
+		return fmt.Errorf("--%s must be between 0 and %s", flags.AggregationSpreadFlag.Name, slotDuration/3)
+	}
+	return nil
+}
+
+// validateTimingConfig checks that the timing offsets of a validator keep its duties within the slot.
+func validateTimingConfig(timing *validatorServiceConfig.TimingConfig) error {
+	if timing == nil {
+		return nil
+	}
+	slotMs := int64(params.BeaconConfig().SecondsPerSlot) * 1000
+	// Attestations are made a third into the slot, so the offset must keep them within the slot.
+	if timing.AttestationOffsetMs <= -slotMs/3 || timing.AttestationOffsetMs >= slotMs-slotMs/3 {
+		return fmt.Errorf("attestation offset %dms must be greater than %dms and less than %dms", timing.AttestationOffsetMs, -slotMs/3, slotMs-slotMs/3)
+	}
+	if uint64(timing.ProposalDelayMs) >= uint64(slotMs) {
+		return fmt.Errorf("proposal delay %dms must be less than %dms", timing.ProposalDelayMs, slotMs)
+	}
+	return nil
+}
+
 func reviewGasLimit(gasLimit validatorServiceConfig.Uint64) validatorServiceConfig.Uint64 {
 	// sets gas limit to default if not defined or set to 0
 	if gasLimit == 0 {
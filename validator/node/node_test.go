@@ -9,6 +9,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -17,6 +18,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v3/config/params"
 	validatorserviceconfig "github.com/prysmaticlabs/prysm/v3/config/validator/service"
 	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v3/io/file"
 	"github.com/prysmaticlabs/prysm/v3/testing/assert"
 	"github.com/prysmaticlabs/prysm/v3/testing/require"
 	"github.com/prysmaticlabs/prysm/v3/validator/accounts"
@@ -83,8 +85,10 @@ func TestWeb3SignerConfig(t *testing.T) {
 	bytepubkey2 := bytesutil.ToBytes48(pubkey2decoded)
 
 	type args struct {
-		baseURL          string
-		publicKeysOrURLs []string
+		baseURL            string
+		publicKeysOrURLs   []string
+		refreshInterval    string
+		slashingProtection bool
 	}
 	tests := []struct {
 		name       string
@@ -122,6 +126,39 @@ func TestWeb3SignerConfig(t *testing.T) {
 				ProvidedPublicKeys:    nil,
 			},
 		},
+		{
+			name: "happy path with external url polling and delegated slashing protection",
+			args: &args{
+				baseURL:            "http://localhost:8545",
+				publicKeysOrURLs:   []string{"http://localhost:8545/api/v1/eth2/publicKeys"},
+				refreshInterval:    "5m",
+				slashingProtection: true,
+			},
+			want: &remoteweb3signer.SetupConfig{
+				BaseEndpoint:               "http://localhost:8545",
+				GenesisValidatorsRoot:      nil,
+				PublicKeysURL:              "http://localhost:8545/api/v1/eth2/publicKeys",
+				ProvidedPublicKeys:         nil,
+				PublicKeysRefreshInterval:  5 * time.Minute,
+				DelegateSlashingProtection: true,
+			},
+		},
+		{
+			name: "key polling ignored with public keys",
+			args: &args{
+				baseURL:          "http://localhost:8545",
+				publicKeysOrURLs: []string{"0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c"},
+				refreshInterval:  "5m",
+			},
+			want: &remoteweb3signer.SetupConfig{
+				BaseEndpoint:          "http://localhost:8545",
+				GenesisValidatorsRoot: nil,
+				PublicKeysURL:         "",
+				ProvidedPublicKeys: [][48]byte{
+					bytepubkey1,
+				},
+			},
+		},
 		{
 			name: "Bad base URL",
 			args: &args{
@@ -194,6 +231,11 @@ func TestWeb3SignerConfig(t *testing.T) {
 			for _, key := range tt.args.publicKeysOrURLs {
 				require.NoError(t, set.Set(flags.Web3SignerPublicValidatorKeysFlag.Name, key))
 			}
+			set.Duration(flags.Web3SignerKeyRefreshIntervalFlag.Name, 0, "")
+			if tt.args.refreshInterval != "" {
+				require.NoError(t, set.Set(flags.Web3SignerKeyRefreshIntervalFlag.Name, tt.args.refreshInterval))
+			}
+			set.Bool(flags.Web3SignerSlashingProtectionFlag.Name, tt.args.slashingProtection, "")
 			cliCtx := cli.NewContext(&app, set, nil)
 			got, err := Web3SignerConfig(cliCtx)
 			if tt.wantErrMsg != "" {
@@ -588,6 +630,52 @@ func TestProposerSettings(t *testing.T) {
 			},
 			wantErr: "cannot specify both",
 		},
+		{
+			name: "Happy Path Config file File with timing",
+			args: args{
+				proposerSettingsFlagValues: &proposerSettingsFlag{
+					dir:        "./testdata/good-timing-proposer-settings.json",
+					url:        "",
+					defaultfee: "",
+				},
+			},
+			want: func() *validatorserviceconfig.ProposerSettings {
+				key1, err := hexutil.Decode("0xa057816155ad77931185101128655c0191bd0214c201ca48ed887f6c4c6adf334070efcd75140eada5ac83a92506dd7a")
+				require.NoError(t, err)
+				return &validatorserviceconfig.ProposerSettings{
+					ProposeConfig: map[[fieldparams.BLSPubkeyLength]byte]*validatorserviceconfig.ProposerOption{
+						bytesutil.ToBytes48(key1): {
+							FeeRecipient: common.HexToAddress("0x50155530FCE8a85ec7055A5F8b2bE214B3DaeFd3"),
+							Timing: &validatorserviceconfig.TimingConfig{
+								AttestationOffsetMs: -500,
+								ProposalDelayMs:     250,
+							},
+						},
+					},
+					DefaultConfig: &validatorserviceconfig.ProposerOption{
+						FeeRecipient: common.HexToAddress("0x6e35733c5af9B61374A128e6F85f553aF09ff89A"),
+						Timing: &validatorserviceconfig.TimingConfig{
+							AttestationOffsetMs: 1000,
+						},
+					},
+				}
+			},
+			wantErr: "",
+		},
+		{
+			name: "Proposal delay longer than a slot",
+			args: args{
+				proposerSettingsFlagValues: &proposerSettingsFlag{
+					dir:        "./testdata/bad-timing-proposer-settings.json",
+					url:        "",
+					defaultfee: "",
+				},
+			},
+			want: func() *validatorserviceconfig.ProposerSettings {
+				return nil
+			},
+			wantErr: "proposal delay 60000ms must be less than",
+		},
 		{
 			name: "Bad Gas value in JSON",
 			args: args{
@@ -652,3 +740,44 @@ func TestProposerSettings(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateTimingConfig(t *testing.T) {
+	slotMs := int64(params.BeaconConfig().SecondsPerSlot) * 1000
+	require.NoError(t, validateTimingConfig(nil))
+	require.NoError(t, validateTimingConfig(&validatorserviceconfig.TimingConfig{AttestationOffsetMs: -slotMs/3 + 1}))
+	require.NoError(t, validateTimingConfig(&validatorserviceconfig.TimingConfig{AttestationOffsetMs: slotMs - slotMs/3 - 1}))
+	require.ErrorContains(t, "attestation offset", validateTimingConfig(&validatorserviceconfig.TimingConfig{AttestationOffsetMs: -slotMs / 3}))
+	require.ErrorContains(t, "attestation offset", validateTimingConfig(&validatorserviceconfig.TimingConfig{AttestationOffsetMs: slotMs - slotMs/3}))
+	require.ErrorContains(t, "proposal delay", validateTimingConfig(&validatorserviceconfig.TimingConfig{ProposalDelayMs: validatorserviceconfig.Uint64(slotMs)}))
+}
+
+func TestValidateDutyTiming(t *testing.T) {
+	slotDuration := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+	require.NoError(t, validateDutyTiming(0, 0))
+	require.NoError(t, validateDutyTiming(slotDuration/2, slotDuration/4))
+	require.ErrorContains(t, "--attestation-max-block-wait must be between", validateDutyTiming(slotDuration, 0))
+	require.ErrorContains(t, "--attestation-max-block-wait must be between", validateDutyTiming(-time.Second, 0))
+	require.ErrorContains(t, "--aggregation-spread must be between", validateDutyTiming(0, slotDuration/3))
+}
+
+func TestProposerSettingsStore(t *testing.T) {
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.Bool(flags.ProposerSettingsReloadFlag.Name, true, "")
+	cliCtx := cli.NewContext(&app, set, nil)
+	_, err := proposerSettingsStore(cliCtx, nil)
+	require.ErrorContains(t, "proposer-settings-reload requires", err)
+
+	settingsFile := filepath.Join(t.TempDir(), "proposer-settings.json")
+	content, err := os.ReadFile("./testdata/good-prepare-beacon-proposer-config.json")
+	require.NoError(t, err)
+	require.NoError(t, file.WriteFile(settingsFile, content))
+	set.String(flags.ProposerSettingsFlag.Name, settingsFile, "")
+	require.NoError(t, set.Set(flags.ProposerSettingsFlag.Name, settingsFile))
+	cliCtx = cli.NewContext(&app, set, nil)
+	settings, err := proposerSettings(cliCtx)
+	require.NoError(t, err)
+	store, err := proposerSettingsStore(cliCtx, settings)
+	require.NoError(t, err)
+	require.NotNil(t, store)
+}
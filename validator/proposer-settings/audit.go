@@ -0,0 +1,166 @@
+package proposersettings
+
+import (
+	"encoding/json"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v3/config/params"
+	validatorserviceconfig "github.com/prysmaticlabs/prysm/v3/config/validator/service"
+	"github.com/sirupsen/logrus"
+)
+
+// Source of a change to the proposer settings.
+type Source string
+
+const (
+	// SourceFile is a change made to the proposer settings file.
+	SourceFile Source = "file"
+	// SourceURL is a change polled from the proposer settings URL.
+	SourceURL Source = "url"
+	// SourceAPI is a change made through the keymanager API.
+	SourceAPI Source = "api"
+)
+
+// defaultConfigKey is used as the public key of changes to the default config.
+const defaultConfigKey = "default"
+
+// AuditEntry records the change of a single field of the proposer settings of a public key.
+type AuditEntry struct {
+	Time   time.Time `json:"time"`
+	Source Source    `json:"source"`
+	// Pubkey is the public key the proposer option belongs to, or "default" for the default config.
+	Pubkey string `json:"pubkey"`
+	Field  string `json:"field"`
+	Old    string `json:"old"`
+	New    string `json:"new"`
+}
+
+// Diff returns an audit entry for every field which differs between two versions of the proposer settings.
+// Entries are sorted by public key, with the default config first.
+func Diff(source Source, prev, next *validatorserviceconfig.ProposerSettingsPayload) []*AuditEntry {
+	now := time.Now()
+	entries := diffOptions(now, source, defaultConfigKey, defaultConfig(prev), defaultConfig(next))
+	for _, pubkey := range pubkeys(prev, next) {
+		entries = append(entries, diffOptions(now, source, pubkey, proposerConfig(prev, pubkey), proposerConfig(next, pubkey))...)
+	}
+	return entries
+}
+
+func diffOptions(now time.Time, source Source, pubkey string, prev, next *validatorserviceconfig.ProposerOptionPayload) []*AuditEntry {
+	oldFields, newFields := optionFields(prev), optionFields(next)
+	var entries []*AuditEntry
+	for i := range oldFields {
+		if oldFields[i].value == newFields[i].value {
+			continue
+		}
+		entries = append(entries, &AuditEntry{
+			Time:   now,
+			Source: source,
+			Pubkey: pubkey,
+			Field:  oldFields[i].name,
+			Old:    oldFields[i].value,
+			New:    newFields[i].value,
+		})
+	}
+	return entries
+}
+
+type field struct {
+	name  string
+	value string
+}
+
+// Returns the audited fields of a proposer option, always in the same order.
+func optionFields(option *validatorserviceconfig.ProposerOptionPayload) []field {
+	var feeRecipient, enabled, gasLimit, relays, graffiti, attestationOffset, proposalDelay string
+	if option != nil {
+		feeRecipient = option.FeeRecipient
+		graffiti = option.Graffiti
+		if option.BuilderConfig != nil {
+			enabled = strconv.FormatBool(option.BuilderConfig.Enabled)
+			gasLimit = strconv.FormatUint(uint64(option.BuilderConfig.GasLimit), 10)
+			relays = strings.Join(option.BuilderConfig.Relays, ",")
+		}
+		if option.Timing != nil {
+			attestationOffset = strconv.FormatInt(option.Timing.AttestationOffsetMs, 10)
+			proposalDelay = strconv.FormatUint(uint64(option.Timing.ProposalDelayMs), 10)
+		}
+	}
+	return []field{
+		{name: "fee_recipient", value: feeRecipient},
+		{name: "builder.enabled", value: enabled},
+		{name: "builder.gas_limit", value: gasLimit},
+		{name: "builder.relays", value: relays},
+		{name: "graffiti", value: graffiti},
+		{name: "timing.attestation_offset_ms", value: attestationOffset},
+		{name: "timing.proposal_delay_ms", value: proposalDelay},
+	}
+}
+
+func defaultConfig(payload *validatorserviceconfig.ProposerSettingsPayload) *validatorserviceconfig.ProposerOptionPayload {
+	if payload == nil {
+		return nil
+	}
+	return payload.DefaultConfig
+}
+
+func proposerConfig(payload *validatorserviceconfig.ProposerSettingsPayload, pubkey string) *validatorserviceconfig.ProposerOptionPayload {
+	if payload == nil {
+		return nil
+	}
+	return payload.ProposerConfig[pubkey]
+}
+
+// Returns the sorted public keys of the proposer configs of both versions of the proposer settings.
+func pubkeys(prev, next *validatorserviceconfig.ProposerSettingsPayload) []string {
+	seen := make(map[string]bool)
+	var keys []string
+	for _, payload := range []*validatorserviceconfig.ProposerSettingsPayload{prev, next} {
+		if payload == nil {
+			continue
+		}
+		for pubkey := range payload.ProposerConfig {
+			if !seen[pubkey] {
+				seen[pubkey] = true
+				keys = append(keys, pubkey)
+			}
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Logs the audit entries and appends them to the audit log file, if one is configured.
+func (s *Store) audit(entries []*AuditEntry) error {
+	for _, entry := range entries {
+		log.WithFields(logrus.Fields{
+			"source": entry.Source,
+			"pubkey": entry.Pubkey,
+			"field":  entry.Field,
+			"old":    entry.Old,
+			"new":    entry.New,
+		}).Info("Proposer settings changed")
+	}
+	if s.cfg.AuditLogFile == "" || len(entries) == 0 {
+		return nil
+	}
+	f, err := os.OpenFile(s.cfg.AuditLogFile, os.O_CREATE|os.O_WRONLY|os.O_APPEND, params.BeaconIoConfig().ReadWritePermissions) // #nosec G304
+	if err != nil {
+		return errors.Wrap(err, "could not open audit log")
+	}
+	encoder := json.NewEncoder(f)
+	for _, entry := range entries {
+		if err := encoder.Encode(entry); err != nil {
+			if closeErr := f.Close(); closeErr != nil {
+				log.WithError(closeErr).Error("Could not close audit log")
+			}
+			return errors.Wrap(err, "could not write audit log")
+		}
+	}
+	return f.Close()
+}
@@ -0,0 +1,58 @@
+package proposersettings
+
+import (
+	"testing"
+
+	validatorserviceconfig "github.com/prysmaticlabs/prysm/v3/config/validator/service"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+)
+
+func TestDiff(t *testing.T) {
+	pubkey1 := "0xa057816155ad77931185101128655c0191bd0214c201ca48ed887f6c4c6adf334070efcd75140eada5ac83a92506dd7a"
+	pubkey2 := "0xb057816155ad77931185101128655c0191bd0214c201ca48ed887f6c4c6adf334070efcd75140eada5ac83a92506dd7b"
+	prev := &validatorserviceconfig.ProposerSettingsPayload{
+		ProposerConfig: map[string]*validatorserviceconfig.ProposerOptionPayload{
+			pubkey1: {
+				FeeRecipient:  "0x50155530FCE8a85ec7055A5F8b2bE214B3DaeFd3",
+				BuilderConfig: &validatorserviceconfig.BuilderConfig{Enabled: true, GasLimit: 30000000},
+			},
+		},
+		DefaultConfig: &validatorserviceconfig.ProposerOptionPayload{
+			FeeRecipient: "0x6e35733c5af9B61374A128e6F85f553aF09ff89A",
+		},
+	}
+	next := &validatorserviceconfig.ProposerSettingsPayload{
+		ProposerConfig: map[string]*validatorserviceconfig.ProposerOptionPayload{
+			pubkey1: {
+				FeeRecipient:  "0x50155530FCE8a85ec7055A5F8b2bE214B3DaeFd3",
+				BuilderConfig: &validatorserviceconfig.BuilderConfig{Enabled: true, GasLimit: 35000000},
+				Graffiti:      "{{.CL}} #{{.Index}}",
+			},
+			pubkey2: {
+				FeeRecipient: "0x6e35733c5af9B61374A128e6F85f553aF09ff89A",
+			},
+		},
+		DefaultConfig: &validatorserviceconfig.ProposerOptionPayload{
+			FeeRecipient: "0x046Fb65722E7b2455012BFEBf6177F1D2e9738D9",
+		},
+	}
+
+	entries := Diff(SourceFile, prev, next)
+	require.Equal(t, 4, len(entries))
+	want := []AuditEntry{
+		{Source: SourceFile, Pubkey: "default", Field: "fee_recipient", Old: "0x6e35733c5af9B61374A128e6F85f553aF09ff89A", New: "0x046Fb65722E7b2455012BFEBf6177F1D2e9738D9"},
+		{Source: SourceFile, Pubkey: pubkey1, Field: "builder.gas_limit", Old: "30000000", New: "35000000"},
+		{Source: SourceFile, Pubkey: pubkey1, Field: "graffiti", Old: "", New: "{{.CL}} #{{.Index}}"},
+		{Source: SourceFile, Pubkey: pubkey2, Field: "fee_recipient", Old: "", New: "0x6e35733c5af9B61374A128e6F85f553aF09ff89A"},
+	}
+	for i, entry := range entries {
+		assert.Equal(t, want[i].Source, entry.Source)
+		assert.Equal(t, want[i].Pubkey, entry.Pubkey)
+		assert.Equal(t, want[i].Field, entry.Field)
+		assert.Equal(t, want[i].Old, entry.Old)
+		assert.Equal(t, want[i].New, entry.New)
+	}
+
+	assert.Equal(t, 0, len(Diff(SourceAPI, next, next)))
+}
@@ -0,0 +1,5 @@
+package proposersettings
+
+import "github.com/sirupsen/logrus"
+
+var log = logrus.WithField("prefix", "proposer-settings")
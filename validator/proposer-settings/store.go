@@ -0,0 +1,288 @@
+// Package proposersettings reloads the proposer settings of the validator client from the proposer
+// settings file or URL while it is running, writes changes made through the keymanager API back to the
+// proposer settings file and keeps an audit log of every change.
+package proposersettings
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v3/async"
+	"github.com/prysmaticlabs/prysm/v3/async/event"
+	validatorserviceconfig "github.com/prysmaticlabs/prysm/v3/config/validator/service"
+	"github.com/prysmaticlabs/prysm/v3/crypto/hash"
+	"github.com/prysmaticlabs/prysm/v3/io/file"
+	"gopkg.in/yaml.v2"
+)
+
+// fileChangesDebounceInterval groups the file system events of a single write of the proposer settings file.
+const fileChangesDebounceInterval = time.Second
+
+// Config for the proposer settings store. Exactly one of File and URL must be set.
+type Config struct {
+	// File is the path of the proposer settings file, which is watched for changes.
+	File string
+	// URL of the proposer settings, which is polled for changes every URLPollInterval.
+	URL             string
+	URLPollInterval time.Duration
+	// AuditLogFile is the path of the file every change is appended to. It is optional.
+	AuditLogFile string
+	// Parse validates a proposer settings payload and converts it into proposer settings, the same way
+	// as the proposer settings are loaded at startup.
+	Parse func(*validatorserviceconfig.ProposerSettingsPayload) (*validatorserviceconfig.ProposerSettings, error)
+}
+
+// Store keeps the proposer settings of the validator client in sync with the proposer settings file or URL.
+type Store struct {
+	ctx      context.Context
+	cancel   context.CancelFunc
+	cfg      *Config
+	client   *http.Client
+	feed     *event.Feed
+	lock     sync.Mutex
+	current  *validatorserviceconfig.ProposerSettingsPayload
+	fileHash [32]byte
+	etag     string
+}
+
+// NewStore creates a proposer settings store for the proposer settings loaded at startup.
+func NewStore(ctx context.Context, cfg *Config, settings *validatorserviceconfig.ProposerSettings) (*Store, error) {
+	if (cfg.File == "") == (cfg.URL == "") {
+		return nil, errors.New("exactly one of a proposer settings file or URL is required")
+	}
+	if cfg.Parse == nil {
+		return nil, errors.New("no proposer settings parser")
+	}
+	if cfg.URL != "" && cfg.URLPollInterval <= 0 {
+		return nil, errors.New("proposer settings URL poll interval must be positive")
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	s := &Store{
+		ctx:     ctx,
+		cancel:  cancel,
+		cfg:     cfg,
+		client:  &http.Client{Timeout: 30 * time.Second},
+		feed:    new(event.Feed),
+		current: settings.ToPayload(),
+	}
+	if cfg.File != "" {
+		b, err := os.ReadFile(cfg.File)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not read proposer settings file")
+		}
+		s.fileHash = hash.Hash(b)
+	}
+	return s, nil
+}
+
+// Start watching the proposer settings file or polling the proposer settings URL.
+func (s *Store) Start() {
+	if s.cfg.File != "" {
+		go s.watchFile()
+		return
+	}
+	go s.pollURL()
+}
+
+// Stop the proposer settings store.
+func (s *Store) Stop() error {
+	s.cancel()
+	return nil
+}
+
+// Status of the proposer settings store.
+func (*Store) Status() error {
+	return nil
+}
+
+// SubscribeUpdates subscribes to the proposer settings reloaded from the proposer settings file or URL.
+func (s *Store) SubscribeUpdates(ch chan<- *validatorserviceconfig.ProposerSettings) event.Subscription {
+	return s.feed.Subscribe(ch)
+}
+
+// Save records proposer settings changed through the keymanager API in the audit log, and writes
+// them back to the proposer settings file. Settings loaded from a URL are not written back.
+func (s *Store) Save(settings *validatorserviceconfig.ProposerSettings) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	payload := settings.ToPayload()
+	entries := Diff(SourceAPI, s.current, payload)
+	if len(entries) == 0 {
+		return nil
+	}
+	if s.cfg.File != "" {
+		if err := s.writeFile(payload); err != nil {
+			return err
+		}
+	}
+	s.current = payload
+	return s.audit(entries)
+}
+
+// Writes the proposer settings file atomically, in YAML if the file has a YAML extension and in JSON otherwise.
+func (s *Store) writeFile(payload *validatorserviceconfig.ProposerSettingsPayload) error {
+	var b []byte
+	var err error
+	switch strings.ToLower(filepath.Ext(s.cfg.File)) {
+	case ".yaml", ".yml":
+		b, err = yaml.Marshal(payload)
+	default:
+		b, err = json.MarshalIndent(payload, "", "  ")
+	}
+	if err != nil {
+		return errors.Wrap(err, "could not marshal proposer settings")
+	}
+	tmpFile := s.cfg.File + ".tmp"
+	if err := file.WriteFile(tmpFile, b); err != nil {
+		return errors.Wrap(err, "could not write proposer settings file")
+	}
+	if err := os.Rename(tmpFile, s.cfg.File); err != nil {
+		return errors.Wrap(err, "could not replace proposer settings file")
+	}
+	s.fileHash = hash.Hash(b)
+	return nil
+}
+
+// Watches the directory of the proposer settings file, as editors often replace a file instead of writing to it.
+func (s *Store) watchFile() {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		log.WithError(err).Error("Could not initialize file watcher")
+		return
+	}
+	defer func() {
+		if err := watcher.Close(); err != nil {
+			log.WithError(err).Error("Could not close file watcher")
+		}
+	}()
+	if err := watcher.Add(filepath.Dir(s.cfg.File)); err != nil {
+		log.WithError(err).Errorf("Could not add directory of %s to file watcher", s.cfg.File)
+		return
+	}
+	fileChangesChan := make(chan interface{}, 100)
+	defer close(fileChangesChan)
+	go async.Debounce(s.ctx, fileChangesDebounceInterval, fileChangesChan, func(interface{}) {
+		if err := s.reloadFile(); err != nil {
+			log.WithError(err).Error("Could not reload proposer settings file")
+		}
+	})
+	for {
+		select {
+		case ev := <-watcher.Events:
+			if filepath.Clean(ev.Name) == filepath.Clean(s.cfg.File) {
+				fileChangesChan <- ev
+			}
+		case err := <-watcher.Errors:
+			log.WithError(err).Errorf("Could not watch for file changes for: %s", s.cfg.File)
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+func (s *Store) reloadFile() error {
+	b, err := os.ReadFile(s.cfg.File)
+	if err != nil {
+		return errors.Wrap(err, "could not read proposer settings file")
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	fileHash := hash.Hash(b)
+	// Skip the writes of the store itself.
+	if fileHash == s.fileHash {
+		return nil
+	}
+	var payload *validatorserviceconfig.ProposerSettingsPayload
+	if err := yaml.Unmarshal(b, &payload); err != nil {
+		return errors.Wrap(err, "could not unmarshal proposer settings file")
+	}
+	if err := s.apply(SourceFile, payload); err != nil {
+		return err
+	}
+	s.fileHash = fileHash
+	return nil
+}
+
+func (s *Store) pollURL() {
+	ticker := time.NewTicker(s.cfg.URLPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := s.reloadURL(); err != nil {
+				log.WithError(err).Error("Could not reload proposer settings URL")
+			}
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+// Fetches the proposer settings from the URL, unless they did not change since the last fetch according to their ETag.
+func (s *Store) reloadURL() error {
+	req, err := http.NewRequestWithContext(s.ctx, http.MethodGet, s.cfg.URL, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to create http request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+	s.lock.Lock()
+	if s.etag != "" {
+		req.Header.Set("If-None-Match", s.etag)
+	}
+	s.lock.Unlock()
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "failed to send http request")
+	}
+	defer func(body io.ReadCloser) {
+		if err := body.Close(); err != nil {
+			log.WithError(err).Error("Failed to close response body")
+		}
+	}(resp.Body)
+	if resp.StatusCode == http.StatusNotModified {
+		return nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return errors.Errorf("http request to %v failed with status code %d", s.cfg.URL, resp.StatusCode)
+	}
+	var payload *validatorserviceconfig.ProposerSettingsPayload
+	if err := json.NewDecoder(resp.Body).Decode(&payload); err != nil {
+		return errors.Wrap(err, "failed to decode http response")
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if err := s.apply(SourceURL, payload); err != nil {
+		return err
+	}
+	s.etag = resp.Header.Get("ETag")
+	return nil
+}
+
+// Validates reloaded proposer settings and sends them to the subscribers if they changed.
+// The lock must be held by the caller.
+func (s *Store) apply(source Source, payload *validatorserviceconfig.ProposerSettingsPayload) error {
+	settings, err := s.cfg.Parse(payload)
+	if err != nil {
+		return errors.Wrap(err, "invalid proposer settings")
+	}
+	if settings == nil {
+		return errors.New("proposer settings are empty")
+	}
+	parsed := settings.ToPayload()
+	entries := Diff(source, s.current, parsed)
+	if len(entries) == 0 {
+		return nil
+	}
+	s.current = parsed
+	s.feed.Send(settings)
+	return s.audit(entries)
+}
@@ -0,0 +1,343 @@
+package proposersettings
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
+	validatorserviceconfig "github.com/prysmaticlabs/prysm/v3/config/validator/service"
+	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v3/io/file"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+	"gopkg.in/yaml.v2"
+)
+
+const testPubkey = "0xa057816155ad77931185101128655c0191bd0214c201ca48ed887f6c4c6adf334070efcd75140eada5ac83a92506dd7a"
+
+// A simplified parser of the proposer settings payload, as the one of the validator client lives in its node package.
+func testParse(payload *validatorserviceconfig.ProposerSettingsPayload) (*validatorserviceconfig.ProposerSettings, error) {
+	if payload == nil || payload.DefaultConfig == nil {
+		return nil, fmt.Errorf("default config is required")
+	}
+	settings := &validatorserviceconfig.ProposerSettings{
+		DefaultConfig: &validatorserviceconfig.ProposerOption{
+			FeeRecipient:  common.HexToAddress(payload.DefaultConfig.FeeRecipient),
+			BuilderConfig: payload.DefaultConfig.BuilderConfig,
+			Graffiti:      payload.DefaultConfig.Graffiti,
+			Timing:        payload.DefaultConfig.Timing,
+		},
+	}
+	if payload.ProposerConfig != nil {
+		settings.ProposeConfig = make(map[[fieldparams.BLSPubkeyLength]byte]*validatorserviceconfig.ProposerOption)
+		for key, option := range payload.ProposerConfig {
+			decoded, err := hexutil.Decode(key)
+			if err != nil {
+				return nil, err
+			}
+			settings.ProposeConfig[bytesutil.ToBytes48(decoded)] = &validatorserviceconfig.ProposerOption{
+				FeeRecipient:  common.HexToAddress(option.FeeRecipient),
+				BuilderConfig: option.BuilderConfig,
+				Graffiti:      option.Graffiti,
+				Timing:        option.Timing,
+			}
+		}
+	}
+	return settings, nil
+}
+
+func testPayload(feeRecipient string, gasLimit uint64) *validatorserviceconfig.ProposerSettingsPayload {
+	return &validatorserviceconfig.ProposerSettingsPayload{
+		ProposerConfig: map[string]*validatorserviceconfig.ProposerOptionPayload{
+			testPubkey: {
+				FeeRecipient: feeRecipient,
+				BuilderConfig: &validatorserviceconfig.BuilderConfig{
+					Enabled:  true,
+					GasLimit: validatorserviceconfig.Uint64(gasLimit),
+				},
+			},
+		},
+		DefaultConfig: &validatorserviceconfig.ProposerOptionPayload{
+			FeeRecipient: "0x6e35733c5af9B61374A128e6F85f553aF09ff89A",
+		},
+	}
+}
+
+func readAuditLog(t *testing.T, path string) []*AuditEntry {
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, f.Close())
+	}()
+	var entries []*AuditEntry
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		entry := &AuditEntry{}
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), entry))
+		entries = append(entries, entry)
+	}
+	require.NoError(t, scanner.Err())
+	return entries
+}
+
+func TestNewStore_InvalidConfig(t *testing.T) {
+	_, err := NewStore(context.Background(), &Config{Parse: testParse}, nil)
+	require.ErrorContains(t, "exactly one of a proposer settings file or URL is required", err)
+	_, err = NewStore(context.Background(), &Config{File: "a", URL: "b", Parse: testParse}, nil)
+	require.ErrorContains(t, "exactly one of a proposer settings file or URL is required", err)
+	_, err = NewStore(context.Background(), &Config{URL: "http://localhost"}, nil)
+	require.ErrorContains(t, "no proposer settings parser", err)
+	_, err = NewStore(context.Background(), &Config{URL: "http://localhost", Parse: testParse}, nil)
+	require.ErrorContains(t, "poll interval must be positive", err)
+}
+
+func TestStore_ReloadFile(t *testing.T) {
+	dir := t.TempDir()
+	settingsFile := filepath.Join(dir, "proposer-settings.yaml")
+	auditFile := filepath.Join(dir, "audit.log")
+	initial := testPayload("0x50155530FCE8a85ec7055A5F8b2bE214B3DaeFd3", 30000000)
+	b, err := yaml.Marshal(initial)
+	require.NoError(t, err)
+	require.NoError(t, file.WriteFile(settingsFile, b))
+	settings, err := testParse(initial)
+	require.NoError(t, err)
+
+	s, err := NewStore(context.Background(), &Config{File: settingsFile, AuditLogFile: auditFile, Parse: testParse}, settings)
+	require.NoError(t, err)
+	updates := make(chan *validatorserviceconfig.ProposerSettings, 1)
+	sub := s.SubscribeUpdates(updates)
+	defer sub.Unsubscribe()
+
+	// Rewriting the same settings is not a change.
+	require.NoError(t, s.reloadFile())
+	select {
+	case <-updates:
+		t.Fatal("unexpected proposer settings update")
+	default:
+	}
+
+	changed := testPayload("0x046Fb65722E7b2455012BFEBf6177F1D2e9738D9", 35000000)
+	b, err = yaml.Marshal(changed)
+	require.NoError(t, err)
+	require.NoError(t, os.Remove(settingsFile))
+	require.NoError(t, file.WriteFile(settingsFile, b))
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		require.NoError(t, s.reloadFile())
+	}()
+	reloaded := <-updates
+	wg.Wait()
+	decoded, err := hexutil.Decode(testPubkey)
+	require.NoError(t, err)
+	option := reloaded.ProposeConfig[bytesutil.ToBytes48(decoded)]
+	require.NotNil(t, option)
+	assert.Equal(t, common.HexToAddress("0x046Fb65722E7b2455012BFEBf6177F1D2e9738D9"), option.FeeRecipient)
+	assert.Equal(t, validatorserviceconfig.Uint64(35000000), option.BuilderConfig.GasLimit)
+
+	entries := readAuditLog(t, auditFile)
+	require.Equal(t, 2, len(entries))
+	assert.Equal(t, SourceFile, entries[0].Source)
+	assert.Equal(t, "fee_recipient", entries[0].Field)
+	assert.Equal(t, "builder.gas_limit", entries[1].Field)
+
+	// Invalid settings are not applied.
+	require.NoError(t, os.Remove(settingsFile))
+	require.NoError(t, file.WriteFile(settingsFile, []byte("proposer_config: {}")))
+	require.ErrorContains(t, "invalid proposer settings", s.reloadFile())
+}
+
+func TestStore_ReloadFile_TimingOnly(t *testing.T) {
+	dir := t.TempDir()
+	settingsFile := filepath.Join(dir, "proposer-settings.yaml")
+	auditFile := filepath.Join(dir, "audit.log")
+	initial := testPayload("0x50155530FCE8a85ec7055A5F8b2bE214B3DaeFd3", 30000000)
+	b, err := yaml.Marshal(initial)
+	require.NoError(t, err)
+	require.NoError(t, file.WriteFile(settingsFile, b))
+	settings, err := testParse(initial)
+	require.NoError(t, err)
+
+	s, err := NewStore(context.Background(), &Config{File: settingsFile, AuditLogFile: auditFile, Parse: testParse}, settings)
+	require.NoError(t, err)
+	updates := make(chan *validatorserviceconfig.ProposerSettings, 1)
+	sub := s.SubscribeUpdates(updates)
+	defer sub.Unsubscribe()
+
+	changed := testPayload("0x50155530FCE8a85ec7055A5F8b2bE214B3DaeFd3", 30000000)
+	changed.ProposerConfig[testPubkey].Timing = &validatorserviceconfig.TimingConfig{AttestationOffsetMs: -500, ProposalDelayMs: 250}
+	b, err = yaml.Marshal(changed)
+	require.NoError(t, err)
+	require.NoError(t, os.Remove(settingsFile))
+	require.NoError(t, file.WriteFile(settingsFile, b))
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		require.NoError(t, s.reloadFile())
+	}()
+	reloaded := <-updates
+	wg.Wait()
+	decoded, err := hexutil.Decode(testPubkey)
+	require.NoError(t, err)
+	option := reloaded.ProposeConfig[bytesutil.ToBytes48(decoded)]
+	require.NotNil(t, option)
+	require.NotNil(t, option.Timing)
+	assert.Equal(t, int64(-500), option.Timing.AttestationOffsetMs)
+
+	entries := readAuditLog(t, auditFile)
+	require.Equal(t, 2, len(entries))
+	assert.Equal(t, "timing.attestation_offset_ms", entries[0].Field)
+	assert.Equal(t, "", entries[0].Old)
+	assert.Equal(t, "-500", entries[0].New)
+	assert.Equal(t, "timing.proposal_delay_ms", entries[1].Field)
+	assert.Equal(t, "250", entries[1].New)
+}
+
+func TestStore_Save(t *testing.T) {
+	dir := t.TempDir()
+	settingsFile := filepath.Join(dir, "proposer-settings.json")
+	auditFile := filepath.Join(dir, "audit.log")
+	initial := testPayload("0x50155530FCE8a85ec7055A5F8b2bE214B3DaeFd3", 30000000)
+	b, err := json.Marshal(initial)
+	require.NoError(t, err)
+	require.NoError(t, file.WriteFile(settingsFile, b))
+	settings, err := testParse(initial)
+	require.NoError(t, err)
+
+	s, err := NewStore(context.Background(), &Config{File: settingsFile, AuditLogFile: auditFile, Parse: testParse}, settings)
+	require.NoError(t, err)
+	updates := make(chan *validatorserviceconfig.ProposerSettings, 1)
+	sub := s.SubscribeUpdates(updates)
+	defer sub.Unsubscribe()
+
+	// Changes are made in place by the keymanager API.
+	settings.DefaultConfig.Graffiti = "{{.CL}}-{{.EL}}"
+	require.NoError(t, s.Save(settings))
+
+	b, err = os.ReadFile(settingsFile)
+	require.NoError(t, err)
+	var written *validatorserviceconfig.ProposerSettingsPayload
+	require.NoError(t, json.Unmarshal(b, &written))
+	assert.Equal(t, "{{.CL}}-{{.EL}}", written.DefaultConfig.Graffiti)
+	assert.Equal(t, validatorserviceconfig.Uint64(30000000), written.ProposerConfig[testPubkey].BuilderConfig.GasLimit)
+
+	entries := readAuditLog(t, auditFile)
+	require.Equal(t, 1, len(entries))
+	assert.Equal(t, SourceAPI, entries[0].Source)
+	assert.Equal(t, "default", entries[0].Pubkey)
+	assert.Equal(t, "graffiti", entries[0].Field)
+
+	// The write of the store itself is not reloaded.
+	require.NoError(t, s.reloadFile())
+	select {
+	case <-updates:
+		t.Fatal("unexpected proposer settings update")
+	default:
+	}
+}
+
+func TestStore_WatchFile(t *testing.T) {
+	settingsFile := filepath.Join(t.TempDir(), "proposer-settings.yaml")
+	initial := testPayload("0x50155530FCE8a85ec7055A5F8b2bE214B3DaeFd3", 30000000)
+	b, err := yaml.Marshal(initial)
+	require.NoError(t, err)
+	require.NoError(t, file.WriteFile(settingsFile, b))
+	settings, err := testParse(initial)
+	require.NoError(t, err)
+
+	s, err := NewStore(context.Background(), &Config{File: settingsFile, Parse: testParse}, settings)
+	require.NoError(t, err)
+	updates := make(chan *validatorserviceconfig.ProposerSettings, 1)
+	sub := s.SubscribeUpdates(updates)
+	defer sub.Unsubscribe()
+	s.Start()
+	defer func() {
+		require.NoError(t, s.Stop())
+	}()
+	// Give the watcher time to start.
+	time.Sleep(100 * time.Millisecond)
+
+	changed := testPayload("0x50155530FCE8a85ec7055A5F8b2bE214B3DaeFd3", 35000000)
+	b, err = yaml.Marshal(changed)
+	require.NoError(t, err)
+	require.NoError(t, file.WriteFile(settingsFile, b))
+
+	select {
+	case reloaded := <-updates:
+		decoded, err := hexutil.Decode(testPubkey)
+		require.NoError(t, err)
+		assert.Equal(t, validatorserviceconfig.Uint64(35000000), reloaded.ProposeConfig[bytesutil.ToBytes48(decoded)].BuilderConfig.GasLimit)
+	case <-time.After(5 * time.Second):
+		t.Fatal("proposer settings file was not reloaded")
+	}
+}
+
+func TestStore_ReloadURL(t *testing.T) {
+	var lock sync.Mutex
+	payload := testPayload("0x50155530FCE8a85ec7055A5F8b2bE214B3DaeFd3", 30000000)
+	etag := `"1"`
+	requests, notModified := 0, 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+		requests++
+		if r.Header.Get("If-None-Match") == etag {
+			notModified++
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", etag)
+		require.NoError(t, json.NewEncoder(w).Encode(payload))
+	}))
+	defer srv.Close()
+
+	settings, err := testParse(payload)
+	require.NoError(t, err)
+	s, err := NewStore(context.Background(), &Config{URL: srv.URL, URLPollInterval: time.Minute, Parse: testParse}, settings)
+	require.NoError(t, err)
+	updates := make(chan *validatorserviceconfig.ProposerSettings, 1)
+	sub := s.SubscribeUpdates(updates)
+	defer sub.Unsubscribe()
+
+	// The first poll returns the settings loaded at startup, which are not a change.
+	require.NoError(t, s.reloadURL())
+	// The second poll is answered with not modified.
+	require.NoError(t, s.reloadURL())
+	lock.Lock()
+	assert.Equal(t, 2, requests)
+	assert.Equal(t, 1, notModified)
+	payload = testPayload("0x046Fb65722E7b2455012BFEBf6177F1D2e9738D9", 30000000)
+	etag = `"2"`
+	lock.Unlock()
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		require.NoError(t, s.reloadURL())
+	}()
+	reloaded := <-updates
+	wg.Wait()
+	decoded, err := hexutil.Decode(testPubkey)
+	require.NoError(t, err)
+	assert.Equal(t, common.HexToAddress("0x046Fb65722E7b2455012BFEBf6177F1D2e9738D9"), reloaded.ProposeConfig[bytesutil.ToBytes48(decoded)].FeeRecipient)
+	assert.Equal(t, `"2"`, s.etag)
+
+	// Changes from the keymanager API are not written back to a URL, but are audited.
+	reloaded.DefaultConfig.Graffiti = "hello"
+	require.NoError(t, s.Save(reloaded))
+	assert.Equal(t, "hello", s.current.DefaultConfig.Graffiti)
+}
@@ -0,0 +1,15 @@
+package apimiddleware
+
+import (
+	"net/http"
+
+	"github.com/prysmaticlabs/prysm/v3/api/gateway/apimiddleware"
+)
+
+// skipEmptyRequestBody allows POST requests without a body, whose fields are all optional.
+func skipEmptyRequestBody(_ *apimiddleware.Endpoint, _ http.ResponseWriter, req *http.Request) (apimiddleware.RunDefault, apimiddleware.ErrorJson) {
+	if req.Body == nil || req.Body == http.NoBody || req.ContentLength == 0 {
+		return false, nil
+	}
+	return true, nil
+}
@@ -20,6 +20,9 @@ func (*ValidatorEndpointFactory) Paths() []string {
 		"/eth/v1/remotekeys",
 		"/eth/v1/validator/{pubkey}/feerecipient",
 		"/eth/v1/validator/{pubkey}/gas_limit",
+		"/eth/v1/validator/{pubkey}/graffiti",
+		"/eth/v1/validator/{pubkey}/voluntary_exit",
+		"/eth/v1/validator/{pubkey}/validator_registration",
 	}
 }
 
@@ -46,6 +49,23 @@ func (*ValidatorEndpointFactory) Create(path string) (*apimiddleware.Endpoint, e
 		endpoint.GetResponse = &GetGasLimitResponseJson{}
 		endpoint.PostRequest = &SetGasLimitRequestJson{}
 		endpoint.DeleteRequest = &DeleteGasLimitRequestJson{}
+	case "/eth/v1/validator/{pubkey}/graffiti":
+		endpoint.GetResponse = &GetGraffitiResponseJson{}
+		endpoint.PostRequest = &SetGraffitiRequestJson{}
+		endpoint.DeleteRequest = &DeleteGraffitiRequestJson{}
+	case "/eth/v1/validator/{pubkey}/voluntary_exit":
+		endpoint.PostRequest = &SetVoluntaryExitRequestJson{}
+		endpoint.PostResponse = &SetVoluntaryExitResponseJson{}
+		endpoint.RequestQueryParams = []apimiddleware.QueryParam{{Name: "epoch"}}
+		endpoint.Hooks = apimiddleware.HookCollection{
+			OnPreDeserializeRequestBodyIntoContainer: skipEmptyRequestBody,
+		}
+	case "/eth/v1/validator/{pubkey}/validator_registration":
+		endpoint.PostRequest = &SignValidatorRegistrationRequestJson{}
+		endpoint.PostResponse = &SignValidatorRegistrationResponseJson{}
+		endpoint.Hooks = apimiddleware.HookCollection{
+			OnPreDeserializeRequestBodyIntoContainer: skipEmptyRequestBody,
+		}
 	default:
 		return nil, errors.New("invalid path")
 	}
@@ -77,6 +77,11 @@ type GasLimitJson struct {
 	GasLimit string `json:"gas_limit"`
 }
 
+type GraffitiJson struct {
+	Pubkey   string `json:"pubkey" hex:"true"`
+	Graffiti string `json:"graffiti"`
+}
+
 type GetFeeRecipientByPubkeyResponseJson struct {
 	Data *FeeRecipientJson `json:"data"`
 }
@@ -96,3 +101,53 @@ type SetGasLimitRequestJson struct {
 type DeleteGasLimitRequestJson struct {
 	Pubkey string `json:"pubkey" hex:"true"`
 }
+
+type GetGraffitiResponseJson struct {
+	Data *GraffitiJson `json:"data"`
+}
+
+type SetGraffitiRequestJson struct {
+	Graffiti string `json:"graffiti"`
+}
+
+type DeleteGraffitiRequestJson struct {
+	Pubkey string `json:"pubkey" hex:"true"`
+}
+
+type SetVoluntaryExitRequestJson struct{}
+
+type SetVoluntaryExitResponseJson struct {
+	Data *SignedVoluntaryExitJson `json:"data"`
+}
+
+type SignedVoluntaryExitJson struct {
+	Message   *VoluntaryExitJson `json:"message"`
+	Signature string             `json:"signature" hex:"true"`
+}
+
+type VoluntaryExitJson struct {
+	Epoch          string `json:"epoch"`
+	ValidatorIndex string `json:"validator_index"`
+}
+
+type SignValidatorRegistrationRequestJson struct {
+	FeeRecipient string `json:"fee_recipient,omitempty" hex:"true"`
+	GasLimit     string `json:"gas_limit,omitempty"`
+	Timestamp    string `json:"timestamp,omitempty"`
+}
+
+type SignValidatorRegistrationResponseJson struct {
+	Data *SignedValidatorRegistrationJson `json:"data"`
+}
+
+type SignedValidatorRegistrationJson struct {
+	Message   *ValidatorRegistrationJson `json:"message"`
+	Signature string                     `json:"signature" hex:"true"`
+}
+
+type ValidatorRegistrationJson struct {
+	FeeRecipient string `json:"fee_recipient" hex:"true"`
+	GasLimit     string `json:"gas_limit"`
+	Timestamp    string `json:"timestamp"`
+	Pubkey       string `json:"pubkey" hex:"true"`
+}
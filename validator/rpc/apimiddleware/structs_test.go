@@ -7,6 +7,7 @@ import (
 
 	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v3/proto/eth/service"
+	ethpbv1 "github.com/prysmaticlabs/prysm/v3/proto/eth/v1"
 	"github.com/prysmaticlabs/prysm/v3/testing/require"
 )
 
@@ -229,6 +230,90 @@ func TestDeleteRemoteKeys_JSONisEqual(t *testing.T) {
 }
 
 // note: this does not do a deep comparison of the structs
+func TestSetVoluntaryExit_JSONisEqual(t *testing.T) {
+	middlewareResponse := &SetVoluntaryExitResponseJson{
+		Data: &SignedVoluntaryExitJson{
+			Message: &VoluntaryExitJson{
+				Epoch:          "1",
+				ValidatorIndex: "2",
+			},
+			Signature: "0x0",
+		},
+	}
+
+	protoResponse := &service.SetVoluntaryExitResponse{
+		Data: &ethpbv1.SignedVoluntaryExit{
+			Message: &ethpbv1.VoluntaryExit{
+				Epoch:          1,
+				ValidatorIndex: 2,
+			},
+			Signature: make([]byte, fieldparams.BLSSignatureLength),
+		},
+	}
+
+	resp, err := areJsonPropertyNamesEqual(middlewareResponse, protoResponse)
+	require.NoError(t, err)
+	require.Equal(t, resp, true)
+
+	resp, err = areJsonPropertyNamesEqual(middlewareResponse.Data, protoResponse.Data)
+	require.NoError(t, err)
+	require.Equal(t, resp, true)
+
+	resp, err = areJsonPropertyNamesEqual(middlewareResponse.Data.Message, protoResponse.Data.Message)
+	require.NoError(t, err)
+	require.Equal(t, resp, true)
+}
+
+func TestSignValidatorRegistration_JSONisEqual(t *testing.T) {
+	middlewareRequest := &SignValidatorRegistrationRequestJson{
+		FeeRecipient: "0x0",
+		GasLimit:     "30000000",
+		Timestamp:    "1",
+	}
+
+	protoRequest := &service.SignValidatorRegistrationRequest{
+		FeeRecipient: make([]byte, fieldparams.FeeRecipientLength),
+		GasLimit:     30000000,
+		Timestamp:    1,
+	}
+
+	resp, err := areJsonPropertyNamesEqual(middlewareRequest, protoRequest)
+	require.NoError(t, err)
+	require.Equal(t, resp, true)
+
+	middlewareResponse := &SignValidatorRegistrationResponseJson{
+		Data: &SignedValidatorRegistrationJson{
+			Message: &ValidatorRegistrationJson{
+				FeeRecipient: "0x0",
+				GasLimit:     "30000000",
+				Timestamp:    "1",
+				Pubkey:       "0x0",
+			},
+			Signature: "0x0",
+		},
+	}
+
+	protoResponse := &service.SignValidatorRegistrationResponse{
+		Data: &ethpbv1.SubmitValidatorRegistrationsRequest_SignedValidatorRegistration{
+			Message: &ethpbv1.SubmitValidatorRegistrationsRequest_ValidatorRegistration{
+				FeeRecipient: make([]byte, fieldparams.FeeRecipientLength),
+				GasLimit:     30000000,
+				Timestamp:    1,
+				Pubkey:       make([]byte, fieldparams.BLSPubkeyLength),
+			},
+			Signature: make([]byte, fieldparams.BLSSignatureLength),
+		},
+	}
+
+	resp, err = areJsonPropertyNamesEqual(middlewareResponse.Data, protoResponse.Data)
+	require.NoError(t, err)
+	require.Equal(t, resp, true)
+
+	resp, err = areJsonPropertyNamesEqual(middlewareResponse.Data.Message, protoResponse.Data.Message)
+	require.NoError(t, err)
+	require.Equal(t, resp, true)
+}
+
 func areJsonPropertyNamesEqual(internal, proto interface{}) (bool, error) {
 	internalJSON, err := json.Marshal(internal)
 	if err != nil {
@@ -74,14 +74,19 @@ func (s *Server) GetBeaconStatus(ctx context.Context, _ *empty.Empty) (*validato
 	if err != nil {
 		return nil, err
 	}
-	return &validatorpb.BeaconStatusResponse{
+	resp := &validatorpb.BeaconStatusResponse{
 		BeaconNodeEndpoint:     s.beaconClientEndpoint,
 		Connected:              true,
 		Syncing:                syncStatus.Syncing,
 		GenesisTime:            genesisTime,
 		DepositContractAddress: address,
 		ChainHead:              chainHead,
-	}, nil
+	}
+	if s.validatorService != nil {
+		resp.PrimaryBeaconNodeEndpoint = s.validatorService.PrimaryBeaconNodeEndpoint()
+		resp.BeaconNodes = s.validatorService.BeaconNodeStatuses()
+	}
+	return resp, nil
 }
 
 // GetValidatorParticipation is a wrapper around the /eth/v1alpha1 endpoint of the same name.
@@ -124,3 +124,28 @@ func (s *Server) StreamValidatorLogs(_ *emptypb.Empty, stream validatorpb.Health
 		}
 	}
 }
+
+// StreamDutyEvents streams the outcome of every duty performed by the validator client, such as
+// submitted attestations, proposed blocks or missed duties, via a gRPC server-side stream.
+func (s *Server) StreamDutyEvents(_ *emptypb.Empty, stream validatorpb.Health_StreamDutyEventsServer) error {
+	if s.validatorService == nil {
+		return status.Error(codes.FailedPrecondition, "Validator service not yet initialized")
+	}
+	ch := make(chan *validatorpb.DutyEvent, s.streamLogsBufferSize)
+	sub := s.validatorService.DutyEventFeed().Subscribe(ch)
+	defer sub.Unsubscribe()
+	for {
+		select {
+		case ev := <-ch:
+			if err := stream.Send(ev); err != nil {
+				return status.Errorf(codes.Unavailable, "Could not send over stream: %v", err)
+			}
+		case <-s.ctx.Done():
+			return status.Error(codes.Canceled, "Context canceled")
+		case err := <-sub.Err():
+			return status.Errorf(codes.Canceled, "Subscriber error, closing: %v", err)
+		case <-stream.Context().Done():
+			return status.Error(codes.Canceled, "Context canceled")
+		}
+	}
+}
@@ -10,6 +10,7 @@ import (
 	pb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1/validator-client"
 	"github.com/prysmaticlabs/prysm/v3/testing/require"
 	"github.com/prysmaticlabs/prysm/v3/validator/client"
+	"google.golang.org/grpc"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -52,3 +53,45 @@ func TestServer_GetBeaconNodeConnection(t *testing.T) {
 	}
 	require.DeepEqual(t, want, got)
 }
+
+type mockDutyEventsStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent chan *pb.DutyEvent
+}
+
+func (m *mockDutyEventsStream) Context() context.Context {
+	return m.ctx
+}
+
+func (m *mockDutyEventsStream) Send(ev *pb.DutyEvent) error {
+	m.sent <- ev
+	return nil
+}
+
+func TestServer_StreamDutyEvents(t *testing.T) {
+	ctx := context.Background()
+	vs, err := client.NewValidatorService(ctx, &client.Config{})
+	require.NoError(t, err)
+	s := &Server{
+		ctx:                  ctx,
+		validatorService:     vs,
+		streamLogsBufferSize: 10,
+	}
+	streamCtx, cancel := context.WithCancel(ctx)
+	stream := &mockDutyEventsStream{ctx: streamCtx, sent: make(chan *pb.DutyEvent, 1)}
+	exitRoutine := make(chan error)
+	go func() {
+		exitRoutine <- s.StreamDutyEvents(&empty.Empty{}, stream)
+	}()
+
+	ev := &pb.DutyEvent{Duty: pb.DutyEvent_SYNC_COMMITTEE_MESSAGE, Slot: 5, Missed: true, Error: "bad"}
+	// Send until the stream has subscribed to the feed.
+	for vs.DutyEventFeed().Send(ev) == 0 {
+		time.Sleep(10 * time.Millisecond)
+	}
+	require.DeepEqual(t, ev, <-stream.sent)
+
+	cancel()
+	require.ErrorContains(t, "Context canceled", <-exitRoutine)
+}
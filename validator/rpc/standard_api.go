@@ -14,7 +14,12 @@ import (
 	validatorServiceConfig "github.com/prysmaticlabs/prysm/v3/config/validator/service"
 	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
 	ethpbservice "github.com/prysmaticlabs/prysm/v3/proto/eth/service"
+	ethpbv1 "github.com/prysmaticlabs/prysm/v3/proto/eth/v1"
+	"github.com/prysmaticlabs/prysm/v3/proto/migration"
 	eth "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	prysmTime "github.com/prysmaticlabs/prysm/v3/time"
+	"github.com/prysmaticlabs/prysm/v3/validator/client"
+	"github.com/prysmaticlabs/prysm/v3/validator/graffiti"
 	"github.com/prysmaticlabs/prysm/v3/validator/keymanager"
 	"github.com/prysmaticlabs/prysm/v3/validator/keymanager/derived"
 	slashingprotection "github.com/prysmaticlabs/prysm/v3/validator/slashing-protection-history"
@@ -455,28 +460,28 @@ func (s *Server) SetGasLimit(ctx context.Context, req *ethpbservice.SetGasLimitR
 		resp, err := s.beaconNodeValidatorClient.GetFeeRecipientByPubKey(ctx, &eth.FeeRecipientByPubKeyRequest{
 			PublicKey: []byte(nonExistantPublicKey),
 		})
+		settings := &validatorServiceConfig.ProposerSettings{
+			ProposeConfig: map[[fieldparams.BLSPubkeyLength]byte]*validatorServiceConfig.ProposerOption{
+				bytesutil.ToBytes48(validatorKey): &pOption,
+			},
+		}
 		if resp == nil || len(resp.FeeRecipient) == 0 || err != nil {
-			s.validatorService.SetProposerSettings(&validatorServiceConfig.ProposerSettings{
-				ProposeConfig: map[[fieldparams.BLSPubkeyLength]byte]*validatorServiceConfig.ProposerOption{
-					bytesutil.ToBytes48(validatorKey): &pOption,
-				},
-				DefaultConfig: &defaultOption,
-			})
+			settings.DefaultConfig = &defaultOption
 		} else {
-			s.validatorService.SetProposerSettings(&validatorServiceConfig.ProposerSettings{
-				ProposeConfig: map[[fieldparams.BLSPubkeyLength]byte]*validatorServiceConfig.ProposerOption{
-					bytesutil.ToBytes48(validatorKey): &pOption,
-				},
-				DefaultConfig: &validatorServiceConfig.ProposerOption{
-					FeeRecipient: common.BytesToAddress(resp.FeeRecipient),
-				},
-			})
+			settings.DefaultConfig = &validatorServiceConfig.ProposerOption{
+				FeeRecipient: common.BytesToAddress(resp.FeeRecipient),
+			}
+		}
+		if err := s.setProposerSettings(settings); err != nil {
+			return nil, err
 		}
 	} else if s.validatorService.ProposerSettings().ProposeConfig == nil {
 		settings := s.validatorService.ProposerSettings()
 		settings.ProposeConfig = make(map[[fieldparams.BLSPubkeyLength]byte]*validatorServiceConfig.ProposerOption)
 		settings.ProposeConfig[bytesutil.ToBytes48(validatorKey)] = &pOption
-		s.validatorService.SetProposerSettings(settings)
+		if err := s.setProposerSettings(settings); err != nil {
+			return nil, err
+		}
 	} else {
 		proposerOption, found := s.validatorService.ProposerSettings().ProposeConfig[bytesutil.ToBytes48(validatorKey)]
 		if found {
@@ -488,6 +493,9 @@ func (s *Server) SetGasLimit(ctx context.Context, req *ethpbservice.SetGasLimitR
 		} else {
 			s.validatorService.ProposerSettings().ProposeConfig[bytesutil.ToBytes48(validatorKey)] = &pOption
 		}
+		if err := s.setProposerSettings(s.validatorService.ProposerSettings()); err != nil {
+			return nil, err
+		}
 	}
 	// override the 200 success with 202 according to the specs
 	if err := grpc.SetHeader(ctx, metadata.Pairs("x-http-code", "202")); err != nil {
@@ -516,6 +524,9 @@ func (s *Server) DeleteGasLimit(ctx context.Context, req *ethpbservice.DeleteGas
 				// Fallback to using global default.
 				proposerOption.BuilderConfig.GasLimit = validatorServiceConfig.Uint64(params.BeaconConfig().DefaultBuilderGasLimit)
 			}
+			if err := s.setProposerSettings(proposerSettings); err != nil {
+				return nil, err
+			}
 			// Successfully deleted gas limit (reset to proposer config default or global default).
 			// Return with success http code "204".
 			if err := grpc.SetHeader(ctx, metadata.Pairs("x-http-code", "204")); err != nil {
@@ -529,6 +540,129 @@ func (s *Server) DeleteGasLimit(ctx context.Context, req *ethpbservice.DeleteGas
 	return nil, status.Error(codes.NotFound, fmt.Sprintf("no gaslimt found for pubkey: %q", hexutil.Encode(validatorKey)))
 }
 
+// GetGraffiti returns the graffiti of the public key from the proposer settings. The graffiti
+// may be a template, which is rendered for every proposal.
+func (s *Server) GetGraffiti(_ context.Context, req *ethpbservice.PubkeyRequest) (*ethpbservice.GetGraffitiResponse, error) {
+	if s.validatorService == nil {
+		return nil, status.Error(codes.FailedPrecondition, "Validator service not ready")
+	}
+	validatorKey := req.Pubkey
+	if err := validatePublicKey(validatorKey); err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	resp := &ethpbservice.GetGraffitiResponse{
+		Data: &ethpbservice.GetGraffitiResponse_Graffiti{
+			Pubkey: validatorKey,
+		},
+	}
+	proposerSettings := s.validatorService.ProposerSettings()
+	if proposerSettings != nil {
+		proposerOption, found := proposerSettings.ProposeConfig[bytesutil.ToBytes48(validatorKey)]
+		if found && proposerOption.Graffiti != "" {
+			resp.Data.Graffiti = proposerOption.Graffiti
+		} else if proposerSettings.DefaultConfig != nil {
+			resp.Data.Graffiti = proposerSettings.DefaultConfig.Graffiti
+		}
+	}
+	return resp, nil
+}
+
+// SetGraffiti sets the graffiti of the public key in the proposer settings, next to its fee
+// recipient and gas limit.
+func (s *Server) SetGraffiti(ctx context.Context, req *ethpbservice.SetGraffitiRequest) (*empty.Empty, error) {
+	if s.validatorService == nil {
+		return nil, status.Error(codes.FailedPrecondition, "Validator service not ready")
+	}
+	validatorKey := req.Pubkey
+	if err := validatePublicKey(validatorKey); err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if err := validateGraffiti(req.Graffiti); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	settings := s.validatorService.ProposerSettings()
+	if settings == nil {
+		defaultOption := validatorServiceConfig.DefaultProposerOption()
+		// get the default fee recipient defined with an invalid public key from beacon node
+		resp, err := s.beaconNodeValidatorClient.GetFeeRecipientByPubKey(ctx, &eth.FeeRecipientByPubKeyRequest{
+			PublicKey: []byte(nonExistantPublicKey),
+		})
+		if resp != nil && len(resp.FeeRecipient) != 0 && err == nil {
+			defaultOption.FeeRecipient = common.BytesToAddress(resp.FeeRecipient)
+		}
+		settings = &validatorServiceConfig.ProposerSettings{
+			DefaultConfig: &defaultOption,
+		}
+	}
+	if settings.ProposeConfig == nil {
+		settings.ProposeConfig = make(map[[fieldparams.BLSPubkeyLength]byte]*validatorServiceConfig.ProposerOption)
+	}
+	proposerOption, found := settings.ProposeConfig[bytesutil.ToBytes48(validatorKey)]
+	if !found {
+		// The validator keeps using the fee recipient and builder config of the default config.
+		option := validatorServiceConfig.DefaultProposerOption()
+		if settings.DefaultConfig != nil {
+			option.FeeRecipient = settings.DefaultConfig.FeeRecipient
+			if settings.DefaultConfig.BuilderConfig != nil {
+				builderConfig := *settings.DefaultConfig.BuilderConfig
+				option.BuilderConfig = &builderConfig
+			}
+		}
+		proposerOption = &option
+		settings.ProposeConfig[bytesutil.ToBytes48(validatorKey)] = proposerOption
+	}
+	proposerOption.Graffiti = req.Graffiti
+	if err := s.setProposerSettings(settings); err != nil {
+		return nil, err
+	}
+
+	// override the 200 success with 202 according to the specs
+	if err := grpc.SetHeader(ctx, metadata.Pairs("x-http-code", "202")); err != nil {
+		return &empty.Empty{}, status.Errorf(codes.Internal, "Could not set custom success code header: %v", err)
+	}
+	return &empty.Empty{}, nil
+}
+
+// DeleteGraffiti removes the graffiti of the public key from the proposer settings.
+func (s *Server) DeleteGraffiti(ctx context.Context, req *ethpbservice.DeleteGraffitiRequest) (*empty.Empty, error) {
+	if s.validatorService == nil {
+		return nil, status.Error(codes.FailedPrecondition, "Validator service not ready")
+	}
+	validatorKey := req.Pubkey
+	if err := validatePublicKey(validatorKey); err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	proposerSettings := s.validatorService.ProposerSettings()
+	if proposerSettings != nil {
+		proposerOption, found := proposerSettings.ProposeConfig[bytesutil.ToBytes48(validatorKey)]
+		if found && proposerOption.Graffiti != "" {
+			proposerOption.Graffiti = ""
+			if err := s.setProposerSettings(proposerSettings); err != nil {
+				return nil, err
+			}
+			// Successfully deleted graffiti, return with success http code "204".
+			if err := grpc.SetHeader(ctx, metadata.Pairs("x-http-code", "204")); err != nil {
+				return &empty.Empty{}, status.Errorf(codes.Internal, "Could not set custom http code 204 header: %v", err)
+			}
+			return &empty.Empty{}, nil
+		}
+	}
+	return nil, status.Error(codes.NotFound, fmt.Sprintf("no graffiti found for pubkey: %q", hexutil.Encode(validatorKey)))
+}
+
+// Graffiti templates are checked to parse, as their length is only known once rendered.
+func validateGraffiti(g string) error {
+	if graffiti.IsTemplate(g) {
+		return graffiti.ValidateTemplate(g)
+	}
+	if len(g) > fieldparams.RootLength {
+		return fmt.Errorf("graffiti is longer than %d bytes", fieldparams.RootLength)
+	}
+	return nil
+}
+
 // ListFeeRecipientByPubkey returns the public key to eth address mapping object to the end user.
 func (s *Server) ListFeeRecipientByPubkey(ctx context.Context, req *ethpbservice.PubkeyRequest) (*ethpbservice.GetFeeRecipientByPubkeyResponse, error) {
 	if s.validatorService == nil {
@@ -605,23 +739,29 @@ func (s *Server) SetFeeRecipientByPubkey(ctx context.Context, req *ethpbservice.
 				FeeRecipient: common.BytesToAddress(resp.FeeRecipient),
 			}
 		}
-		s.validatorService.SetProposerSettings(settings)
+		if err := s.setProposerSettings(settings); err != nil {
+			return nil, err
+		}
 	case s.validatorService.ProposerSettings().ProposeConfig == nil:
 		settings := s.validatorService.ProposerSettings()
 		pOption.BuilderConfig = settings.DefaultConfig.BuilderConfig
 		settings.ProposeConfig = map[[fieldparams.BLSPubkeyLength]byte]*validatorServiceConfig.ProposerOption{
 			bytesutil.ToBytes48(validatorKey): &pOption,
 		}
-		s.validatorService.SetProposerSettings(settings)
+		if err := s.setProposerSettings(settings); err != nil {
+			return nil, err
+		}
 	default:
 		proposerOption, found := s.validatorService.ProposerSettings().ProposeConfig[bytesutil.ToBytes48(validatorKey)]
+		settings := s.validatorService.ProposerSettings()
 		if found {
 			proposerOption.FeeRecipient = common.BytesToAddress(req.Ethaddress)
 		} else {
-			settings := s.validatorService.ProposerSettings()
 			pOption.BuilderConfig = settings.DefaultConfig.BuilderConfig
 			settings.ProposeConfig[bytesutil.ToBytes48(validatorKey)] = &pOption
-			s.validatorService.SetProposerSettings(settings)
+		}
+		if err := s.setProposerSettings(settings); err != nil {
+			return nil, err
 		}
 	}
 	// override the 200 success with 202 according to the specs
@@ -648,6 +788,9 @@ func (s *Server) DeleteFeeRecipientByPubkey(ctx context.Context, req *ethpbservi
 		proposerOption, found := s.validatorService.ProposerSettings().ProposeConfig[bytesutil.ToBytes48(validatorKey)]
 		if found {
 			proposerOption.FeeRecipient = defaultFeeRecipient
+			if err := s.setProposerSettings(s.validatorService.ProposerSettings()); err != nil {
+				return nil, err
+			}
 		}
 	}
 	// override the 200 success with 204 according to the specs
@@ -657,6 +800,113 @@ func (s *Server) DeleteFeeRecipientByPubkey(ctx context.Context, req *ethpbservi
 	return &empty.Empty{}, nil
 }
 
+// SetVoluntaryExit creates a signed voluntary exit for the public key with the signer of the validator client.
+// The exit is returned to the caller, not submitted to the beacon node.
+func (s *Server) SetVoluntaryExit(ctx context.Context, req *ethpbservice.SetVoluntaryExitRequest) (*ethpbservice.SetVoluntaryExitResponse, error) {
+	if s.validatorService == nil {
+		return nil, status.Error(codes.FailedPrecondition, "Validator service not ready")
+	}
+	if err := validatePublicKey(req.Pubkey); err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	km, err := s.keymanagerWithKey(ctx, req.Pubkey)
+	if err != nil {
+		return nil, err
+	}
+	signedExit, err := client.CreateSignedVoluntaryExit(
+		ctx, s.beaconNodeValidatorClient, s.beaconNodeClient, km.Sign, req.Pubkey, req.Epoch,
+	)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not create voluntary exit: %v", err)
+	}
+	return &ethpbservice.SetVoluntaryExitResponse{Data: migration.V1Alpha1ExitToV1(signedExit)}, nil
+}
+
+// SignValidatorRegistration creates a signed builder validator registration for the public key with the signer
+// of the validator client. Fields not in the request default to the fee recipient and gas limit of the public key
+// and the current time. The registration is returned to the caller, not submitted to the beacon node.
+func (s *Server) SignValidatorRegistration(
+	ctx context.Context, req *ethpbservice.SignValidatorRegistrationRequest,
+) (*ethpbservice.SignValidatorRegistrationResponse, error) {
+	if s.validatorService == nil {
+		return nil, status.Error(codes.FailedPrecondition, "Validator service not ready")
+	}
+	if err := validatePublicKey(req.Pubkey); err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	km, err := s.keymanagerWithKey(ctx, req.Pubkey)
+	if err != nil {
+		return nil, err
+	}
+	reg := &eth.ValidatorRegistrationV1{
+		FeeRecipient: req.FeeRecipient,
+		GasLimit:     req.GasLimit,
+		Timestamp:    req.Timestamp,
+		Pubkey:       req.Pubkey,
+	}
+	if len(reg.FeeRecipient) == 0 {
+		feeRecipient, err := s.ListFeeRecipientByPubkey(ctx, &ethpbservice.PubkeyRequest{Pubkey: req.Pubkey})
+		if err != nil {
+			return nil, err
+		}
+		reg.FeeRecipient = feeRecipient.Data.Ethaddress
+	} else if len(reg.FeeRecipient) != fieldparams.FeeRecipientLength {
+		return nil, status.Errorf(codes.InvalidArgument, "Fee recipient is not byte length %d", fieldparams.FeeRecipientLength)
+	}
+	if reg.GasLimit == 0 {
+		gasLimit, err := s.GetGasLimit(ctx, &ethpbservice.PubkeyRequest{Pubkey: req.Pubkey})
+		if err != nil {
+			return nil, err
+		}
+		reg.GasLimit = gasLimit.Data.GasLimit
+	}
+	if reg.Timestamp == 0 {
+		reg.Timestamp = uint64(prysmTime.Now().UTC().Unix())
+	}
+	signedReg, err := client.SignValidatorRegistration(ctx, km.Sign, reg)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not sign validator registration: %v", err)
+	}
+	return &ethpbservice.SignValidatorRegistrationResponse{
+		Data: &ethpbv1.SubmitValidatorRegistrationsRequest_SignedValidatorRegistration{
+			Message: &ethpbv1.SubmitValidatorRegistrationsRequest_ValidatorRegistration{
+				FeeRecipient: signedReg.Message.FeeRecipient,
+				GasLimit:     signedReg.Message.GasLimit,
+				Timestamp:    signedReg.Message.Timestamp,
+				Pubkey:       signedReg.Message.Pubkey,
+			},
+			Signature: signedReg.Signature,
+		},
+	}, nil
+}
+
+// Returns the keymanager of the validator client if it holds the public key, local or remote.
+func (s *Server) keymanagerWithKey(ctx context.Context, pubkey []byte) (keymanager.IKeymanager, error) {
+	km, err := s.validatorService.Keymanager()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get keymanager: %v", err)
+	}
+	pubKeys, err := km.FetchValidatingPublicKeys(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not fetch validating public keys: %v", err)
+	}
+	for _, key := range pubKeys {
+		if bytes.Equal(key[:], pubkey) {
+			return km, nil
+		}
+	}
+	return nil, status.Errorf(codes.NotFound, "Validating public key %#x not found", pubkey)
+}
+
+// Sets the proposer settings of the validator, which also writes them back to the proposer settings
+// file when proposer settings reloading is enabled.
+func (s *Server) setProposerSettings(settings *validatorServiceConfig.ProposerSettings) error {
+	if err := s.validatorService.SetProposerSettings(settings); err != nil {
+		return status.Errorf(codes.Internal, "Could not save proposer settings: %v", err)
+	}
+	return nil
+}
+
 func validatePublicKey(pubkey []byte) error {
 	if len(pubkey) != fieldparams.BLSPubkeyLength {
 		return status.Errorf(
@@ -5,6 +5,7 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -13,9 +14,11 @@ import (
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/google/uuid"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/signing"
 	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v3/config/params"
 	validatorserviceconfig "github.com/prysmaticlabs/prysm/v3/config/validator/service"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v3/crypto/bls"
 	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
 	ethpbservice "github.com/prysmaticlabs/prysm/v3/proto/eth/service"
@@ -32,12 +35,14 @@ import (
 	"github.com/prysmaticlabs/prysm/v3/validator/db/kv"
 	"github.com/prysmaticlabs/prysm/v3/validator/keymanager"
 	"github.com/prysmaticlabs/prysm/v3/validator/keymanager/derived"
+	"github.com/prysmaticlabs/prysm/v3/validator/keymanager/local"
 	remoteweb3signer "github.com/prysmaticlabs/prysm/v3/validator/keymanager/remote-web3signer"
 	"github.com/prysmaticlabs/prysm/v3/validator/slashing-protection-history/format"
 	mocks "github.com/prysmaticlabs/prysm/v3/validator/testing"
 	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func TestServer_ListKeystores(t *testing.T) {
@@ -1310,3 +1315,323 @@ func TestServer_DeleteGasLimit(t *testing.T) {
 		})
 	}
 }
+
+func TestServer_GetGraffiti(t *testing.T) {
+	ctx := context.Background()
+	pubkey1, err := hexutil.Decode("0xaf2e7ba294e03438ea819bd4033c6c1bf6b04320ee2075b77273c08d02f8a61bcc303c2c06bd3713cb442072ae591493")
+	require.NoError(t, err)
+	pubkey2, err := hexutil.Decode("0xbedefeaa94e03438ea819bd4033c6c1bf6b04320ee2075b77273c08d02f8a61bcc303c2cdddddddddddddddddddddddd")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name             string
+		pubkey           []byte
+		proposerSettings *validatorserviceconfig.ProposerSettings
+		want             string
+	}{
+		{
+			name:   "graffiti of the public key",
+			pubkey: pubkey1,
+			proposerSettings: &validatorserviceconfig.ProposerSettings{
+				ProposeConfig: map[[48]byte]*validatorserviceconfig.ProposerOption{
+					bytesutil.ToBytes48(pubkey1): {Graffiti: "{{.CL}} #{{.Index}}"},
+				},
+				DefaultConfig: &validatorserviceconfig.ProposerOption{Graffiti: "default"},
+			},
+			want: "{{.CL}} #{{.Index}}",
+		},
+		{
+			name:   "default graffiti",
+			pubkey: pubkey2,
+			proposerSettings: &validatorserviceconfig.ProposerSettings{
+				ProposeConfig: map[[48]byte]*validatorserviceconfig.ProposerOption{
+					bytesutil.ToBytes48(pubkey1): {Graffiti: "{{.CL}} #{{.Index}}"},
+				},
+				DefaultConfig: &validatorserviceconfig.ProposerOption{Graffiti: "default"},
+			},
+			want: "default",
+		},
+		{
+			name:   "no proposer settings",
+			pubkey: pubkey1,
+			want:   "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &mock.MockValidator{}
+			m.SetProposerSettings(tt.proposerSettings)
+			vs, err := client.NewValidatorService(ctx, &client.Config{
+				Validator: m,
+			})
+			require.NoError(t, err)
+			s := &Server{
+				validatorService: vs,
+			}
+			got, err := s.GetGraffiti(ctx, &ethpbservice.PubkeyRequest{Pubkey: tt.pubkey})
+			require.NoError(t, err)
+			assert.DeepEqual(t, tt.pubkey, got.Data.Pubkey)
+			assert.Equal(t, tt.want, got.Data.Graffiti)
+		})
+	}
+}
+
+func TestServer_SetGraffiti(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	beaconClient := mock2.NewMockBeaconNodeValidatorClient(ctrl)
+	ctx := grpc.NewContextWithServerTransportStream(context.Background(), &runtime.ServerTransportStream{})
+	pubkey1, err := hexutil.Decode("0xaf2e7ba294e03438ea819bd4033c6c1bf6b04320ee2075b77273c08d02f8a61bcc303c2c06bd3713cb442072ae591493")
+	require.NoError(t, err)
+	pubkey2, err := hexutil.Decode("0xbedefeaa94e03438ea819bd4033c6c1bf6b04320ee2075b77273c08d02f8a61bcc303c2cdddddddddddddddddddddddd")
+	require.NoError(t, err)
+	feeRecipient := common.HexToAddress("0x046Fb65722E7b2455012BFEBf6177F1D2e9738D9")
+
+	tests := []struct {
+		name             string
+		pubkey           []byte
+		graffiti         string
+		proposerSettings *validatorserviceconfig.ProposerSettings
+		beaconReturn     bool
+		wantErr          string
+	}{
+		{
+			name:     "update existing proposer option",
+			pubkey:   pubkey1,
+			graffiti: "{{.CL}}/{{.EL}} #{{.Index}}",
+			proposerSettings: &validatorserviceconfig.ProposerSettings{
+				ProposeConfig: map[[48]byte]*validatorserviceconfig.ProposerOption{
+					bytesutil.ToBytes48(pubkey1): {FeeRecipient: feeRecipient},
+				},
+			},
+		},
+		{
+			name:     "new proposer option from default config",
+			pubkey:   pubkey2,
+			graffiti: "hello",
+			proposerSettings: &validatorserviceconfig.ProposerSettings{
+				DefaultConfig: &validatorserviceconfig.ProposerOption{
+					FeeRecipient:  feeRecipient,
+					BuilderConfig: &validatorserviceconfig.BuilderConfig{Enabled: true, GasLimit: 123456789},
+				},
+			},
+		},
+		{
+			name:         "no proposer settings",
+			pubkey:       pubkey1,
+			graffiti:     "hello",
+			beaconReturn: true,
+		},
+		{
+			name:     "invalid template",
+			pubkey:   pubkey1,
+			graffiti: "{{.CL",
+			wantErr:  "could not parse graffiti template",
+		},
+		{
+			name:     "graffiti too long",
+			pubkey:   pubkey1,
+			graffiti: strings.Repeat("a", 33),
+			wantErr:  "graffiti is longer than 32 bytes",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &mock.MockValidator{}
+			m.SetProposerSettings(tt.proposerSettings)
+			vs, err := client.NewValidatorService(ctx, &client.Config{
+				Validator: m,
+			})
+			require.NoError(t, err)
+			s := &Server{
+				validatorService:          vs,
+				beaconNodeValidatorClient: beaconClient,
+			}
+			if tt.beaconReturn {
+				beaconClient.EXPECT().GetFeeRecipientByPubKey(
+					gomock.Any(),
+					gomock.Any(),
+				).Return(&eth.FeeRecipientByPubKeyResponse{FeeRecipient: feeRecipient.Bytes()}, nil)
+			}
+			_, err = s.SetGraffiti(ctx, &ethpbservice.SetGraffitiRequest{Pubkey: tt.pubkey, Graffiti: tt.graffiti})
+			if tt.wantErr != "" {
+				require.ErrorContains(t, tt.wantErr, err)
+				return
+			}
+			require.NoError(t, err)
+			option := s.validatorService.ProposerSettings().ProposeConfig[bytesutil.ToBytes48(tt.pubkey)]
+			require.NotNil(t, option)
+			assert.Equal(t, tt.graffiti, option.Graffiti)
+			assert.Equal(t, feeRecipient, option.FeeRecipient)
+			defaultConfig := s.validatorService.ProposerSettings().DefaultConfig
+			if defaultConfig != nil && defaultConfig.BuilderConfig != nil {
+				assert.DeepEqual(t, defaultConfig.BuilderConfig, option.BuilderConfig)
+			}
+		})
+	}
+}
+
+func TestServer_DeleteGraffiti(t *testing.T) {
+	ctx := grpc.NewContextWithServerTransportStream(context.Background(), &runtime.ServerTransportStream{})
+	pubkey1, err := hexutil.Decode("0xaf2e7ba294e03438ea819bd4033c6c1bf6b04320ee2075b77273c08d02f8a61bcc303c2c06bd3713cb442072ae591493")
+	require.NoError(t, err)
+	pubkey2, err := hexutil.Decode("0xbedefeaa94e03438ea819bd4033c6c1bf6b04320ee2075b77273c08d02f8a61bcc303c2cdddddddddddddddddddddddd")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name             string
+		pubkey           []byte
+		proposerSettings *validatorserviceconfig.ProposerSettings
+		wantError        error
+	}{
+		{
+			name:   "delete existing graffiti",
+			pubkey: pubkey1,
+			proposerSettings: &validatorserviceconfig.ProposerSettings{
+				ProposeConfig: map[[48]byte]*validatorserviceconfig.ProposerOption{
+					bytesutil.ToBytes48(pubkey1): {Graffiti: "hello"},
+					bytesutil.ToBytes48(pubkey2): {Graffiti: "world"},
+				},
+			},
+		},
+		{
+			name:   "delete nonexist graffiti",
+			pubkey: pubkey2,
+			proposerSettings: &validatorserviceconfig.ProposerSettings{
+				ProposeConfig: map[[48]byte]*validatorserviceconfig.ProposerOption{
+					bytesutil.ToBytes48(pubkey1): {Graffiti: "hello"},
+					bytesutil.ToBytes48(pubkey2): {},
+				},
+			},
+			wantError: fmt.Errorf("%s", codes.NotFound.String()),
+		},
+		{
+			name:      "delete nonexist graffiti without proposer settings",
+			pubkey:    pubkey2,
+			wantError: fmt.Errorf("%s", codes.NotFound.String()),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &mock.MockValidator{}
+			m.SetProposerSettings(tt.proposerSettings)
+			vs, err := client.NewValidatorService(ctx, &client.Config{
+				Validator: m,
+			})
+			require.NoError(t, err)
+			s := &Server{
+				validatorService: vs,
+			}
+			_, err = s.DeleteGraffiti(ctx, &ethpbservice.DeleteGraffitiRequest{Pubkey: tt.pubkey})
+			if tt.wantError != nil {
+				assert.ErrorContains(t, fmt.Sprintf("code = %s", tt.wantError.Error()), err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "", s.validatorService.ProposerSettings().ProposeConfig[bytesutil.ToBytes48(tt.pubkey)].Graffiti)
+			// Other public keys are unaffected.
+			assert.Equal(t, "world", s.validatorService.ProposerSettings().ProposeConfig[bytesutil.ToBytes48(pubkey2)].Graffiti)
+		})
+	}
+}
+
+func TestServer_SetVoluntaryExit(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	km, err := local.NewInteropKeymanager(ctx, 0, 1)
+	require.NoError(t, err)
+	pubKeys, err := km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	vs, err := client.NewValidatorService(ctx, &client.Config{
+		Validator: &mock.MockValidator{Km: km},
+	})
+	require.NoError(t, err)
+	beaconClient := mock2.NewMockBeaconNodeValidatorClient(ctrl)
+	nodeClient := mock2.NewMockNodeClient(ctrl)
+	s := &Server{
+		validatorService:          vs,
+		beaconNodeValidatorClient: beaconClient,
+		beaconNodeClient:          nodeClient,
+	}
+
+	t.Run("unknown public key", func(t *testing.T) {
+		_, err := s.SetVoluntaryExit(ctx, &ethpbservice.SetVoluntaryExitRequest{Pubkey: make([]byte, fieldparams.BLSPubkeyLength)})
+		require.ErrorContains(t, "not found", err)
+	})
+	t.Run("signs exit", func(t *testing.T) {
+		domain := make([]byte, 32)
+		beaconClient.EXPECT().ValidatorIndex(gomock.Any(), &eth.ValidatorIndexRequest{PublicKey: pubKeys[0][:]}).
+			Return(&eth.ValidatorIndexResponse{Index: 3}, nil)
+		nodeClient.EXPECT().GetGenesis(gomock.Any(), gomock.Any()).
+			Return(&eth.Genesis{GenesisTime: timestamppb.Now()}, nil)
+		beaconClient.EXPECT().DomainData(gomock.Any(), &eth.DomainRequest{Epoch: 10, Domain: params.BeaconConfig().DomainVoluntaryExit[:]}).
+			Return(&eth.DomainResponse{SignatureDomain: domain}, nil)
+
+		resp, err := s.SetVoluntaryExit(ctx, &ethpbservice.SetVoluntaryExitRequest{Pubkey: pubKeys[0][:], Epoch: 10})
+		require.NoError(t, err)
+		assert.Equal(t, types.Epoch(10), resp.Data.Message.Epoch)
+		assert.Equal(t, types.ValidatorIndex(3), resp.Data.Message.ValidatorIndex)
+		exit := &eth.VoluntaryExit{Epoch: 10, ValidatorIndex: 3}
+		require.NoError(t, signing.VerifySigningRoot(exit, pubKeys[0][:], resp.Data.Signature, domain))
+	})
+}
+
+func TestServer_SignValidatorRegistration(t *testing.T) {
+	ctx := context.Background()
+	km, err := local.NewInteropKeymanager(ctx, 0, 1)
+	require.NoError(t, err)
+	pubKeys, err := km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	m := &mock.MockValidator{Km: km}
+	m.SetProposerSettings(&validatorserviceconfig.ProposerSettings{
+		DefaultConfig: &validatorserviceconfig.ProposerOption{
+			FeeRecipient:  common.HexToAddress("0x046Fb65722E7b2455012BFEBf6177F1D2e9738D9"),
+			BuilderConfig: &validatorserviceconfig.BuilderConfig{Enabled: true, GasLimit: 35000000},
+		},
+	})
+	vs, err := client.NewValidatorService(ctx, &client.Config{Validator: m})
+	require.NoError(t, err)
+	s := &Server{validatorService: vs}
+	domain, err := signing.ComputeDomain(params.BeaconConfig().DomainApplicationBuilder, nil, nil)
+	require.NoError(t, err)
+
+	t.Run("defaults to proposer settings", func(t *testing.T) {
+		resp, err := s.SignValidatorRegistration(ctx, &ethpbservice.SignValidatorRegistrationRequest{Pubkey: pubKeys[0][:]})
+		require.NoError(t, err)
+		assert.DeepEqual(t, common.HexToAddress("0x046Fb65722E7b2455012BFEBf6177F1D2e9738D9").Bytes(), resp.Data.Message.FeeRecipient)
+		assert.Equal(t, uint64(35000000), resp.Data.Message.GasLimit)
+		assert.NotEqual(t, uint64(0), resp.Data.Message.Timestamp)
+		reg := &eth.ValidatorRegistrationV1{
+			FeeRecipient: resp.Data.Message.FeeRecipient,
+			GasLimit:     resp.Data.Message.GasLimit,
+			Timestamp:    resp.Data.Message.Timestamp,
+			Pubkey:       resp.Data.Message.Pubkey,
+		}
+		require.NoError(t, signing.VerifySigningRoot(reg, pubKeys[0][:], resp.Data.Signature, domain))
+	})
+	t.Run("uses requested fields", func(t *testing.T) {
+		feeRecipient := common.HexToAddress("0x50155530FCE8a85ec7055A5F8b2bE214B3DaeFd3").Bytes()
+		resp, err := s.SignValidatorRegistration(ctx, &ethpbservice.SignValidatorRegistrationRequest{
+			Pubkey:       pubKeys[0][:],
+			FeeRecipient: feeRecipient,
+			GasLimit:     30000000,
+			Timestamp:    1000,
+		})
+		require.NoError(t, err)
+		assert.DeepEqual(t, feeRecipient, resp.Data.Message.FeeRecipient)
+		assert.Equal(t, uint64(30000000), resp.Data.Message.GasLimit)
+		assert.Equal(t, uint64(1000), resp.Data.Message.Timestamp)
+	})
+	t.Run("invalid fee recipient", func(t *testing.T) {
+		_, err := s.SignValidatorRegistration(ctx, &ethpbservice.SignValidatorRegistrationRequest{
+			Pubkey:       pubKeys[0][:],
+			FeeRecipient: []byte{1, 2},
+		})
+		require.ErrorContains(t, "Fee recipient is not byte length 20", err)
+	})
+	t.Run("unknown public key", func(t *testing.T) {
+		_, err := s.SignValidatorRegistration(ctx, &ethpbservice.SignValidatorRegistrationRequest{Pubkey: make([]byte, fieldparams.BLSPubkeyLength)})
+		require.ErrorContains(t, "not found", err)
+	})
+}
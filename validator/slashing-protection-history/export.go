@@ -10,12 +10,29 @@ import (
 	"github.com/pkg/errors"
 	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v3/config/params"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v3/monitoring/progress"
+	"github.com/prysmaticlabs/prysm/v3/time/slots"
 	"github.com/prysmaticlabs/prysm/v3/validator/db"
 	"github.com/prysmaticlabs/prysm/v3/validator/slashing-protection-history/format"
 )
 
+// ExportOptions limits the slashing protection data exported from a validator database.
+type ExportOptions struct {
+	// PublicKeys limits the export to the given validator public keys. Every key is exported if empty.
+	PublicKeys [][]byte
+	// StartEpoch and EndEpoch limit the export to the blocks and attestations whose epoch, or target
+	// epoch for attestations, is within the inclusive window. An EndEpoch of zero sets no upper bound.
+	StartEpoch types.Epoch
+	EndEpoch   types.Epoch
+	// Minimal only exports the lowest and highest watermarks of each key, as at most two signed blocks
+	// and two signed attestations without signing roots. Such an export should be imported with
+	// MergeStandardProtectionJSON, as its entries conflict with any existing history at the same
+	// slots and target epochs.
+	Minimal bool
+}
+
 // ExportStandardProtectionJSON extracts all slashing protection data from a validator database
 // and packages it into an EIP-3076 compliant, standard
 func ExportStandardProtectionJSON(
@@ -23,6 +40,24 @@ func ExportStandardProtectionJSON(
 	validatorDB db.Database,
 	filteredKeys ...[]byte,
 ) (*format.EIPSlashingProtectionFormat, error) {
+	return ExportStandardProtectionJSONWithOptions(ctx, validatorDB, &ExportOptions{PublicKeys: filteredKeys})
+}
+
+// ExportStandardProtectionJSONWithOptions extracts the slashing protection data selected by the
+// export options from a validator database, and packages it into an EIP-3076 compliant, standard
+// JSON format.
+func ExportStandardProtectionJSONWithOptions(
+	ctx context.Context,
+	validatorDB db.Database,
+	opts *ExportOptions,
+) (*format.EIPSlashingProtectionFormat, error) {
+	if opts == nil {
+		opts = &ExportOptions{}
+	}
+	if opts.EndEpoch != 0 && opts.EndEpoch < opts.StartEpoch {
+		return nil, fmt.Errorf("end epoch %d is lower than start epoch %d", opts.EndEpoch, opts.StartEpoch)
+	}
+	filteredKeys := opts.PublicKeys
 	interchangeJSON := &format.EIPSlashingProtectionFormat{}
 	genesisValidatorsRoot, err := validatorDB.GenesisValidatorsRoot(ctx)
 	if err != nil {
@@ -69,7 +104,7 @@ func ExportStandardProtectionJSON(
 		if err != nil {
 			return nil, errors.Wrap(err, "could not convert public key to hex string")
 		}
-		signedBlocks, err := signedBlocksByPubKey(ctx, validatorDB, pubKey)
+		signedBlocks, err := signedBlocksByPubKey(ctx, validatorDB, pubKey, opts)
 		if err != nil {
 			return nil, errors.Wrapf(err, "could not retrieve signed blocks for public key %s", pubKeyHex)
 		}
@@ -95,7 +130,7 @@ func ExportStandardProtectionJSON(
 		if err != nil {
 			return nil, errors.Wrap(err, "could not convert public key to hex string")
 		}
-		signedAttestations, err := signedAttestationsByPubKey(ctx, validatorDB, pubKey)
+		signedAttestations, err := signedAttestationsByPubKey(ctx, validatorDB, pubKey, opts)
 		if err != nil {
 			return nil, errors.Wrapf(err, "could not retrieve signed attestations for public key %s", pubKeyHex)
 		}
@@ -122,6 +157,13 @@ func ExportStandardProtectionJSON(
 		if item.SignedBlocks == nil {
 			item.SignedBlocks = make([]*format.SignedBlock, 0)
 		}
+		if opts.Minimal {
+			minimal, err := minimalProtectionData(item)
+			if err != nil {
+				return nil, errors.Wrapf(err, "could not compute watermarks for public key %s", item.Pubkey)
+			}
+			item = minimal
+		}
 		dataList = append(dataList, item)
 	}
 	sort.Slice(dataList, func(i, j int) bool {
@@ -131,7 +173,14 @@ func ExportStandardProtectionJSON(
 	return interchangeJSON, nil
 }
 
-func signedAttestationsByPubKey(ctx context.Context, validatorDB db.Database, pubKey [fieldparams.BLSPubkeyLength]byte) ([]*format.SignedAttestation, error) {
+// inWindow returns true if the epoch is within the epoch window of the export options.
+func (opts *ExportOptions) inWindow(epoch types.Epoch) bool {
+	return epoch >= opts.StartEpoch && (opts.EndEpoch == 0 || epoch <= opts.EndEpoch)
+}
+
+func signedAttestationsByPubKey(
+	ctx context.Context, validatorDB db.Database, pubKey [fieldparams.BLSPubkeyLength]byte, opts *ExportOptions,
+) ([]*format.SignedAttestation, error) {
 	// If a key does not have an attestation history in our database, we return nil.
 	// This way, a user will be able to export their slashing protection history
 	// even if one of their keys does not have a history of signed attestations.
@@ -157,6 +206,9 @@ func signedAttestationsByPubKey(ctx context.Context, validatorDB db.Database, pu
 				continue
 			}
 		}
+		if !opts.inWindow(att.Target) {
+			continue
+		}
 		var root string
 		if !bytes.Equal(att.SigningRoot[:], params.BeaconConfig().ZeroHash[:]) {
 			root, err = rootToHexString(att.SigningRoot[:])
@@ -173,7 +225,9 @@ func signedAttestationsByPubKey(ctx context.Context, validatorDB db.Database, pu
 	return signedAttestations, nil
 }
 
-func signedBlocksByPubKey(ctx context.Context, validatorDB db.Database, pubKey [fieldparams.BLSPubkeyLength]byte) ([]*format.SignedBlock, error) {
+func signedBlocksByPubKey(
+	ctx context.Context, validatorDB db.Database, pubKey [fieldparams.BLSPubkeyLength]byte, opts *ExportOptions,
+) ([]*format.SignedBlock, error) {
 	// If a key does not have a lowest or highest signed proposal history
 	// in our database, we return nil. This way, a user will be able to export their
 	// slashing protection history even if one of their keys does not have a history
@@ -187,6 +241,9 @@ func signedBlocksByPubKey(ctx context.Context, validatorDB db.Database, pubKey [
 		if ctx.Err() != nil {
 			return nil, errors.Wrap(err, "context canceled")
 		}
+		if !opts.inWindow(slots.ToEpoch(proposal.Slot)) {
+			continue
+		}
 		signingRootHex, err := rootToHexString(proposal.SigningRoot)
 		if err != nil {
 			return nil, errors.Wrap(err, "could not convert signing root to hex string")
@@ -198,3 +255,73 @@ func signedBlocksByPubKey(ctx context.Context, validatorDB db.Database, pubKey [
 	}
 	return signedBlocks, nil
 }
+
+// minimalProtectionData reduces the signed blocks and attestations of a key to its lowest and highest
+// watermarks. The lowest and highest signed slots are kept as signed blocks, and the lowest and highest
+// source and target epochs are kept as two signed attestations, which never surround each other.
+// Signing roots are dropped, so any block or attestation at the watermarks is refused after import.
+func minimalProtectionData(data *format.ProtectionData) (*format.ProtectionData, error) {
+	minimal := &format.ProtectionData{
+		Pubkey:             data.Pubkey,
+		SignedBlocks:       make([]*format.SignedBlock, 0),
+		SignedAttestations: make([]*format.SignedAttestation, 0),
+	}
+	var lowestSlot, highestSlot types.Slot
+	for i, blk := range data.SignedBlocks {
+		slot, err := SlotFromString(blk.Slot)
+		if err != nil {
+			return nil, fmt.Errorf("%s is not a valid slot: %w", blk.Slot, err)
+		}
+		if i == 0 || slot < lowestSlot {
+			lowestSlot = slot
+		}
+		if i == 0 || slot > highestSlot {
+			highestSlot = slot
+		}
+	}
+	if len(data.SignedBlocks) > 0 {
+		if lowestSlot != highestSlot {
+			minimal.SignedBlocks = append(minimal.SignedBlocks, &format.SignedBlock{Slot: fmt.Sprintf("%d", lowestSlot)})
+		}
+		minimal.SignedBlocks = append(minimal.SignedBlocks, &format.SignedBlock{Slot: fmt.Sprintf("%d", highestSlot)})
+	}
+
+	var lowestSource, lowestTarget, highestSource, highestTarget types.Epoch
+	for i, att := range data.SignedAttestations {
+		source, err := EpochFromString(att.SourceEpoch)
+		if err != nil {
+			return nil, fmt.Errorf("%s is not a valid epoch: %w", att.SourceEpoch, err)
+		}
+		target, err := EpochFromString(att.TargetEpoch)
+		if err != nil {
+			return nil, fmt.Errorf("%s is not a valid epoch: %w", att.TargetEpoch, err)
+		}
+		if i == 0 || source < lowestSource {
+			lowestSource = source
+		}
+		if i == 0 || target < lowestTarget {
+			lowestTarget = target
+		}
+		if i == 0 || source > highestSource {
+			highestSource = source
+		}
+		if i == 0 || target > highestTarget {
+			highestTarget = target
+		}
+	}
+	if len(data.SignedAttestations) > 0 {
+		// Two attestations with the same target epoch would be a double vote, so only the
+		// highest watermarks are kept when every attestation has the same target epoch.
+		if lowestTarget != highestTarget {
+			minimal.SignedAttestations = append(minimal.SignedAttestations, &format.SignedAttestation{
+				SourceEpoch: fmt.Sprintf("%d", lowestSource),
+				TargetEpoch: fmt.Sprintf("%d", lowestTarget),
+			})
+		}
+		minimal.SignedAttestations = append(minimal.SignedAttestations, &format.SignedAttestation{
+			SourceEpoch: fmt.Sprintf("%d", highestSource),
+			TargetEpoch: fmt.Sprintf("%d", highestTarget),
+		})
+	}
+	return minimal, nil
+}
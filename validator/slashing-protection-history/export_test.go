@@ -37,7 +37,7 @@ func Test_getSignedAttestationsByPubKey(t *testing.T) {
 		validatorDB := dbtest.SetupDB(t, pubKeys)
 
 		// No attestation history stored should return empty.
-		signedAttestations, err := signedAttestationsByPubKey(ctx, validatorDB, pubKeys[0])
+		signedAttestations, err := signedAttestationsByPubKey(ctx, validatorDB, pubKeys[0], &ExportOptions{})
 		require.NoError(t, err)
 		assert.Equal(t, 0, len(signedAttestations))
 
@@ -55,7 +55,7 @@ func Test_getSignedAttestationsByPubKey(t *testing.T) {
 		)))
 
 		// We then retrieve the signed attestations and expect a correct result.
-		signedAttestations, err = signedAttestationsByPubKey(ctx, validatorDB, pubKeys[0])
+		signedAttestations, err = signedAttestationsByPubKey(ctx, validatorDB, pubKeys[0], &ExportOptions{})
 		require.NoError(t, err)
 
 		wanted := []*format.SignedAttestation{
@@ -80,7 +80,7 @@ func Test_getSignedAttestationsByPubKey(t *testing.T) {
 		validatorDB := dbtest.SetupDB(t, pubKeys)
 
 		// No attestation history stored should return empty.
-		signedAttestations, err := signedAttestationsByPubKey(ctx, validatorDB, pubKeys[0])
+		signedAttestations, err := signedAttestationsByPubKey(ctx, validatorDB, pubKeys[0], &ExportOptions{})
 		require.NoError(t, err)
 		assert.Equal(t, 0, len(signedAttestations))
 
@@ -102,7 +102,7 @@ func Test_getSignedAttestationsByPubKey(t *testing.T) {
 
 		// We then retrieve the signed attestations and expect to have
 		// skipped the 0th, corrupted entry.
-		signedAttestations, err = signedAttestationsByPubKey(ctx, validatorDB, pubKeys[0])
+		signedAttestations, err = signedAttestationsByPubKey(ctx, validatorDB, pubKeys[0], &ExportOptions{})
 		require.NoError(t, err)
 
 		wanted := []*format.SignedAttestation{
@@ -122,7 +122,7 @@ func Test_getSignedAttestationsByPubKey(t *testing.T) {
 		validatorDB := dbtest.SetupDB(t, pubKeys)
 
 		// No attestation history stored should return empty.
-		signedAttestations, err := signedAttestationsByPubKey(ctx, validatorDB, pubKeys[0])
+		signedAttestations, err := signedAttestationsByPubKey(ctx, validatorDB, pubKeys[0], &ExportOptions{})
 		require.NoError(t, err)
 		assert.Equal(t, 0, len(signedAttestations))
 
@@ -144,7 +144,7 @@ func Test_getSignedAttestationsByPubKey(t *testing.T) {
 
 		// We then retrieve the signed attestations and do not expect changes
 		// as the bug only manifests in the genesis epoch.
-		signedAttestations, err = signedAttestationsByPubKey(ctx, validatorDB, pubKeys[0])
+		signedAttestations, err = signedAttestationsByPubKey(ctx, validatorDB, pubKeys[0], &ExportOptions{})
 		require.NoError(t, err)
 
 		wanted := []*format.SignedAttestation{
@@ -171,7 +171,7 @@ func Test_getSignedBlocksByPubKey(t *testing.T) {
 	validatorDB := dbtest.SetupDB(t, pubKeys)
 
 	// No highest and/or lowest signed blocks will return empty.
-	signedBlocks, err := signedBlocksByPubKey(ctx, validatorDB, pubKeys[0])
+	signedBlocks, err := signedBlocksByPubKey(ctx, validatorDB, pubKeys[0], &ExportOptions{})
 	require.NoError(t, err)
 	assert.Equal(t, 0, len(signedBlocks))
 
@@ -191,7 +191,7 @@ func Test_getSignedBlocksByPubKey(t *testing.T) {
 
 	// We expect a valid proposal history containing slot 1 and slot 5 only
 	// when we attempt to retrieve it from disk.
-	signedBlocks, err = signedBlocksByPubKey(ctx, validatorDB, pubKeys[0])
+	signedBlocks, err = signedBlocksByPubKey(ctx, validatorDB, pubKeys[0], &ExportOptions{})
 	require.NoError(t, err)
 	wanted := []*format.SignedBlock{
 		{
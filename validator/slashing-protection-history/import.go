@@ -3,7 +3,6 @@ package history
 import (
 	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
 	"io"
 
@@ -23,13 +22,9 @@ import (
 // protection in the validator client's database. For more information, see the EIP document here:
 // https://eips.ethereum.org/EIPS/eip-3076.
 func ImportStandardProtectionJSON(ctx context.Context, validatorDB db.Database, r io.Reader) error {
-	encodedJSON, err := io.ReadAll(r)
+	interchangeJSON, err := readProtectionJSON(r)
 	if err != nil {
-		return errors.Wrap(err, "could not read slashing protection JSON file")
-	}
-	interchangeJSON := &format.EIPSlashingProtectionFormat{}
-	if err := json.Unmarshal(encodedJSON, interchangeJSON); err != nil {
-		return errors.Wrap(err, "could not unmarshal slashing protection JSON file")
+		return err
 	}
 	if interchangeJSON.Data == nil {
 		log.Warn("No slashing protection data to import")
@@ -0,0 +1,347 @@
+package history
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/validator/db"
+	"github.com/prysmaticlabs/prysm/v3/validator/db/kv"
+	"github.com/prysmaticlabs/prysm/v3/validator/slashing-protection-history/format"
+	"github.com/sirupsen/logrus"
+)
+
+// MergeStandardProtectionJSON imports an EIP-3076 compliant JSON file into the validator database,
+// merging it with the existing slashing protection history instead of rejecting conflicting keys.
+//
+// Conflicts are resolved with watermark semantics: a block or attestation which conflicts with the
+// existing history, or with another entry of the file, is saved without its signing root, so the
+// validator refuses to sign anything else at its slot or target epoch. For every key in the file, the
+// lowest signed proposal slot and the lowest signed source and target epochs are then raised to the
+// highest slot and epochs of the file, so the validator refuses to sign anything at or below them.
+func MergeStandardProtectionJSON(ctx context.Context, validatorDB db.Database, r io.Reader) error {
+	interchangeJSON, err := readProtectionJSON(r)
+	if err != nil {
+		return err
+	}
+	if interchangeJSON.Data == nil {
+		log.Warn("No slashing protection data to merge")
+		return nil
+	}
+	if err := validateMetadata(ctx, validatorDB, interchangeJSON); err != nil {
+		return errors.Wrap(err, "slashing protection JSON metadata was incorrect")
+	}
+	signedBlocksByPubKey, err := parseBlocksForUniquePublicKeys(interchangeJSON.Data)
+	if err != nil {
+		return errors.Wrap(err, "could not parse unique entries for blocks by public key")
+	}
+	signedAttsByPubKey, err := parseAttestationsForUniquePublicKeys(interchangeJSON.Data)
+	if err != nil {
+		return errors.Wrap(err, "could not parse unique entries for attestations by public key")
+	}
+
+	// Parse everything before writing, so a malformed file does not leave a partial merge behind.
+	proposalHistoryByPubKey := make(map[[fieldparams.BLSPubkeyLength]byte]*kv.ProposalHistoryForPubkey)
+	for pubKey, signedBlocks := range signedBlocksByPubKey {
+		proposalHistory, err := transformSignedBlocks(ctx, signedBlocks)
+		if err != nil {
+			return errors.Wrapf(err, "could not parse signed blocks in JSON file for key %#x", pubKey)
+		}
+		proposalHistoryByPubKey[pubKey] = proposalHistory
+	}
+	attestingHistoryByPubKey := make(map[[fieldparams.BLSPubkeyLength]byte][]*kv.AttestationRecord)
+	for pubKey, signedAtts := range signedAttsByPubKey {
+		historicalAtts, err := transformSignedAttestations(pubKey, signedAtts)
+		if err != nil {
+			return errors.Wrapf(err, "could not parse signed attestations in JSON file for key %#x", pubKey)
+		}
+		attestingHistoryByPubKey[pubKey] = historicalAtts
+	}
+
+	for pubKey, proposalHistory := range proposalHistoryByPubKey {
+		if err := mergeProposals(ctx, validatorDB, pubKey, proposalHistory.Proposals); err != nil {
+			return errors.Wrapf(err, "could not merge proposals for key %#x", pubKey)
+		}
+	}
+	for pubKey, attestations := range attestingHistoryByPubKey {
+		if err := mergeAttestations(ctx, validatorDB, pubKey, attestations); err != nil {
+			return errors.Wrapf(err, "could not merge attestations for key %#x", pubKey)
+		}
+	}
+	return nil
+}
+
+func mergeProposals(
+	ctx context.Context, validatorDB db.Database, pubKey [fieldparams.BLSPubkeyLength]byte, proposals []kv.Proposal,
+) error {
+	if len(proposals) == 0 {
+		return nil
+	}
+	var highestSlot types.Slot
+	conflicts := 0
+	for _, proposal := range proposals {
+		signingRoot := proposal.SigningRoot
+		// Proposals are saved one by one, so a conflict with an earlier entry of the file is
+		// found in the database as well.
+		existingRoot, exists, err := validatorDB.ProposalHistoryForSlot(ctx, pubKey, proposal.Slot)
+		if err != nil {
+			return err
+		}
+		if exists && !bytes.Equal(existingRoot[:], signingRoot) {
+			signingRoot = make([]byte, 32)
+			conflicts++
+		}
+		if err := validatorDB.SaveProposalHistoryForSlot(ctx, pubKey, proposal.Slot, signingRoot); err != nil {
+			return err
+		}
+		if proposal.Slot > highestSlot {
+			highestSlot = proposal.Slot
+		}
+	}
+	if conflicts > 0 {
+		log.WithFields(logrus.Fields{
+			"publicKey": fmt.Sprintf("%#x", bytesutil.Trunc(pubKey[:])),
+			"conflicts": conflicts,
+		}).Warn("Merged conflicting proposals without their signing roots")
+	}
+	return validatorDB.RaiseLowestSignedProposal(ctx, pubKey, highestSlot)
+}
+
+func mergeAttestations(
+	ctx context.Context, validatorDB db.Database, pubKey [fieldparams.BLSPubkeyLength]byte, records []*kv.AttestationRecord,
+) error {
+	if len(records) == 0 {
+		return nil
+	}
+	// Attestations with the same target epoch but different signing roots within the file are
+	// double votes, so their target epoch is saved without a signing root.
+	signingRootsByTarget := make(map[types.Epoch][32]byte, len(records))
+	for _, record := range records {
+		if root, ok := signingRootsByTarget[record.Target]; ok && root != record.SigningRoot {
+			signingRootsByTarget[record.Target] = [32]byte{}
+			continue
+		}
+		signingRootsByTarget[record.Target] = record.SigningRoot
+	}
+
+	var highestSource, highestTarget types.Epoch
+	conflicts := 0
+	indexedAtts := make([]*ethpb.IndexedAttestation, len(records))
+	signingRoots := make([][32]byte, len(records))
+	for i, record := range records {
+		indexedAtt := createAttestation(record.Source, record.Target)
+		signingRoot := signingRootsByTarget[record.Target]
+		slashable, err := validatorDB.CheckSlashableAttestation(ctx, pubKey, signingRoot, indexedAtt)
+		switch {
+		case slashable == kv.DoubleVote:
+			signingRoot = [32]byte{}
+			conflicts++
+		case slashable != kv.NotSlashable:
+			// A surrounding or surrounded attestation is kept as is, as it only adds to the
+			// history the validator checks new attestations against.
+			conflicts++
+		case err != nil:
+			return err
+		}
+		indexedAtts[i] = indexedAtt
+		signingRoots[i] = signingRoot
+		if record.Source > highestSource {
+			highestSource = record.Source
+		}
+		if record.Target > highestTarget {
+			highestTarget = record.Target
+		}
+	}
+	if conflicts > 0 {
+		log.WithFields(logrus.Fields{
+			"publicKey": fmt.Sprintf("%#x", bytesutil.Trunc(pubKey[:])),
+			"conflicts": conflicts,
+		}).Warn("Merged conflicting attestations")
+	}
+	if err := validatorDB.SaveAttestationsForPubKey(ctx, pubKey, signingRoots, indexedAtts); err != nil {
+		return err
+	}
+	return validatorDB.RaiseLowestSignedEpochs(ctx, pubKey, highestSource, highestTarget)
+}
+
+// MergeProtectionFiles combines EIP-3076 slashing protection files, for example exported from
+// several machines, into a single file. The files must be for the same chain. Duplicate entries
+// are removed, and signing roots are dropped from conflicting blocks and attestations, so a
+// validator importing the combined file refuses to sign anything else at their slot or target epoch.
+func MergeProtectionFiles(files ...*format.EIPSlashingProtectionFormat) (*format.EIPSlashingProtectionFormat, error) {
+	if len(files) == 0 {
+		return nil, errors.New("no slashing protection files to merge")
+	}
+	merged := &format.EIPSlashingProtectionFormat{}
+	merged.Metadata.InterchangeFormatVersion = format.InterchangeFormatVersion
+	merged.Metadata.GenesisValidatorsRoot = files[0].Metadata.GenesisValidatorsRoot
+	dataByPubKey := make(map[string]*format.ProtectionData)
+	for i, file := range files {
+		if file.Metadata.InterchangeFormatVersion != format.InterchangeFormatVersion {
+			return nil, fmt.Errorf(
+				"slashing protection file %d has version '%s', wanted '%s'",
+				i,
+				file.Metadata.InterchangeFormatVersion,
+				format.InterchangeFormatVersion,
+			)
+		}
+		if !strings.EqualFold(file.Metadata.GenesisValidatorsRoot, merged.Metadata.GenesisValidatorsRoot) {
+			return nil, fmt.Errorf(
+				"slashing protection file %d has genesis validators root %s, but the first file has %s",
+				i,
+				file.Metadata.GenesisValidatorsRoot,
+				merged.Metadata.GenesisValidatorsRoot,
+			)
+		}
+		for _, data := range file.Data {
+			if data == nil {
+				continue
+			}
+			pubKey, err := PubKeyFromHex(data.Pubkey)
+			if err != nil {
+				return nil, fmt.Errorf("%s is not a valid public key: %w", data.Pubkey, err)
+			}
+			pubKeyHex, err := pubKeyToHexString(pubKey[:])
+			if err != nil {
+				return nil, err
+			}
+			item, ok := dataByPubKey[pubKeyHex]
+			if !ok {
+				item = &format.ProtectionData{
+					Pubkey:             pubKeyHex,
+					SignedBlocks:       make([]*format.SignedBlock, 0),
+					SignedAttestations: make([]*format.SignedAttestation, 0),
+				}
+				dataByPubKey[pubKeyHex] = item
+			}
+			for _, blk := range data.SignedBlocks {
+				if blk != nil {
+					item.SignedBlocks = append(item.SignedBlocks, blk)
+				}
+			}
+			for _, att := range data.SignedAttestations {
+				if att != nil {
+					item.SignedAttestations = append(item.SignedAttestations, att)
+				}
+			}
+		}
+	}
+
+	merged.Data = make([]*format.ProtectionData, 0, len(dataByPubKey))
+	for _, item := range dataByPubKey {
+		signedBlocks, err := mergeSignedBlocks(item.SignedBlocks)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not merge signed blocks for public key %s", item.Pubkey)
+		}
+		signedAtts, err := mergeSignedAttestations(item.SignedAttestations)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not merge signed attestations for public key %s", item.Pubkey)
+		}
+		item.SignedBlocks = signedBlocks
+		item.SignedAttestations = signedAtts
+		merged.Data = append(merged.Data, item)
+	}
+	sort.Slice(merged.Data, func(i, j int) bool {
+		return strings.Compare(merged.Data[i].Pubkey, merged.Data[j].Pubkey) < 0
+	})
+	return merged, nil
+}
+
+// mergeSignedBlocks keeps a single signed block per slot, sorted by slot. Its signing root is
+// dropped if the blocks at the slot have different signing roots.
+func mergeSignedBlocks(signedBlocks []*format.SignedBlock) ([]*format.SignedBlock, error) {
+	rootsBySlot := make(map[types.Slot]string)
+	for _, blk := range signedBlocks {
+		slot, err := SlotFromString(blk.Slot)
+		if err != nil {
+			return nil, fmt.Errorf("%s is not a valid slot: %w", blk.Slot, err)
+		}
+		root, ok := rootsBySlot[slot]
+		if ok && !strings.EqualFold(root, blk.SigningRoot) {
+			rootsBySlot[slot] = ""
+			continue
+		}
+		rootsBySlot[slot] = blk.SigningRoot
+	}
+	sortedSlots := make([]types.Slot, 0, len(rootsBySlot))
+	for slot := range rootsBySlot {
+		sortedSlots = append(sortedSlots, slot)
+	}
+	sort.Slice(sortedSlots, func(i, j int) bool {
+		return sortedSlots[i] < sortedSlots[j]
+	})
+	merged := make([]*format.SignedBlock, len(sortedSlots))
+	for i, slot := range sortedSlots {
+		merged[i] = &format.SignedBlock{Slot: fmt.Sprintf("%d", slot), SigningRoot: rootsBySlot[slot]}
+	}
+	return merged, nil
+}
+
+// mergeSignedAttestations keeps a single signed attestation per source and target epoch pair,
+// sorted by target epoch. Signing roots are dropped from every attestation with a target epoch
+// which has attestations with different signing roots.
+func mergeSignedAttestations(signedAtts []*format.SignedAttestation) ([]*format.SignedAttestation, error) {
+	type sourceTarget struct {
+		source types.Epoch
+		target types.Epoch
+	}
+	rootsByTarget := make(map[types.Epoch]string)
+	seen := make(map[sourceTarget]bool)
+	pairs := make([]sourceTarget, 0, len(signedAtts))
+	for _, att := range signedAtts {
+		source, err := EpochFromString(att.SourceEpoch)
+		if err != nil {
+			return nil, fmt.Errorf("%s is not a valid epoch: %w", att.SourceEpoch, err)
+		}
+		target, err := EpochFromString(att.TargetEpoch)
+		if err != nil {
+			return nil, fmt.Errorf("%s is not a valid epoch: %w", att.TargetEpoch, err)
+		}
+		if root, ok := rootsByTarget[target]; ok && !strings.EqualFold(root, att.SigningRoot) {
+			rootsByTarget[target] = ""
+		} else if !ok {
+			rootsByTarget[target] = att.SigningRoot
+		}
+		pair := sourceTarget{source: source, target: target}
+		if !seen[pair] {
+			seen[pair] = true
+			pairs = append(pairs, pair)
+		}
+	}
+	sort.Slice(pairs, func(i, j int) bool {
+		if pairs[i].target == pairs[j].target {
+			return pairs[i].source < pairs[j].source
+		}
+		return pairs[i].target < pairs[j].target
+	})
+	merged := make([]*format.SignedAttestation, len(pairs))
+	for i, pair := range pairs {
+		merged[i] = &format.SignedAttestation{
+			SourceEpoch: fmt.Sprintf("%d", pair.source),
+			TargetEpoch: fmt.Sprintf("%d", pair.target),
+			SigningRoot: rootsByTarget[pair.target],
+		}
+	}
+	return merged, nil
+}
+
+func readProtectionJSON(r io.Reader) (*format.EIPSlashingProtectionFormat, error) {
+	encodedJSON, err := io.ReadAll(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read slashing protection JSON file")
+	}
+	interchangeJSON := &format.EIPSlashingProtectionFormat{}
+	if err := json.Unmarshal(encodedJSON, interchangeJSON); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal slashing protection JSON file")
+	}
+	return interchangeJSON, nil
+}
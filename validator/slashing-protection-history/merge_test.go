@@ -0,0 +1,202 @@
+package history
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+	"github.com/prysmaticlabs/prysm/v3/validator/db/kv"
+	dbtest "github.com/prysmaticlabs/prysm/v3/validator/db/testing"
+	"github.com/prysmaticlabs/prysm/v3/validator/slashing-protection-history/format"
+)
+
+func protectionFile(pubKey [fieldparams.BLSPubkeyLength]byte, blocks []*format.SignedBlock, atts []*format.SignedAttestation) *format.EIPSlashingProtectionFormat {
+	file := &format.EIPSlashingProtectionFormat{}
+	file.Metadata.InterchangeFormatVersion = format.InterchangeFormatVersion
+	file.Metadata.GenesisValidatorsRoot = fmt.Sprintf("%#x", [32]byte{1})
+	file.Data = []*format.ProtectionData{{
+		Pubkey:             fmt.Sprintf("%#x", pubKey),
+		SignedBlocks:       blocks,
+		SignedAttestations: atts,
+	}}
+	return file
+}
+
+func TestMergeStandardProtectionJSON_MergesConflictingHistory(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [fieldparams.BLSPubkeyLength]byte{1}
+	validatorDB := dbtest.SetupDB(t, [][fieldparams.BLSPubkeyLength]byte{pubKey})
+	genesisValidatorsRoot := [32]byte{1}
+	require.NoError(t, validatorDB.SaveGenesisValidatorsRoot(ctx, genesisValidatorsRoot[:]))
+
+	// The existing history has a block at slot 10 and an attestation for target epoch 3.
+	require.NoError(t, validatorDB.SaveProposalHistoryForSlot(ctx, pubKey, 10, bytes.Repeat([]byte{1}, 32)))
+	require.NoError(t, validatorDB.SaveAttestationForPubKey(ctx, pubKey, [32]byte{1}, createAttestation(2, 3)))
+
+	// The file has a different block at slot 10 and a different attestation for target epoch 3.
+	file := protectionFile(pubKey, []*format.SignedBlock{
+		{Slot: "10", SigningRoot: fmt.Sprintf("%#x", [32]byte{2})},
+		{Slot: "12", SigningRoot: fmt.Sprintf("%#x", [32]byte{3})},
+	}, []*format.SignedAttestation{
+		{SourceEpoch: "2", TargetEpoch: "3", SigningRoot: fmt.Sprintf("%#x", [32]byte{2})},
+		{SourceEpoch: "3", TargetEpoch: "5", SigningRoot: fmt.Sprintf("%#x", [32]byte{3})},
+	})
+	enc, err := json.Marshal(file)
+	require.NoError(t, err)
+
+	// A regular import refuses the conflicting history.
+	err = ImportStandardProtectionJSON(ctx, validatorDB, bytes.NewBuffer(enc))
+	require.ErrorContains(t, "could not filter slashable attester public keys", err)
+
+	require.NoError(t, MergeStandardProtectionJSON(ctx, validatorDB, bytes.NewBuffer(enc)))
+
+	// Conflicting entries lose their signing roots.
+	root, exists, err := validatorDB.ProposalHistoryForSlot(ctx, pubKey, 10)
+	require.NoError(t, err)
+	require.Equal(t, true, exists)
+	assert.Equal(t, [32]byte{}, root)
+	root, _, err = validatorDB.ProposalHistoryForSlot(ctx, pubKey, 12)
+	require.NoError(t, err)
+	assert.Equal(t, [32]byte{3}, root)
+	root, err = validatorDB.SigningRootAtTargetEpoch(ctx, pubKey, 3)
+	require.NoError(t, err)
+	assert.Equal(t, [32]byte{}, root)
+	root, err = validatorDB.SigningRootAtTargetEpoch(ctx, pubKey, 5)
+	require.NoError(t, err)
+	assert.Equal(t, [32]byte{3}, root)
+
+	// The watermarks are raised to the highest entries of the file.
+	slot, _, err := validatorDB.LowestSignedProposal(ctx, pubKey)
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(12), slot)
+	source, _, err := validatorDB.LowestSignedSourceEpoch(ctx, pubKey)
+	require.NoError(t, err)
+	assert.Equal(t, types.Epoch(3), source)
+	target, _, err := validatorDB.LowestSignedTargetEpoch(ctx, pubKey)
+	require.NoError(t, err)
+	assert.Equal(t, types.Epoch(5), target)
+
+	// No key is blacklisted.
+	blacklisted, err := validatorDB.EIPImportBlacklistedPublicKeys(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(blacklisted))
+}
+
+func TestMergeStandardProtectionJSON_DoubleVoteWithinFile(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [fieldparams.BLSPubkeyLength]byte{1}
+	validatorDB := dbtest.SetupDB(t, [][fieldparams.BLSPubkeyLength]byte{pubKey})
+
+	file := protectionFile(pubKey, nil, []*format.SignedAttestation{
+		{SourceEpoch: "1", TargetEpoch: "2", SigningRoot: fmt.Sprintf("%#x", [32]byte{1})},
+		{SourceEpoch: "1", TargetEpoch: "2", SigningRoot: fmt.Sprintf("%#x", [32]byte{2})},
+	})
+	enc, err := json.Marshal(file)
+	require.NoError(t, err)
+	require.NoError(t, MergeStandardProtectionJSON(ctx, validatorDB, bytes.NewBuffer(enc)))
+
+	root, err := validatorDB.SigningRootAtTargetEpoch(ctx, pubKey, 2)
+	require.NoError(t, err)
+	assert.Equal(t, [32]byte{}, root)
+	slashable, err := validatorDB.CheckSlashableAttestation(ctx, pubKey, [32]byte{1}, createAttestation(1, 2))
+	require.NotNil(t, err)
+	assert.Equal(t, kv.DoubleVote, slashable)
+}
+
+func TestMergeProtectionFiles(t *testing.T) {
+	pubKey := [fieldparams.BLSPubkeyLength]byte{1}
+	otherPubKey := [fieldparams.BLSPubkeyLength]byte{2}
+	first := protectionFile(pubKey, []*format.SignedBlock{
+		{Slot: "5", SigningRoot: fmt.Sprintf("%#x", [32]byte{1})},
+		{Slot: "3", SigningRoot: fmt.Sprintf("%#x", [32]byte{1})},
+	}, []*format.SignedAttestation{
+		{SourceEpoch: "1", TargetEpoch: "2", SigningRoot: fmt.Sprintf("%#x", [32]byte{1})},
+	})
+	second := protectionFile(pubKey, []*format.SignedBlock{
+		{Slot: "5", SigningRoot: fmt.Sprintf("%#x", [32]byte{2})},
+		{Slot: "3", SigningRoot: fmt.Sprintf("%#x", [32]byte{1})},
+	}, []*format.SignedAttestation{
+		{SourceEpoch: "1", TargetEpoch: "2", SigningRoot: fmt.Sprintf("%#x", [32]byte{1})},
+		{SourceEpoch: "0", TargetEpoch: "4", SigningRoot: fmt.Sprintf("%#x", [32]byte{2})},
+		{SourceEpoch: "2", TargetEpoch: "4", SigningRoot: fmt.Sprintf("%#x", [32]byte{3})},
+	})
+	second.Data = append(second.Data, &format.ProtectionData{
+		Pubkey:             fmt.Sprintf("%#x", otherPubKey),
+		SignedBlocks:       []*format.SignedBlock{{Slot: "1"}},
+		SignedAttestations: []*format.SignedAttestation{},
+	})
+
+	merged, err := MergeProtectionFiles(first, second)
+	require.NoError(t, err)
+	assert.Equal(t, first.Metadata, merged.Metadata)
+	require.Equal(t, 2, len(merged.Data))
+	assert.DeepEqual(t, []*format.SignedBlock{
+		{Slot: "3", SigningRoot: fmt.Sprintf("%#x", [32]byte{1})},
+		{Slot: "5"},
+	}, merged.Data[0].SignedBlocks)
+	assert.DeepEqual(t, []*format.SignedAttestation{
+		{SourceEpoch: "1", TargetEpoch: "2", SigningRoot: fmt.Sprintf("%#x", [32]byte{1})},
+		{SourceEpoch: "0", TargetEpoch: "4"},
+		{SourceEpoch: "2", TargetEpoch: "4"},
+	}, merged.Data[0].SignedAttestations)
+	assert.Equal(t, fmt.Sprintf("%#x", otherPubKey), merged.Data[1].Pubkey)
+
+	// Files of different chains cannot be merged.
+	second.Metadata.GenesisValidatorsRoot = fmt.Sprintf("%#x", [32]byte{2})
+	_, err = MergeProtectionFiles(first, second)
+	require.ErrorContains(t, "genesis validators root", err)
+}
+
+func TestExportStandardProtectionJSONWithOptions(t *testing.T) {
+	ctx := context.Background()
+	pubKeys := [][fieldparams.BLSPubkeyLength]byte{{1}, {2}}
+	validatorDB := dbtest.SetupDB(t, pubKeys)
+	genesisValidatorsRoot := [32]byte{1}
+	require.NoError(t, validatorDB.SaveGenesisValidatorsRoot(ctx, genesisValidatorsRoot[:]))
+	for _, pubKey := range pubKeys {
+		for epoch := types.Epoch(1); epoch <= 10; epoch++ {
+			require.NoError(t, validatorDB.SaveAttestationForPubKey(ctx, pubKey, [32]byte{byte(epoch)}, createAttestation(epoch-1, epoch)))
+		}
+		for _, slot := range []types.Slot{40, 100, 200} {
+			require.NoError(t, validatorDB.SaveProposalHistoryForSlot(ctx, pubKey, slot, bytes.Repeat([]byte{1}, 32)))
+		}
+	}
+
+	t.Run("epoch window", func(t *testing.T) {
+		exported, err := ExportStandardProtectionJSONWithOptions(ctx, validatorDB, &ExportOptions{
+			PublicKeys: [][]byte{pubKeys[1][:]},
+			StartEpoch: 3,
+			EndEpoch:   5,
+		})
+		require.NoError(t, err)
+		require.Equal(t, 1, len(exported.Data))
+		assert.Equal(t, fmt.Sprintf("%#x", pubKeys[1]), exported.Data[0].Pubkey)
+		require.Equal(t, 3, len(exported.Data[0].SignedAttestations))
+		assert.Equal(t, "3", exported.Data[0].SignedAttestations[0].TargetEpoch)
+		assert.Equal(t, "5", exported.Data[0].SignedAttestations[2].TargetEpoch)
+		require.Equal(t, 1, len(exported.Data[0].SignedBlocks))
+		assert.Equal(t, "100", exported.Data[0].SignedBlocks[0].Slot)
+	})
+	t.Run("minimal", func(t *testing.T) {
+		exported, err := ExportStandardProtectionJSONWithOptions(ctx, validatorDB, &ExportOptions{Minimal: true})
+		require.NoError(t, err)
+		require.Equal(t, 2, len(exported.Data))
+		for _, data := range exported.Data {
+			assert.DeepEqual(t, []*format.SignedBlock{{Slot: "40"}, {Slot: "200"}}, data.SignedBlocks)
+			assert.DeepEqual(t, []*format.SignedAttestation{
+				{SourceEpoch: "0", TargetEpoch: "1"},
+				{SourceEpoch: "9", TargetEpoch: "10"},
+			}, data.SignedAttestations)
+		}
+	})
+	t.Run("invalid window", func(t *testing.T) {
+		_, err := ExportStandardProtectionJSONWithOptions(ctx, validatorDB, &ExportOptions{StartEpoch: 5, EndEpoch: 4})
+		require.ErrorContains(t, "lower than start epoch", err)
+	})
+}